@@ -5,8 +5,12 @@ const (
 	DoctorId                = 2
 	PharmacyManagerId       = 3
 	AdminId                 = 4
+	FinanceId               = 5
+	CatalogEditorId         = 6
 	UserRoleName            = "user"
 	DoctorRoleName          = "doctor"
 	PharmacyManagerRoleName = "pharmacy manager"
 	AdminRoleName           = "admin"
+	FinanceRoleName         = "finance"
+	CatalogEditorRoleName   = "catalog editor"
 )
@@ -0,0 +1,14 @@
+package appconstant
+
+const (
+	AccessTokenDuration  = 15
+	RefreshTokenDuration = 24 * 60
+
+	SessionRevokedByLogout         = "logout"
+	SessionRevokedByLogoutAll      = "logout_all"
+	SessionRevokedByUser           = "revoked_by_user"
+	SessionRevokedByTokenReuse     = "refresh_token_reuse"
+	SessionRevokedByPasswordReset  = "password_reset"
+	SessionRevokedByTwoFactorReset = "two_factor_reset"
+	SessionRevokedByRoleChange     = "role_change"
+)
@@ -0,0 +1,15 @@
+package appconstant
+
+const (
+	AdminFileMaxSize        = 5000000
+	AdminFileMaxRequestSize = AdminFileMaxSize + 1000000
+	FileSniffLength         = 512
+)
+
+var AdminFileContentTypes = map[string]string{
+	"png":  "image/png",
+	"jpg":  "image/jpeg",
+	"jpeg": "image/jpeg",
+	"webp": "image/webp",
+	"pdf":  "application/pdf",
+}
@@ -0,0 +1,41 @@
+package appconstant
+
+const (
+	AuditActionConfirmPayment               = "order.confirm_payment"
+	AuditActionCreateDrug                   = "drug.create"
+	AuditActionUpdateDrug                   = "drug.update"
+	AuditActionDeleteDrug                   = "drug.delete"
+	AuditActionCreatePartner                = "partner.create"
+	AuditActionUpdatePartner                = "partner.update"
+	AuditActionDeletePartner                = "partner.delete"
+	AuditActionDeletePharmacy               = "pharmacy.delete"
+	AuditActionCreatePharmacyDrug           = "pharmacy_drug.create"
+	AuditActionUpdatePharmacyDrug           = "pharmacy_drug.update"
+	AuditActionDeletePharmacyDrug           = "pharmacy_drug.delete"
+	AuditActionMutatePharmacyDrug           = "pharmacy_drug.stock_mutation"
+	AuditActionUpdateRolePermissions        = "role.update_permissions"
+	AuditActionAssignAccountRole            = "account.assign_role"
+	AuditActionResetTwoFactor               = "account.reset_two_factor"
+	AuditActionCreateOperator               = "account.create_operator"
+	AuditActionUpdateDoctorVerification     = "doctor.update_verification"
+	AuditActionUpdateDoctorReviewVisibility = "doctor_review.update_visibility"
+	AuditActionUpdateMaintenanceMode        = "maintenance.update_mode"
+	AuditActionUploadAdminFile              = "admin_file.upload"
+	AuditActionDeleteAdminFile              = "admin_file.delete"
+
+	AuditEntityOrder           = "order"
+	AuditEntityDrug            = "drug"
+	AuditEntityPharmacyManager = "pharmacy_manager"
+	AuditEntityPharmacy        = "pharmacy"
+	AuditEntityPharmacyDrug    = "pharmacy_drug"
+	AuditEntityRole            = "role"
+	AuditEntityAccount         = "account"
+	AuditEntityAdminFile       = "admin_file"
+	AuditEntityDoctor          = "doctor"
+	AuditEntityDoctorReview    = "doctor_review"
+	AuditEntityMaintenanceMode = "maintenance_mode"
+
+	AuditLogExportLimit    = 10000
+	AuditLogExportFileName = "audit-logs.csv"
+	CsvContentType         = "text/csv"
+)
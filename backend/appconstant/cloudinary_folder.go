@@ -7,5 +7,5 @@ const (
 	DrugPicturesUrl       = "drugs/"
 	ChatAttachmentUrl     = "chat_attachments/"
 	OrderPaymentProofsUrl = "order_payment_proofs/"
-	PersonalUrl           = "personal/"
+	AdminFilesUrl         = "admin_files/"
 )
@@ -4,10 +4,12 @@ const (
 	RequestId = "request-id"
 	AccountId = "account-id"
 	Role      = "role"
+	SessionId = "session-id"
 
 	Latitude  = "lat"
 	Longitude = "long"
 
-	Page  = "page"
-	Limit = "limit"
+	Page   = "page"
+	Limit  = "limit"
+	Before = "before"
 )
@@ -0,0 +1,8 @@
+package appconstant
+
+const (
+	DoctorReviewVisibilityHidden  = "hidden"
+	DoctorReviewVisibilityVisible = "visible"
+
+	DoctorReviewVisibilityString = "visibility"
+)
@@ -0,0 +1,9 @@
+package appconstant
+
+const (
+	DoctorVerificationPending  = "pending"
+	DoctorVerificationApproved = "approved"
+	DoctorVerificationRejected = "rejected"
+
+	DoctorVerificationStatusString = "status"
+)
@@ -0,0 +1,43 @@
+package appconstant
+
+import "time"
+
+const (
+	EmailTypeVerification   = "verification"
+	EmailTypeResetPassword  = "reset_password"
+	EmailTypeCredentials    = "credentials"
+	EmailTypeAccountLocked  = "account_locked"
+	EmailTypeDoctorApproved = "doctor_approved"
+	EmailTypeDoctorRejected = "doctor_rejected"
+
+	EmailLocaleDefault   = "en"
+	EmailSubjectTemplate = "subject"
+
+	EmailModeSmtp = "smtp"
+	EmailModeSink = "sink"
+
+	EmailOutboxStatusPending = "pending"
+	EmailOutboxStatusSent    = "sent"
+	EmailOutboxStatusDead    = "dead"
+
+	EmailOutboxBatchSize          = 20
+	EmailOutboxMaxAttempts        = 8
+	EmailOutboxLeaseSeconds       = 300
+	EmailOutboxBaseBackoffSeconds = 30
+	EmailOutboxMaxBackoffSeconds  = 6 * 60 * 60
+	EmailOutboxLastErrorMaxLength = 1000
+	EmailOutboxPollInterval       = 5 * time.Second
+	EmailOutboxSendTimeout        = 30 * time.Second
+	EmailSinkFileExtension        = ".eml"
+)
+
+var EmailLocales = map[string]bool{
+	"en": true,
+	"id": true,
+}
+
+var EmailSecretTypes = []string{
+	EmailTypeVerification,
+	EmailTypeResetPassword,
+	EmailTypeCredentials,
+}
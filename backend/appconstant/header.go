@@ -1,6 +1,11 @@
 package appconstant
 
 const (
-	AuthorizationHeader = "Authorization"
-	Bearer              = "Bearer"
+	AuthorizationHeader  = "Authorization"
+	Bearer               = "Bearer"
+	RetryAfterHeader     = "Retry-After"
+	CacheControlHeader   = "Cache-Control"
+	JwksCacheControl     = "public, max-age=300"
+	AcceptLanguageHeader = "Accept-Language"
+	LastEventIdHeader    = "Last-Event-ID"
 )
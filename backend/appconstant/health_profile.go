@@ -0,0 +1,11 @@
+package appconstant
+
+const (
+	PregnancyStatusUnknown       = "unknown"
+	PregnancyStatusNotPregnant   = "not_pregnant"
+	PregnancyStatusPregnant      = "pregnant"
+	PregnancyStatusBreastfeeding = "breastfeeding"
+
+	HealthProfileAccessSourceRoomDetail    = "room_detail"
+	HealthProfileAccessSourceHealthProfile = "health_profile"
+)
@@ -0,0 +1,14 @@
+package appconstant
+
+const (
+	AccessTokenAudience        = "access"
+	RefreshTokenAudience       = "refresh"
+	VerificationTokenAudience  = "verification"
+	ResetPasswordTokenAudience = "reset-password"
+	TwoFactorChallengeAudience = "two-factor-challenge"
+
+	JwtKeyIdHeader      = "kid"
+	JwtKeyUse           = "sig"
+	JwtKeyFileExtension = ".pem"
+	JwtMinRsaKeyBits    = 2048
+)
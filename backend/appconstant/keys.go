@@ -20,4 +20,13 @@ const (
 	OrderPharmacyIdString   = "order_pharmacy_id"
 	PharmacyDrugIdString    = "pharmacy_drug_id"
 	DoctorIdString          = "doctor_id"
+	DoctorReviewIdString    = "doctor_review_id"
+	VerificationCodeString  = "verification_code"
+	SickLeaveIdString       = "sick_leave_certificate_id"
+	SessionIdString         = "session_id"
+	AccountIdString         = "account_id"
+	RoleIdString            = "role_id"
+	ProviderString          = "provider"
+	AdminFileIdString       = "admin_file_id"
+	EmailOutboxIdString     = "email_outbox_id"
 )
@@ -0,0 +1,10 @@
+package appconstant
+
+const (
+	MaintenancePurgeRetentionHours = 24
+	MaintenanceRetryAfterSeconds   = "300"
+	MetricsContentType             = "text/plain; version=0.0.4; charset=utf-8"
+	MetricsNamespace               = "maxhealth"
+	MetricsUnmatchedRoute          = "unmatched"
+	MaintenanceExemptPath          = "/ping"
+)
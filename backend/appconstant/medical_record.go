@@ -0,0 +1,10 @@
+package appconstant
+
+const (
+	MedicalHistoryEntryConsultationNote = "consultation_note"
+	MedicalHistoryEntryPrescription     = "prescription"
+
+	MedicalRecordExportFormat   = "max-health.medical-record"
+	MedicalRecordExportVersion  = 1
+	MedicalRecordExportFileName = "medical-record-%d.json"
+)
@@ -80,4 +80,58 @@ const (
 	MsgInvalidPharmacyOperational      = "invalid pharmacy operational"
 	MsgInvalidPharmacyCourier          = "invalid pharmacy courier"
 	MsgOngoingOrderExists              = "ongoing order exists"
+	MsgInvalidChatCursor               = "before must be a chat id greater than 0"
+	MsgInvalidPrescriptionVerification = "prescription verification code not found"
+	MsgPrescriptionNotSigned           = "prescription was issued without a signed document"
+	MsgPrescriptionHasExpired          = "prescription has expired"
+	MsgPrescriptionExhausted           = "prescription has no remaining quantity"
+	MsgPrescriptionQuantityExceeded    = "requested quantity exceeds the remaining prescription quantity"
+	MsgPrescriptionRequired            = "drug requires a valid prescription"
+	MsgPrescriptionNotCoveringDrug     = "prescription does not cover this drug or has expired"
+	MsgDoctorNotApproved               = "doctor registration has not been approved"
+	MsgInvalidDoctorVerificationStatus = "status must be one of pending, approved, rejected"
+	MsgDoctorVerificationUnchanged     = "doctor already has the requested verification status"
+	MsgDoctorReviewNotFound            = "doctor review not found"
+	MsgDoctorReviewAlreadyExists       = "consultation has already been reviewed"
+	MsgChatRoomNotClosed               = "consultation must be closed before it can be reviewed"
+	MsgInvalidDoctorReviewVisibility   = "visibility must be one of hidden, visible"
+	MsgOrderItemNotFound               = "order item not found"
+	MsgOrderNotConfirmed               = "order must be confirmed before it can be reviewed"
+	MsgPharmacyReviewAlreadyExists     = "pharmacy order has already been reviewed"
+	MsgOrderItemReviewAlreadyExists    = "order item has already been reviewed"
+	MsgPharmacyReviewNotFound          = "pharmacy review not found"
+	MsgOrderItemReviewNotFound         = "order item review not found"
+	MsgReviewEditWindowExpired         = "review can no longer be edited"
+	MsgChatRoomNotActive               = "consultation is not active"
+	MsgInvalidHealthProfileMeasurement = "height and weight must be greater than 0"
+	MsgConsultationNoteNotFound        = "consultation note not found"
+	MsgSickLeaveNotFound               = "sick leave certificate not found"
+	MsgInvalidSickLeaveVerification    = "invalid sick leave certificate verification code"
+	MsgInvalidSickLeaveStartDate       = "start date must be in YYYY-MM-DD format"
+	MsgRefreshTokenReused              = "refresh token has already been used, session has been revoked"
+	MsgSessionNotFound                 = "session not found"
+	MsgTooManyRequests                 = "too many requests, please try again later"
+	MsgRequestBodyTooLarge             = "request body is too large"
+	MsgAccountLocked                   = "account is temporarily locked due to too many failed login attempts"
+	MsgTwoFactorRequired               = "two-factor authentication is required for this account"
+	MsgInvalidTwoFactorCode            = "invalid two-factor authentication code"
+	MsgInvalidTwoFactorChallenge       = "two-factor challenge is invalid or has expired"
+	MsgTwoFactorAlreadyEnabled         = "two-factor authentication is already enabled"
+	MsgTwoFactorNotEnrolled            = "two-factor authentication enrolment has not been started"
+	MsgTwoFactorNotEnabled             = "two-factor authentication is not enabled"
+	MsgTwoFactorMandatory              = "two-factor authentication cannot be disabled for this role"
+	MsgRoleNotFound                    = "role not found"
+	MsgInvalidPermission               = "one or more permissions do not exist"
+	MsgOperatorRoleRequired            = "role can only be assigned to and from operator roles"
+	MsgOwnRoleChange                   = "cannot change own role"
+	MsgRolesManagePermissionRequired   = "admin role must keep the roles.manage permission"
+	MsgOidcProviderNotFound            = "oidc provider not found"
+	MsgInvalidOidcLogin                = "invalid oidc login"
+	MsgOidcEmailNotVerified            = "oidc account has no verified email"
+	MsgOidcAccountLinkRequired         = "sign in with your password and link this provider from your account"
+	MsgOidcIdentityLinked              = "oidc account is already linked to another account"
+	MsgAdminFileNotFound               = "file not found"
+	MsgUnderMaintenance                = "service is under maintenance"
+	MsgEmailOutboxNotFound             = "dead-lettered email not found or its content was discarded"
+	MsgInvalidLastEventId              = "invalid last event id"
 )
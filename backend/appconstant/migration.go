@@ -0,0 +1,13 @@
+package appconstant
+
+const (
+	MigrateCommand         = "migrate"
+	MigrateUpCommand       = "up"
+	MigrateDownCommand     = "down"
+	MigrateStatusCommand   = "status"
+	MigrateBaselineCommand = "baseline"
+
+	MigrationAdvisoryLockKey = 7_204_513_880_194_562_301
+	MigrationsDir            = "migrations"
+	MigrationDefaultDownStep = 1
+)
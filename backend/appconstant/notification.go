@@ -0,0 +1,38 @@
+package appconstant
+
+import "time"
+
+const (
+	NotificationTypeOrderStatusChanged     = "order_status_changed"
+	NotificationTypePaymentConfirmed       = "payment_confirmed"
+	NotificationTypePaymentRejected        = "payment_rejected"
+	NotificationTypeNewOrder               = "new_order"
+	NotificationTypeChatRoomCreated        = "chat_room_created"
+	NotificationTypeStockMutationRequested = "stock_mutation_requested"
+
+	NotificationTitleOrderStatusChanged     = "Order status updated"
+	NotificationTitlePaymentConfirmed       = "Payment confirmed"
+	NotificationTitlePaymentRejected        = "Payment rejected"
+	NotificationTitleNewOrder               = "New order to process"
+	NotificationTitleChatRoomCreated        = "New consultation"
+	NotificationTitleStockMutationRequested = "Stock mutation"
+
+	NotificationMessageOrderStatusChanged     = "Order #%d is now %s"
+	NotificationMessagePaymentConfirmed       = "Payment for order #%d has been confirmed"
+	NotificationMessagePaymentRejected        = "Payment proof for order #%d was rejected, please upload a new one"
+	NotificationMessageNewOrder               = "Order #%d has been paid and is ready to be processed"
+	NotificationMessageChatRoomCreated        = "A patient has started a consultation with you"
+	NotificationMessageStockMutationRequested = "%d units were transferred from your pharmacy stock"
+
+	NotificationChannelFormat     = "$private:notifications#%v"
+	NotificationTokenDuration     = 60
+	NotificationPushBatchSize     = 100
+	NotificationPushMaxAgeSeconds = 300
+	NotificationPushPollInterval  = time.Second
+	NotificationMarkReadMaxIds    = 100
+
+	CentrifugoPublishPath      = "/api/publish"
+	CentrifugoApiKeyHeader     = "X-API-Key"
+	CentrifugoHttpTimeout      = 5 * time.Second
+	CentrifugoMaxResponseBytes = 1 << 16
+)
@@ -0,0 +1,22 @@
+package appconstant
+
+import "time"
+
+const (
+	LogFieldRequestId = "request_id"
+	LogFieldAccountId = "account_id"
+	LogFieldRole      = "role"
+	LogFieldTraceId   = "trace_id"
+	LogFieldSpanId    = "span_id"
+
+	TracerName                = "max-health"
+	TracingShutdownTimeout    = 5 * time.Second
+	TracingAttributeRequestId = "request.id"
+	TracingAttributeAccountId = "enduser.id"
+	TracingAttributeRole      = "enduser.role"
+	SpanRajaOngkirCost        = "rajaongkir.cost"
+	SpanCloudinaryUpload      = "cloudinary.upload"
+	SpanCloudinaryDestroy     = "cloudinary.destroy"
+	SpanSmtpSend              = "smtp.send"
+	SpanWsSession             = "ws.session"
+)
@@ -0,0 +1,19 @@
+package appconstant
+
+import "time"
+
+const (
+	OidcLoginStateDuration     = 10
+	OidcSecureCodeLength       = 64
+	OidcDiscoveryPath          = "/.well-known/openid-configuration"
+	OidcDiscoveryCacheDuration = time.Hour
+	OidcKeysRefreshInterval    = time.Minute
+	OidcHttpTimeout            = 10 * time.Second
+	OidcClockSkew              = time.Minute
+	OidcMaxResponseBytes       = 1 << 20
+	OidcCodeChallengeMethod    = "S256"
+	OidcDefaultAccountName     = "MAXHealth User"
+	OidcClientSecretBasic      = "client_secret_basic"
+)
+
+var OidcIdTokenAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
@@ -0,0 +1,16 @@
+package appconstant
+
+import "time"
+
+const (
+	OrderEventTypeOrderStatusChanged         = "order_status_changed"
+	OrderEventTypeOrderPharmacyStatusChanged = "order_pharmacy_status_changed"
+	OrderEventTypeResync                     = "resync"
+
+	OrderEventHistorySize          = 1024
+	OrderEventSubscriberBufferSize = 64
+	OrderEventHeartbeatInterval    = 15 * time.Second
+	OrderEventRetryMilliseconds    = 3000
+
+	LastEventIdQuery = "last_event_id"
+)
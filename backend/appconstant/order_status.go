@@ -8,3 +8,12 @@ const (
 	OrderStatusConfirmed                     = 5
 	OrderStatusCanceled                      = 6
 )
+
+var OrderStatusNames = map[int64]string{
+	OrderStatusWaitingForPayment:             "Waiting For Payment",
+	OrderStatusWaitingForPaymentConfirmation: "Waiting For Payment Confirmation",
+	OrderStatusProcessed:                     "Processed",
+	OrderStatusSent:                          "Sent",
+	OrderStatusConfirmed:                     "Order Confirmed",
+	OrderStatusCanceled:                      "Cancelled",
+}
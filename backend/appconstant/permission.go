@@ -0,0 +1,37 @@
+package appconstant
+
+import "time"
+
+const (
+	PermissionUsersProfile         = "users.profile"
+	PermissionAddressesManage      = "addresses.manage"
+	PermissionCartsManage          = "carts.manage"
+	PermissionOrdersCheckout       = "orders.checkout"
+	PermissionPrescriptionsRedeem  = "prescriptions.redeem"
+	PermissionConsultationsBook    = "consultations.book"
+	PermissionReviewsWrite         = "reviews.write"
+	PermissionHealthRecordsOwn     = "health_records.own"
+	PermissionDoctorsProfile       = "doctors.profile"
+	PermissionConsultationsAttend  = "consultations.attend"
+	PermissionPharmaciesManage     = "pharmacies.manage"
+	PermissionStockManage          = "stock.manage"
+	PermissionPharmacyOrdersFulfil = "pharmacy_orders.fulfil"
+	PermissionPharmacyReportsRead  = "reports.pharmacy"
+	PermissionReviewsReply         = "reviews.reply"
+	PermissionDrugsRead            = "drugs.read"
+	PermissionDrugsWrite           = "drugs.write"
+	PermissionCategoriesWrite      = "categories.write"
+	PermissionDoctorsVerify        = "doctors.verify"
+	PermissionReviewsModerate      = "reviews.moderate"
+	PermissionPartnersManage       = "partners.manage"
+	PermissionOrdersConfirmPayment = "orders.confirm_payment"
+	PermissionOrdersRead           = "orders.read"
+	PermissionReportsRead          = "reports.read"
+	PermissionAccountsManage       = "accounts.manage"
+	PermissionRolesManage          = "roles.manage"
+	PermissionAuditLogsRead        = "audit_logs.read"
+	PermissionFilesManage          = "files.manage"
+	PermissionMaintenanceManage    = "maintenance.manage"
+
+	RoleCacheDuration = time.Minute
+)
@@ -0,0 +1,11 @@
+package appconstant
+
+import "time"
+
+const (
+	ReviewEditWindow = 7 * 24 * time.Hour
+
+	PharmacyReviewIdString  = "pharmacy_review_id"
+	OrderItemReviewIdString = "order_item_review_id"
+	OrderItemIdString       = "order_item_id"
+)
@@ -0,0 +1,5 @@
+package appconstant
+
+const (
+	DefaultPrescriptionValidDays = 30
+)
@@ -0,0 +1,8 @@
+package appconstant
+
+const (
+	PrescriptionVerificationCodeLength = 12
+
+	PrescriptionDocumentTitle    = "MAXHealth E-Prescription"
+	PrescriptionDocumentFileName = "prescription-%d.pdf"
+)
@@ -0,0 +1,16 @@
+package appconstant
+
+const (
+	DefaultMaxPrescriptionQuantity = 100
+
+	PrescriptionIssueUnknownDrug         = "unknown_drug"
+	PrescriptionIssueInactiveDrug        = "inactive_drug"
+	PrescriptionIssueMaxQuantityExceeded = "max_quantity_exceeded"
+	PrescriptionIssueDrugInteraction     = "drug_interaction"
+	PrescriptionIssueDuplicateTherapy    = "duplicate_therapy"
+	PrescriptionIssueAllergy             = "allergy"
+	PrescriptionIssueCurrentMedication   = "current_medication"
+	PrescriptionIssuePregnancy           = "pregnancy"
+
+	WsMessageTypePrescriptionValidation = "prescription_validation"
+)
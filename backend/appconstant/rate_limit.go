@@ -0,0 +1,31 @@
+package appconstant
+
+import "time"
+
+const (
+	LoginRateLimitName     = "login"
+	LoginIpRateLimit       = 30
+	LoginIdentityRateLimit = 10
+	LoginRateLimitWindow   = time.Minute
+
+	VerificationRateLimitName     = "verification"
+	VerificationIpRateLimit       = 20
+	VerificationIdentityRateLimit = 5
+	VerificationRateLimitWindow   = 15 * time.Minute
+
+	ResetPasswordRateLimitName     = "reset-password"
+	ResetPasswordIpRateLimit       = 10
+	ResetPasswordIdentityRateLimit = 3
+	ResetPasswordRateLimitWindow   = 15 * time.Minute
+
+	TwoFactorRateLimitName    = "two-factor"
+	TwoFactorIpRateLimit      = 20
+	TwoFactorAccountRateLimit = 5
+	TwoFactorRateLimitWindow  = 15 * time.Minute
+
+	RateLimitMaxBodyBytes = 8 << 10
+
+	MaxFailedLoginAttempts   = 5
+	LoginLockoutBaseDuration = 15 * 60
+	LoginLockoutMaxDuration  = 24 * 60 * 60
+)
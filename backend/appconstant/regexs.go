@@ -1,5 +1,6 @@
 package appconstant
 
 const (
-	NameRegexPattern = `^[a-zA-Z]+(?: [a-zA-Z]+)*$`
+	NameRegexPattern  = `^[a-zA-Z]+(?: [a-zA-Z]+)*$`
+	ICD10RegexPattern = `^[A-TV-Z][0-9][0-9AB](\.[0-9A-TV-Z]{1,4})?$`
 )
@@ -2,4 +2,6 @@ package appconstant
 
 const (
 	CharSet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	PlaceholderPasswordLength = 32
 )
@@ -0,0 +1,18 @@
+package appconstant
+
+const (
+	SickLeaveMaxDays          = 30
+	SickLeaveDateFormat       = "2006-01-02"
+	SickLeaveDocumentTitle    = "MAXHealth Sick Leave Certificate"
+	SickLeaveDocumentFileName = "sick-leave-%d.pdf"
+
+	ChatTypeMessage              = "message"
+	ChatTypePrescription         = "prescription"
+	ChatTypeSickLeaveCertificate = "sick_leave_certificate"
+
+	UserDocumentPrescription         = "prescription"
+	UserDocumentSickLeaveCertificate = "sick_leave_certificate"
+
+	PrescriptionDocumentPath = "/prescriptions/%d/document"
+	SickLeaveDocumentPath    = "/sick-leave-certificates/%d/document"
+)
@@ -1,5 +1,6 @@
 package appconstant
 
 const (
-	ChatTimeFormat = "2006-01-02 15:04:05"
+	ChatTimeFormat     = "2006-01-02 15:04:05"
+	DocumentDateFormat = "02 January 2006"
 )
@@ -0,0 +1,17 @@
+package appconstant
+
+import "time"
+
+const (
+	TwoFactorIssuer             = "MaxHealth"
+	TwoFactorSecretSize         = 20
+	TwoFactorCodeDigits         = 6
+	TwoFactorPeriod             = 30 * time.Second
+	TwoFactorSkew               = 1
+	TwoFactorChallengeDuration  = 5
+	TwoFactorRecoveryCodeCount  = 10
+	TwoFactorRecoveryCodeLength = 10
+	TwoFactorQrCodeSize         = 256
+
+	TwoFactorVerified = "two-factor-verified"
+)
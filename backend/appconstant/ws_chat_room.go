@@ -8,7 +8,19 @@ const (
 
 	ChatRoomDuration = time.Duration(30 * time.Minute)
 
+	ChatHistoryDefaultLimit = 30
+	ChatHistoryMaxLimit     = 100
+	ChatReplayLimit         = 50
+
 	ChannelHeaderKey      = "channel"
 	ChannelTokenHeaderKey = "channel-token"
 	ClientTokenHeaderKey  = "client-token"
+
+	WsMessageTypeAuth      = "auth"
+	WsMessageTypeChat      = "chat"
+	WsMessageTypeTyping    = "typing"
+	WsMessageTypeDelivered = "delivered"
+	WsMessageTypeRead      = "read"
+	WsMessageTypeError     = "error"
+	WsMessageTypeReplay    = "replay"
 )
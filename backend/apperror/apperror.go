@@ -11,10 +11,11 @@ import (
 )
 
 type AppError struct {
-	Code    int
-	Err     error
-	Message string
-	stack   []byte
+	Code       int
+	Err        error
+	Message    string
+	RetryAfter int
+	stack      []byte
 }
 
 func (ae AppError) Error() string {
@@ -399,3 +400,271 @@ func OngoingOrderExistsError() *AppError {
 	err := errors.New(appconstant.MsgOngoingOrderExists)
 	return NewAppError(http.StatusForbidden, err, appconstant.MsgOngoingOrderExists)
 }
+
+func InvalidChatCursorError() *AppError {
+	err := errors.New(appconstant.MsgInvalidChatCursor)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidChatCursor)
+}
+
+func InvalidPrescriptionVerificationCodeError() *AppError {
+	err := errors.New(appconstant.MsgInvalidPrescriptionVerification)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgInvalidPrescriptionVerification)
+}
+
+func PrescriptionNotSignedError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionNotSigned)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgPrescriptionNotSigned)
+}
+
+func PrescriptionHasExpiredError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionHasExpired)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgPrescriptionHasExpired)
+}
+
+func PrescriptionExhaustedError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionExhausted)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgPrescriptionExhausted)
+}
+
+func PrescriptionQuantityExceededError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionQuantityExceeded)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgPrescriptionQuantityExceeded)
+}
+
+func PrescriptionRequiredError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionRequired)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgPrescriptionRequired)
+}
+
+func PrescriptionNotCoveringDrugError() *AppError {
+	err := errors.New(appconstant.MsgPrescriptionNotCoveringDrug)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgPrescriptionNotCoveringDrug)
+}
+
+func DoctorNotApprovedError() *AppError {
+	err := errors.New(appconstant.MsgDoctorNotApproved)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgDoctorNotApproved)
+}
+
+func InvalidDoctorVerificationStatusError() *AppError {
+	err := errors.New(appconstant.MsgInvalidDoctorVerificationStatus)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidDoctorVerificationStatus)
+}
+
+func DoctorVerificationUnchangedError() *AppError {
+	err := errors.New(appconstant.MsgDoctorVerificationUnchanged)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgDoctorVerificationUnchanged)
+}
+
+func DoctorReviewNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgDoctorReviewNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgDoctorReviewNotFound)
+}
+
+func DoctorReviewAlreadyExistsError() *AppError {
+	err := errors.New(appconstant.MsgDoctorReviewAlreadyExists)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgDoctorReviewAlreadyExists)
+}
+
+func ChatRoomNotClosedError() *AppError {
+	err := errors.New(appconstant.MsgChatRoomNotClosed)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgChatRoomNotClosed)
+}
+
+func InvalidDoctorReviewVisibilityError() *AppError {
+	err := errors.New(appconstant.MsgInvalidDoctorReviewVisibility)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidDoctorReviewVisibility)
+}
+
+func OrderItemNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgOrderItemNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgOrderItemNotFound)
+}
+
+func OrderNotConfirmedError() *AppError {
+	err := errors.New(appconstant.MsgOrderNotConfirmed)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgOrderNotConfirmed)
+}
+
+func PharmacyReviewAlreadyExistsError() *AppError {
+	err := errors.New(appconstant.MsgPharmacyReviewAlreadyExists)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgPharmacyReviewAlreadyExists)
+}
+
+func OrderItemReviewAlreadyExistsError() *AppError {
+	err := errors.New(appconstant.MsgOrderItemReviewAlreadyExists)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgOrderItemReviewAlreadyExists)
+}
+
+func PharmacyReviewNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgPharmacyReviewNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgPharmacyReviewNotFound)
+}
+
+func OrderItemReviewNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgOrderItemReviewNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgOrderItemReviewNotFound)
+}
+
+func ReviewEditWindowExpiredError() *AppError {
+	err := errors.New(appconstant.MsgReviewEditWindowExpired)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgReviewEditWindowExpired)
+}
+
+func ChatRoomNotActiveError() *AppError {
+	err := errors.New(appconstant.MsgChatRoomNotActive)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgChatRoomNotActive)
+}
+
+func InvalidHealthProfileMeasurementError() *AppError {
+	err := errors.New(appconstant.MsgInvalidHealthProfileMeasurement)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidHealthProfileMeasurement)
+}
+
+func ConsultationNoteNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgConsultationNoteNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgConsultationNoteNotFound)
+}
+
+func SickLeaveNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgSickLeaveNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgSickLeaveNotFound)
+}
+
+func InvalidSickLeaveVerificationCodeError() *AppError {
+	err := errors.New(appconstant.MsgInvalidSickLeaveVerification)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgInvalidSickLeaveVerification)
+}
+
+func InvalidSickLeaveStartDateError() *AppError {
+	err := errors.New(appconstant.MsgInvalidSickLeaveStartDate)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidSickLeaveStartDate)
+}
+
+func RefreshTokenReusedError() *AppError {
+	err := errors.New(appconstant.MsgRefreshTokenReused)
+	return NewAppError(http.StatusUnauthorized, err, appconstant.MsgRefreshTokenReused)
+}
+
+func SessionNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgSessionNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgSessionNotFound)
+}
+
+func TooManyRequestsError(retryAfter int) *AppError {
+	err := errors.New(appconstant.MsgTooManyRequests)
+	appErr := NewAppError(http.StatusTooManyRequests, err, appconstant.MsgTooManyRequests)
+	appErr.RetryAfter = retryAfter
+	return appErr
+}
+
+func RequestBodyTooLargeError() *AppError {
+	err := errors.New(appconstant.MsgRequestBodyTooLarge)
+	return NewAppError(http.StatusRequestEntityTooLarge, err, appconstant.MsgRequestBodyTooLarge)
+}
+
+func AccountLockedError(retryAfter int) *AppError {
+	err := errors.New(appconstant.MsgAccountLocked)
+	appErr := NewAppError(http.StatusTooManyRequests, err, appconstant.MsgAccountLocked)
+	appErr.RetryAfter = retryAfter
+	return appErr
+}
+
+func TwoFactorRequiredError() *AppError {
+	err := errors.New(appconstant.MsgTwoFactorRequired)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgTwoFactorRequired)
+}
+
+func InvalidTwoFactorCodeError() *AppError {
+	err := errors.New(appconstant.MsgInvalidTwoFactorCode)
+	return NewAppError(http.StatusUnauthorized, err, appconstant.MsgInvalidTwoFactorCode)
+}
+
+func InvalidTwoFactorChallengeError() *AppError {
+	err := errors.New(appconstant.MsgInvalidTwoFactorChallenge)
+	return NewAppError(http.StatusUnauthorized, err, appconstant.MsgInvalidTwoFactorChallenge)
+}
+
+func TwoFactorAlreadyEnabledError() *AppError {
+	err := errors.New(appconstant.MsgTwoFactorAlreadyEnabled)
+	return NewAppError(http.StatusConflict, err, appconstant.MsgTwoFactorAlreadyEnabled)
+}
+
+func TwoFactorNotEnrolledError() *AppError {
+	err := errors.New(appconstant.MsgTwoFactorNotEnrolled)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgTwoFactorNotEnrolled)
+}
+
+func TwoFactorNotEnabledError() *AppError {
+	err := errors.New(appconstant.MsgTwoFactorNotEnabled)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgTwoFactorNotEnabled)
+}
+
+func TwoFactorMandatoryError() *AppError {
+	err := errors.New(appconstant.MsgTwoFactorMandatory)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgTwoFactorMandatory)
+}
+
+func RoleNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgRoleNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgRoleNotFound)
+}
+
+func InvalidPermissionError() *AppError {
+	err := errors.New(appconstant.MsgInvalidPermission)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidPermission)
+}
+
+func OperatorRoleRequiredError() *AppError {
+	err := errors.New(appconstant.MsgOperatorRoleRequired)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgOperatorRoleRequired)
+}
+
+func OwnRoleChangeError() *AppError {
+	err := errors.New(appconstant.MsgOwnRoleChange)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgOwnRoleChange)
+}
+
+func RolesManagePermissionRequiredError() *AppError {
+	err := errors.New(appconstant.MsgRolesManagePermissionRequired)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgRolesManagePermissionRequired)
+}
+
+func OidcProviderNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgOidcProviderNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgOidcProviderNotFound)
+}
+
+func InvalidOidcLoginError(err error) *AppError {
+	return NewAppError(http.StatusUnauthorized, err, appconstant.MsgInvalidOidcLogin)
+}
+
+func OidcEmailNotVerifiedError() *AppError {
+	err := errors.New(appconstant.MsgOidcEmailNotVerified)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgOidcEmailNotVerified)
+}
+
+func OidcAccountLinkRequiredError() *AppError {
+	err := errors.New(appconstant.MsgOidcAccountLinkRequired)
+	return NewAppError(http.StatusForbidden, err, appconstant.MsgOidcAccountLinkRequired)
+}
+
+func OidcIdentityLinkedError() *AppError {
+	err := errors.New(appconstant.MsgOidcIdentityLinked)
+	return NewAppError(http.StatusConflict, err, appconstant.MsgOidcIdentityLinked)
+}
+
+func AdminFileNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgAdminFileNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgAdminFileNotFound)
+}
+
+func EmailOutboxNotFoundError() *AppError {
+	err := errors.New(appconstant.MsgEmailOutboxNotFound)
+	return NewAppError(http.StatusNotFound, err, appconstant.MsgEmailOutboxNotFound)
+}
+
+func InvalidLastEventIdError() *AppError {
+	err := errors.New(appconstant.MsgInvalidLastEventId)
+	return NewAppError(http.StatusBadRequest, err, appconstant.MsgInvalidLastEventId)
+}
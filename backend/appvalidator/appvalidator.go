@@ -1,8 +1,12 @@
 package appvalidator
 
 import (
+	"strings"
 	"unicode"
 
+	"max-health/appconstant"
+	"max-health/util"
+
 	"github.com/gin-gonic/gin/binding"
 	"github.com/go-playground/validator/v10"
 )
@@ -10,6 +14,7 @@ import (
 func AppValidator() {
 	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
 		v.RegisterValidation("ValidPassword", ValidPassword)
+		v.RegisterValidation("ICD10", ValidICD10)
 	}
 }
 
@@ -40,3 +45,10 @@ func ValidPassword(fl validator.FieldLevel) bool {
 	}
 	return false
 }
+
+func ValidICD10(fl validator.FieldLevel) bool {
+	if code, ok := fl.Field().Interface().(string); ok {
+		return util.RegexValidate(strings.ToUpper(strings.TrimSpace(code)), appconstant.ICD10RegexPattern)
+	}
+	return false
+}
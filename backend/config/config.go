@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -10,25 +11,36 @@ import (
 )
 
 type Config struct {
-	Port                string
-	FEPort              string
-	DbUrl               string
-	Issuer              string
-	SendEmailIdentity   string
-	SendEmailUsername   string
-	SendEmailPassword   string
-	SendEmailHost       string
-	SendEmailPort       string
-	VerifSecret         string
-	AccessSecret        string
-	RefreshSecret       string
-	ResetPasswordSecret string
-	CentrifugoSecret    string
-	RajaOngkirApiKey    string
-	HashCost            int
-	GracefulPeriod      int
-	PersonalPassword    string
-	AllowOrigins        []string
+	Port               string
+	AdminPort          string
+	FEPort             string
+	DbUrl              string
+	Issuer             string
+	SendEmailIdentity  string
+	SendEmailUsername  string
+	SendEmailPassword  string
+	SendEmailHost      string
+	SendEmailPort      string
+	EmailFrom          string
+	EmailMode          string
+	EmailSinkDir       string
+	EmailTemplatesDir  string
+	CentrifugoApiUrl   string
+	CentrifugoApiKey   string
+	CentrifugoSecret   string
+	JwtKeysDir         string
+	JwtActiveKeyId     string
+	PrescriptionSecret string
+	TwoFactorKey       string
+	RajaOngkirApiKey   string
+	HashCost           int
+	GracefulPeriod     int
+	AllowOrigins       []string
+	TrustedProxies     []string
+	PublicBaseUrl      string
+	OidcProviders      []OidcProviderConfig
+	OtelEndpoint       string
+	OtelServiceName    string
 }
 
 var (
@@ -36,6 +48,8 @@ var (
 	CentrifugoUrl string
 )
 
+const minSecretKeyLength = 32
+
 func Init(log *logrus.Logger) *Config {
 	err := godotenv.Load()
 	if err != nil {
@@ -59,29 +73,79 @@ func Init(log *logrus.Logger) *Config {
 		}).Fatal("error loading .env file")
 	}
 
+	emailFrom := os.Getenv("EMAIL_FROM")
+	if emailFrom == "" {
+		emailFrom = os.Getenv("SEND_EMAIL_USERNAME")
+	}
+
+	emailMode := os.Getenv("EMAIL_MODE")
+	if emailMode == "" {
+		emailMode = "smtp"
+	}
+
+	otelServiceName := os.Getenv("OTEL_SERVICE_NAME")
+	if otelServiceName == "" {
+		otelServiceName = "max-health-backend"
+	}
+
+	prescriptionSecret := loadSecretKey(log, "PRESCRIPTION_SIGNATURE_SECRET_KEY")
+	twoFactorKey := loadSecretKey(log, "TWO_FACTOR_ENCRYPTION_KEY")
+
+	publicBaseUrl := strings.TrimSuffix(os.Getenv("PUBLIC_BASE_URL"), "/")
+	if publicBaseUrl == "" {
+		publicBaseUrl = "https://" + EmailHost
+	}
+
 	allowOriginsStr := os.Getenv("ALLOW_ORIGINS")
 
 	allowOrigins := strings.Split(allowOriginsStr, ",")
 
+	var trustedProxies []string
+	if trustedProxiesStr := os.Getenv("TRUSTED_PROXIES"); trustedProxiesStr != "" {
+		trustedProxies = strings.Split(trustedProxiesStr, ",")
+	}
+
 	return &Config{
-		Port:                os.Getenv("BE_PORT"),
-		FEPort:              os.Getenv("FE_PORT"),
-		DbUrl:               os.Getenv("DATABASE_URL"),
-		Issuer:              os.Getenv("ISSUER"),
-		SendEmailIdentity:   os.Getenv("SEND_EMAIL_IDENTITY"),
-		SendEmailUsername:   os.Getenv("SEND_EMAIL_USERNAME"),
-		SendEmailPassword:   os.Getenv("SEND_EMAIL_PASSWORD"),
-		SendEmailHost:       os.Getenv("SEND_EMAIL_HOST"),
-		SendEmailPort:       os.Getenv("SEND_EMAIL_PORT"),
-		VerifSecret:         os.Getenv("VERIFICATION_CODE_SECRET_KEY"),
-		AccessSecret:        os.Getenv("ACCESS_TOKEN_SECRET_KEY"),
-		RefreshSecret:       os.Getenv("REFRESH_TOKEN_SECRET_KEY"),
-		ResetPasswordSecret: os.Getenv("RESET_PASSWORD_SECRET_KEY"),
-		CentrifugoSecret:    os.Getenv("CENTRIFUGO_SECRET"),
-		RajaOngkirApiKey:    os.Getenv("RAJA_ONGKIR_API_KEY"),
-		HashCost:            hashCost,
-		GracefulPeriod:      gracefulPeriod,
-		PersonalPassword:    os.Getenv("PERSONAL_PASSWORD"),
-		AllowOrigins:        allowOrigins,
+		Port:               os.Getenv("BE_PORT"),
+		AdminPort:          os.Getenv("ADMIN_PORT"),
+		FEPort:             os.Getenv("FE_PORT"),
+		DbUrl:              os.Getenv("DATABASE_URL"),
+		Issuer:             os.Getenv("ISSUER"),
+		SendEmailIdentity:  os.Getenv("SEND_EMAIL_IDENTITY"),
+		SendEmailUsername:  os.Getenv("SEND_EMAIL_USERNAME"),
+		SendEmailPassword:  os.Getenv("SEND_EMAIL_PASSWORD"),
+		SendEmailHost:      os.Getenv("SEND_EMAIL_HOST"),
+		SendEmailPort:      os.Getenv("SEND_EMAIL_PORT"),
+		EmailFrom:          emailFrom,
+		EmailMode:          emailMode,
+		EmailSinkDir:       os.Getenv("EMAIL_SINK_DIR"),
+		EmailTemplatesDir:  os.Getenv("EMAIL_TEMPLATES_DIR"),
+		CentrifugoApiUrl:   strings.TrimSuffix(os.Getenv("CENTRIFUGO_API_URL"), "/"),
+		CentrifugoApiKey:   os.Getenv("CENTRIFUGO_API_KEY"),
+		CentrifugoSecret:   os.Getenv("CENTRIFUGO_SECRET"),
+		JwtKeysDir:         os.Getenv("JWT_KEYS_DIR"),
+		JwtActiveKeyId:     os.Getenv("JWT_ACTIVE_KEY_ID"),
+		PrescriptionSecret: prescriptionSecret,
+		TwoFactorKey:       twoFactorKey,
+		RajaOngkirApiKey:   os.Getenv("RAJA_ONGKIR_API_KEY"),
+		HashCost:           hashCost,
+		GracefulPeriod:     gracefulPeriod,
+		AllowOrigins:       allowOrigins,
+		TrustedProxies:     trustedProxies,
+		PublicBaseUrl:      publicBaseUrl,
+		OidcProviders:      loadOidcProviders(),
+		OtelEndpoint:       os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		OtelServiceName:    otelServiceName,
 	}
 }
+
+func loadSecretKey(log *logrus.Logger, name string) string {
+	key := os.Getenv(name)
+	if len(key) < minSecretKeyLength {
+		log.WithFields(logrus.Fields{
+			"error": fmt.Sprintf("%s must be at least %d characters", name, minSecretKeyLength),
+		}).Fatal("error loading .env file")
+	}
+
+	return key
+}
@@ -69,4 +69,38 @@ const (
 		SET deleted_at = NOW(), updated_at = NOW()
 		WHERE account_id = $1
 	`
+
+	FindAccountLockRemainingSecondsQuery = `
+		SELECT GREATEST(CEIL(EXTRACT(EPOCH FROM (COALESCE(locked_until, NOW()) - NOW()))), 0)::INT
+		FROM accounts
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	RecordFailedLoginQuery = `
+		UPDATE accounts
+		SET failed_login_attempts = failed_login_attempts + 1,
+		locked_until = CASE
+			WHEN (failed_login_attempts + 1) % $2 = 0
+			THEN NOW() + LEAST($3 * POWER(2, (failed_login_attempts + 1) / $2 - 1), $4) * INTERVAL '1 second'
+			ELSE locked_until
+		END,
+		updated_at = NOW()
+		WHERE account_id = $1
+		RETURNING failed_login_attempts, GREATEST(CEIL(EXTRACT(EPOCH FROM (COALESCE(locked_until, NOW()) - NOW()))), 0)::INT
+	`
+
+	ResetFailedLoginsQuery = `
+		UPDATE accounts
+		SET failed_login_attempts = 0, locked_until = NULL, updated_at = NOW()
+		WHERE account_id = $1
+		AND (failed_login_attempts > 0 OR locked_until IS NOT NULL)
+	`
+
+	VerifyAccountQuery = `
+		UPDATE accounts
+		SET verified_at = COALESCE(verified_at, NOW()), updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
 )
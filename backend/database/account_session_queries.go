@@ -0,0 +1,55 @@
+package database
+
+const (
+	CreateAccountSessionQuery = `
+		INSERT
+		INTO account_sessions (session_id, account_id, user_agent, ip_address, expired_at)
+		VALUES ($1, $2, $3, $4, NOW() + INTERVAL '1 day')
+	`
+
+	AccountSessionQuery = `
+		SELECT session_id, account_id, user_agent, ip_address, last_used_at, expired_at, created_at
+		FROM account_sessions
+		WHERE revoked_at IS NULL
+		AND expired_at > NOW()
+		AND deleted_at IS NULL
+	`
+
+	FindActiveAccountSessionQuery = AccountSessionQuery + `
+		AND session_id = $1
+	`
+
+	GetActiveAccountSessionsByAccountIdQuery = AccountSessionQuery + `
+		AND account_id = $1
+		ORDER BY last_used_at DESC
+	`
+
+	RefreshAccountSessionQuery = `
+		UPDATE account_sessions
+		SET last_used_at = NOW(), expired_at = NOW() + INTERVAL '1 day', updated_at = NOW()
+		WHERE session_id = $1
+	`
+
+	RevokeAccountSessionQuery = `
+		UPDATE account_sessions
+		SET revoked_at = NOW(), revoked_reason = $2, updated_at = NOW()
+		WHERE session_id = $1
+		AND revoked_at IS NULL
+	`
+
+	RevokeAccountSessionByAccountIdQuery = `
+		UPDATE account_sessions
+		SET revoked_at = NOW(), revoked_reason = $3, updated_at = NOW()
+		WHERE session_id = $1
+		AND account_id = $2
+		AND revoked_at IS NULL
+		AND expired_at > NOW()
+	`
+
+	RevokeAllAccountSessionsQuery = `
+		UPDATE account_sessions
+		SET revoked_at = NOW(), revoked_reason = $2, updated_at = NOW()
+		WHERE account_id = $1
+		AND revoked_at IS NULL
+	`
+)
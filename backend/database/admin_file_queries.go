@@ -0,0 +1,38 @@
+package database
+
+const (
+	CreateAdminFileQuery = `
+		INSERT INTO admin_files (public_id, file_url, file_name, content_type, file_size, uploaded_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING admin_file_id, created_at
+	`
+
+	FindAllAdminFilesQuery = `
+		SELECT admin_file_id, public_id, file_url, file_name, content_type, file_size, uploaded_by, created_at
+		FROM admin_files
+		WHERE deleted_at IS NULL
+		ORDER BY created_at DESC, admin_file_id DESC
+		LIMIT $1
+		OFFSET $2
+	`
+
+	FindAllAdminFilesTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM admin_files
+		WHERE deleted_at IS NULL
+	`
+
+	FindOneAdminFileByIdQuery = `
+		SELECT admin_file_id, public_id, file_url, file_name, content_type, file_size, uploaded_by, created_at
+		FROM admin_files
+		WHERE admin_file_id = $1
+		AND deleted_at IS NULL
+	`
+
+	DeleteOneAdminFileByIdQuery = `
+		UPDATE admin_files
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE admin_file_id = $1
+		AND deleted_at IS NULL
+	`
+)
@@ -0,0 +1,14 @@
+package database
+
+const (
+	CreateAuditLogQuery = `
+		INSERT INTO audit_logs (actor_account_id, actor_role, action, entity_type, entity_id, before_data, after_data, request_id, ip_address)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	FindAllAuditLogsQuery = `
+		SELECT audit_log_id, actor_account_id, actor_role, action, entity_type, entity_id, before_data, after_data, request_id, ip_address, created_at, COUNT(*) OVER()
+		FROM audit_logs
+		WHERE TRUE
+	`
+)
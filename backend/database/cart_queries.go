@@ -60,8 +60,8 @@ const (
 	`
 
 	PostOneCartQuery = `
-		INSERT INTO cart_items (user_id, pharmacy_drug_id,quantity)
-		VALUES($1, $2, $3)
+		INSERT INTO cart_items (user_id, pharmacy_drug_id, quantity, prescription_id)
+		VALUES($1, $2, $3, $4)
 		RETURNING cart_item_id
 	`
 
@@ -98,8 +98,14 @@ const (
 		where ci.cart_item_id = $1
 	`
 
+	GetCartPrescriptionQuery = `
+		SELECT pharmacy_drug_id, prescription_id
+		FROM cart_items
+		WHERE cart_item_id = $1 AND deleted_at IS NULL
+	`
+
 	GetAllDetailedCartItems = `
-		SELECT ci.cart_item_id, d.drug_id, d.drug_name, pd.pharmacy_drug_id, pd.price, d.unit_in_pack, ci.quantity
+		SELECT ci.cart_item_id, d.drug_id, d.drug_name, pd.pharmacy_drug_id, pd.price, d.unit_in_pack, ci.quantity, ci.prescription_id, d.is_prescription_required
 		FROM cart_items ci
 		JOIN pharmacy_drugs pd
 		ON pd.pharmacy_drug_id = ci.pharmacy_drug_id
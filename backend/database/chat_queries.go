@@ -2,16 +2,34 @@ package database
 
 const (
 	PostOneChatQuery = `
-		INSERT INTO chats (chat_room_id, sender_account_id, chat_message, attachment_format, attachment_url, prescription_id)
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO chats (chat_room_id, sender_account_id, chat_message, attachment_format, attachment_url, prescription_id, sick_leave_certificate_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING chat_id, created_at
 	`
 
-	GetAllChatQuery = `
-		SELECT c.chat_id, c.sender_account_id, c.chat_message, c.attachment_format, c.attachment_url, c.prescription_id, p.redeemed_at, c.created_at
+	GetChatsAfterIdQuery = `
+		SELECT c.chat_id, c.sender_account_id, c.chat_message, c.attachment_format, c.attachment_url, c.prescription_id, p.redeemed_at,
+			c.sick_leave_certificate_id, slc.start_date, slc.days, slc.diagnosis_summary, slc.verification_code, slc.created_at, c.created_at
 		FROM chats c
 		LEFT JOIN prescriptions p ON p.prescription_id = c.prescription_id
-		WHERE chat_room_id = $1
+		LEFT JOIN sick_leave_certificates slc ON slc.sick_leave_certificate_id = c.sick_leave_certificate_id
+		WHERE c.chat_room_id = $1
+		AND c.chat_id > $2
 		AND c.deleted_at IS NULL
+		ORDER BY c.chat_id ASC
+		LIMIT $3
+	`
+
+	GetChatHistoryQuery = `
+		SELECT c.chat_id, c.sender_account_id, c.chat_message, c.attachment_format, c.attachment_url, c.prescription_id, p.redeemed_at,
+			c.sick_leave_certificate_id, slc.start_date, slc.days, slc.diagnosis_summary, slc.verification_code, slc.created_at, c.created_at
+		FROM chats c
+		LEFT JOIN prescriptions p ON p.prescription_id = c.prescription_id
+		LEFT JOIN sick_leave_certificates slc ON slc.sick_leave_certificate_id = c.sick_leave_certificate_id
+		WHERE c.chat_room_id = $1
+		AND ($2::BIGINT IS NULL OR c.chat_id < $2)
+		AND c.deleted_at IS NULL
+		ORDER BY c.chat_id DESC
+		LIMIT $3
 	`
 )
@@ -0,0 +1,34 @@
+package database
+
+const (
+	UpsertLastDeliveredChatQuery = `
+		INSERT INTO chat_read_states (chat_room_id, account_id, last_delivered_chat_id)
+		SELECT $1, $2, $3
+		WHERE EXISTS (
+			SELECT 1
+			FROM chats
+			WHERE chat_id = $3
+			AND chat_room_id = $1
+			AND deleted_at IS NULL
+		)
+		ON CONFLICT (chat_room_id, account_id) DO UPDATE
+		SET last_delivered_chat_id = GREATEST(chat_read_states.last_delivered_chat_id, EXCLUDED.last_delivered_chat_id),
+			updated_at = NOW()
+	`
+
+	UpsertLastReadChatQuery = `
+		INSERT INTO chat_read_states (chat_room_id, account_id, last_delivered_chat_id, last_read_chat_id)
+		SELECT $1, $2, $3, $3
+		WHERE EXISTS (
+			SELECT 1
+			FROM chats
+			WHERE chat_id = $3
+			AND chat_room_id = $1
+			AND deleted_at IS NULL
+		)
+		ON CONFLICT (chat_room_id, account_id) DO UPDATE
+		SET last_delivered_chat_id = GREATEST(chat_read_states.last_delivered_chat_id, EXCLUDED.last_delivered_chat_id),
+			last_read_chat_id = GREATEST(chat_read_states.last_read_chat_id, EXCLUDED.last_read_chat_id),
+			updated_at = NOW()
+	`
+)
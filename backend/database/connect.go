@@ -5,12 +5,20 @@ import (
 
 	"max-health/config"
 
+	"github.com/XSAM/otelsql"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/sirupsen/logrus"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
 func ConnectDB(config *config.Config, log *logrus.Logger) *sql.DB {
-	db, err := sql.Open("pgx", config.DbUrl)
+	db, err := otelsql.Open("pgx", config.DbUrl,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		log.WithFields(logrus.Fields{
 			"error": err.Error(),
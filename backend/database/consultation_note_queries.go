@@ -0,0 +1,81 @@
+package database
+
+const (
+	UpsertConsultationNoteQuery = `
+		INSERT INTO consultation_notes (ws_chat_room_id, user_account_id, doctor_account_id, complaint, assessment, plan)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (ws_chat_room_id)
+		DO UPDATE SET complaint = EXCLUDED.complaint,
+		assessment = EXCLUDED.assessment,
+		plan = EXCLUDED.plan,
+		updated_at = NOW(),
+		deleted_at = NULL
+		RETURNING consultation_note_id
+	`
+
+	ConsultationNoteQuery = `
+		SELECT cn.consultation_note_id, cn.ws_chat_room_id, cn.user_account_id, cn.doctor_account_id, a.account_name,
+			cn.complaint, cn.assessment, cn.plan, cn.created_at, cn.updated_at
+		FROM consultation_notes cn
+		JOIN accounts a ON a.account_id = cn.doctor_account_id
+		WHERE cn.deleted_at IS NULL
+	`
+
+	FindConsultationNoteByIdQuery = ConsultationNoteQuery + `
+		AND cn.consultation_note_id = $1
+	`
+
+	FindConsultationNoteByRoomIdQuery = ConsultationNoteQuery + `
+		AND cn.ws_chat_room_id = $1
+	`
+
+	GetConsultationNotesByUserAccountIdQuery = ConsultationNoteQuery + `
+		AND cn.user_account_id = $1
+		ORDER BY cn.created_at DESC, cn.consultation_note_id DESC
+	`
+
+	GetConsultationDiagnosesByNoteIdQuery = `
+		SELECT icd10_code, description
+		FROM consultation_note_diagnoses
+		WHERE consultation_note_id = $1
+		AND deleted_at IS NULL
+		ORDER BY consultation_note_diagnosis_id
+	`
+
+	DeleteConsultationDiagnosesByNoteIdQuery = `
+		UPDATE consultation_note_diagnoses
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE consultation_note_id = $1
+		AND deleted_at IS NULL
+	`
+
+	CreateConsultationDiagnosisQuery = `
+		INSERT INTO consultation_note_diagnoses (consultation_note_id, icd10_code, description)
+		VALUES ($1, $2, $3)
+	`
+
+	MedicalHistoryQuery = `
+		SELECT 'consultation_note' AS entry_type, cn.consultation_note_id AS entry_id, cn.created_at AS occurred_at
+		FROM consultation_notes cn
+		WHERE cn.user_account_id = $1
+		AND cn.deleted_at IS NULL
+		UNION ALL
+		SELECT 'prescription' AS entry_type, p.prescription_id AS entry_id, p.created_at AS occurred_at
+		FROM prescriptions p
+		WHERE p.user_account_id = $1
+		AND p.deleted_at IS NULL
+	`
+
+	GetMedicalHistoryByUserAccountIdQuery = `
+		SELECT entry_type, entry_id, occurred_at
+		FROM (` + MedicalHistoryQuery + `) medical_history
+		ORDER BY occurred_at DESC, entry_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetMedicalHistoryByUserAccountIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM (` + MedicalHistoryQuery + `) medical_history
+	`
+)
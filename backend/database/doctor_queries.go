@@ -3,8 +3,8 @@ package database
 const (
 	PostOneDoctorQuery = `
 		INSERT 
-		INTO doctors (account_id, specialization_id, certificate, fee_per_patient, is_online)
-		VALUES ($1, $2, $3, 0, FALSE)
+		INTO doctors (account_id, specialization_id, certificate, fee_per_patient, is_online, verification_status)
+		VALUES ($1, $2, $3, 0, FALSE, $4)
 	`
 
 	UpdateOneDoctorQuery = `
@@ -22,26 +22,31 @@ const (
 	`
 
 	FindDoctorByAccountIdQuery = `
-		SELECT d.doctor_id, d.experience, d.specialization_id, ds.specialization_name, d.fee_per_patient, d.certificate
+		SELECT d.doctor_id, d.experience, d.specialization_id, ds.specialization_name, d.fee_per_patient, d.certificate, d.verification_status, d.rejection_reason,
+		COALESCE(dr.average_rating, 0)::FLOAT8, COALESCE(dr.review_count, 0)
 		FROM doctors d
 		JOIN accounts a
 		ON d.account_id = a.account_id
 		LEFT JOIN doctor_specializations ds
 		ON d.specialization_id = ds.specialization_id
+		` + DoctorRatingJoin + `
 		WHERE d.account_id = $1
 		AND a.verified_at IS NOT NULL
 		AND (d.deleted_at IS NULL AND a.deleted_at IS NULL)
 	`
 
 	FindDoctorByDoctorIdQuery = `
-		SELECT d.doctor_id, a.email, a.account_name, a.profile_picture, d.experience, d.specialization_id, ds.specialization_name, d.fee_per_patient
+		SELECT d.doctor_id, a.email, a.account_name, a.profile_picture, d.experience, d.specialization_id, ds.specialization_name, d.fee_per_patient,
+		COALESCE(dr.average_rating, 0)::FLOAT8, COALESCE(dr.review_count, 0)
 		FROM doctors d
 		JOIN accounts a
 		ON d.account_id = a.account_id
 		LEFT JOIN doctor_specializations ds
 		ON d.specialization_id = ds.specialization_id
+		` + DoctorRatingJoin + `
 		WHERE d.doctor_id = $1
 		AND a.verified_at IS NOT NULL
+		AND d.verification_status = 'approved'
 		AND (d.deleted_at IS NULL AND a.deleted_at IS NULL)
 	`
 
@@ -58,4 +63,43 @@ const (
 		WHERE d.account_id = $1 
 		AND deleted_at IS NULL
 	`
+
+	GetDoctorVerificationQuery = `
+		SELECT d.doctor_id, d.account_id, a.account_name, a.email, ds.specialization_name, d.certificate, d.verification_status, d.rejection_reason, d.reviewed_at, d.created_at
+		FROM doctors d
+		JOIN accounts a
+		ON d.account_id = a.account_id
+		LEFT JOIN doctor_specializations ds
+		ON d.specialization_id = ds.specialization_id
+		WHERE a.verified_at IS NOT NULL
+		AND (d.deleted_at IS NULL AND a.deleted_at IS NULL)
+	`
+
+	GetAllDoctorVerificationByStatusQuery = GetDoctorVerificationQuery + `
+		AND d.verification_status = $1
+		ORDER BY d.created_at ASC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetAllDoctorVerificationByStatusTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM doctors d
+		JOIN accounts a
+		ON d.account_id = a.account_id
+		WHERE a.verified_at IS NOT NULL
+		AND d.verification_status = $1
+		AND (d.deleted_at IS NULL AND a.deleted_at IS NULL)
+	`
+
+	FindDoctorVerificationByDoctorIdQuery = GetDoctorVerificationQuery + `
+		AND d.doctor_id = $1
+	`
+
+	UpdateDoctorVerificationQuery = `
+		UPDATE doctors
+		SET verification_status = $2, rejection_reason = $3, reviewed_at = NOW(), updated_at = NOW()
+		WHERE doctor_id = $1
+		AND deleted_at IS NULL
+	`
 )
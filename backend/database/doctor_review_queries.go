@@ -0,0 +1,72 @@
+package database
+
+const (
+	DoctorRatingJoin = `
+		LEFT JOIN (
+			SELECT doctor_account_id, AVG(rating) AS average_rating, COUNT(*) AS review_count
+			FROM doctor_reviews
+			WHERE is_hidden = FALSE
+			AND deleted_at IS NULL
+			GROUP BY doctor_account_id
+		) dr ON dr.doctor_account_id = d.account_id
+	`
+
+	CreateDoctorReviewQuery = `
+		INSERT INTO doctor_reviews (ws_chat_room_id, user_account_id, doctor_account_id, rating, review)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING doctor_review_id
+	`
+
+	GetDoctorReviewQuery = `
+		SELECT dr.doctor_review_id, dr.ws_chat_room_id, dr.user_account_id, a.account_name, a.profile_picture, dr.doctor_account_id, dr.rating, dr.review, dr.is_hidden, dr.hidden_reason, dr.created_at
+		FROM doctor_reviews dr
+		JOIN accounts a
+		ON a.account_id = dr.user_account_id
+		WHERE dr.deleted_at IS NULL
+	`
+
+	FindDoctorReviewByIdQuery = GetDoctorReviewQuery + `
+		AND dr.doctor_review_id = $1
+	`
+
+	FindDoctorReviewByRoomIdQuery = GetDoctorReviewQuery + `
+		AND dr.ws_chat_room_id = $1
+	`
+
+	GetAllVisibleDoctorReviewByDoctorIdQuery = GetDoctorReviewQuery + `
+		AND dr.is_hidden = FALSE
+		AND dr.doctor_account_id = (SELECT account_id FROM doctors WHERE doctor_id = $1)
+		ORDER BY dr.created_at DESC, dr.doctor_review_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetAllVisibleDoctorReviewByDoctorIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM doctor_reviews dr
+		WHERE dr.deleted_at IS NULL
+		AND dr.is_hidden = FALSE
+		AND dr.doctor_account_id = (SELECT account_id FROM doctors WHERE doctor_id = $1)
+	`
+
+	GetAllDoctorReviewQuery = GetDoctorReviewQuery + `
+		AND ($1 = '' OR ($1 = 'hidden' AND dr.is_hidden = TRUE) OR ($1 = 'visible' AND dr.is_hidden = FALSE))
+		ORDER BY dr.created_at DESC, dr.doctor_review_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetAllDoctorReviewTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM doctor_reviews dr
+		WHERE dr.deleted_at IS NULL
+		AND ($1 = '' OR ($1 = 'hidden' AND dr.is_hidden = TRUE) OR ($1 = 'visible' AND dr.is_hidden = FALSE))
+	`
+
+	UpdateDoctorReviewVisibilityQuery = `
+		UPDATE doctor_reviews
+		SET is_hidden = $1, hidden_reason = $2, updated_at = NOW()
+		WHERE doctor_review_id = $3
+		AND deleted_at IS NULL
+	`
+)
@@ -0,0 +1,11 @@
+package database
+
+const (
+	FindDrugInteractionsQuery = `
+		SELECT drug_interaction_id, generic_name_a, generic_name_b, severity, description
+		FROM drug_interactions
+		WHERE LOWER(generic_name_a) = ANY($1)
+		AND LOWER(generic_name_b) = ANY($1)
+		AND deleted_at IS NULL
+	`
+)
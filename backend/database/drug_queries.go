@@ -146,6 +146,7 @@ const (
 	CreateOneDrugQuery = `
 		INSERT INTO drugs (drug_name, generic_name, content, manufacture, description, classification_id, form_id, drug_category_id, unit_in_pack, selling_unit, weight, height, length, width, image, is_prescription_required, is_active) VALUES
 		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
+		RETURNING drug_id
 	`
 
 	DeleteOneDrugQuery = `
@@ -190,4 +191,11 @@ const (
 		LIMIT $2
 		OFFSET $3
 	`
+
+	GetPrescribableDrugsByIdsQuery = `
+		SELECT drug_id, drug_name, generic_name, is_active, max_prescription_quantity
+		FROM drugs
+		WHERE drug_id = ANY($1)
+		AND deleted_at IS NULL
+	`
 )
@@ -0,0 +1,68 @@
+package database
+
+const (
+	CreateEmailOutboxQuery = `
+		INSERT INTO email_outbox (email_type, locale, recipient, template_data)
+		VALUES ($1, $2, $3, $4)
+		RETURNING email_outbox_id, status, next_attempt_at, created_at
+	`
+
+	ClaimDueEmailOutboxQuery = `
+		UPDATE email_outbox
+		SET attempts = attempts + 1,
+			next_attempt_at = NOW() + $1 * INTERVAL '1 second',
+			updated_at = NOW()
+		WHERE email_outbox_id IN (
+			SELECT email_outbox_id
+			FROM email_outbox
+			WHERE status = 'pending'
+			AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at, email_outbox_id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING email_outbox_id, email_type, locale, recipient, template_data, status, attempts, next_attempt_at, last_error, sent_at, created_at
+	`
+
+	MarkEmailOutboxSentQuery = `
+		UPDATE email_outbox
+		SET status = 'sent', sent_at = NOW(), last_error = NULL, template_data = '{}', updated_at = NOW()
+		WHERE email_outbox_id = $1
+		AND status = 'pending'
+	`
+
+	MarkEmailOutboxFailedQuery = `
+		UPDATE email_outbox
+		SET last_error = $2,
+			status = CASE WHEN attempts >= $3::INT THEN 'dead' ELSE status END,
+			template_data = CASE WHEN attempts >= $3::INT AND email_type = ANY($6::TEXT[]) THEN '{}' ELSE template_data END,
+			next_attempt_at = NOW() + LEAST($4::INT * POWER(2, attempts - 1), $5::INT) * INTERVAL '1 second',
+			updated_at = NOW()
+		WHERE email_outbox_id = $1
+		AND status = 'pending'
+		RETURNING status
+	`
+
+	FindAllEmailOutboxByStatusQuery = `
+		SELECT email_outbox_id, email_type, locale, recipient, status, attempts, next_attempt_at, last_error, sent_at, created_at
+		FROM email_outbox
+		WHERE status = $1
+		ORDER BY updated_at DESC, email_outbox_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	FindAllEmailOutboxByStatusTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM email_outbox
+		WHERE status = $1
+	`
+
+	RequeueDeadEmailOutboxQuery = `
+		UPDATE email_outbox
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW(), updated_at = NOW()
+		WHERE email_outbox_id = $1
+		AND status = 'dead'
+		AND template_data <> '{}'
+	`
+)
@@ -0,0 +1,29 @@
+package database
+
+const (
+	PurgeExpiredVerificationCodesQuery = `
+		DELETE FROM verification_codes
+		WHERE expired_at < NOW() - $1 * INTERVAL '1 hour'
+	`
+
+	PurgeExpiredResetPasswordTokensQuery = `
+		DELETE FROM reset_password_tokens
+		WHERE expired_at < NOW() - $1 * INTERVAL '1 hour'
+	`
+
+	PurgeExpiredRefreshTokensQuery = `
+		DELETE FROM refresh_tokens
+		WHERE expired_at < NOW() - $1 * INTERVAL '1 hour'
+	`
+
+	PurgeExpiredOidcLoginStatesQuery = `
+		DELETE FROM oidc_login_states
+		WHERE expired_at < NOW() - $1 * INTERVAL '1 hour'
+	`
+
+	PurgeSentEmailOutboxQuery = `
+		DELETE FROM email_outbox
+		WHERE status = 'sent'
+		AND sent_at < NOW() - $1 * INTERVAL '1 hour'
+	`
+)
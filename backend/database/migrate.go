@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+//go:embed migrations/*.sql
+var embeddedMigrations embed.FS
+
+var migrationFileRegex = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []entity.Migration
+}
+
+func NewMigrator(db *sql.DB) (*Migrator, error) {
+	migrations, err := LoadMigrations(embeddedMigrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+func LoadMigrations(migrationFS fs.FS) ([]entity.Migration, error) {
+	fileNames, err := fs.Glob(migrationFS, path.Join(appconstant.MigrationsDir, "*.sql"))
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int64]*entity.Migration{}
+
+	for _, fileName := range fileNames {
+		matches := migrationFileRegex.FindStringSubmatch(path.Base(fileName))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name %s", fileName)
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", fileName, err)
+		}
+
+		content, err := fs.ReadFile(migrationFS, fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &entity.Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == appconstant.MigrateUpCommand {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := []entity.Migration{}
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+func (m *Migrator) Up(ctx context.Context) ([]entity.Migration, error) {
+	migrated := []entity.Migration{}
+
+	err := m.withLock(ctx, func(conn *sql.Conn, applied map[int64]entity.MigrationStatus) error {
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			if err := runMigration(ctx, conn, migration.Up, CreateAppliedMigrationQuery, migration.Version, migration.Name); err != nil {
+				return fmt.Errorf("migration %d_%s up: %w", migration.Version, migration.Name, err)
+			}
+
+			migrated = append(migrated, migration)
+		}
+
+		return nil
+	})
+
+	return migrated, err
+}
+
+func (m *Migrator) Down(ctx context.Context, steps int) ([]entity.Migration, error) {
+	reverted := []entity.Migration{}
+
+	err := m.withLock(ctx, func(conn *sql.Conn, applied map[int64]entity.MigrationStatus) error {
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			if err := runMigration(ctx, conn, migration.Down, DeleteAppliedMigrationQuery, migration.Version); err != nil {
+				return fmt.Errorf("migration %d_%s down: %w", migration.Version, migration.Name, err)
+			}
+
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+
+	return reverted, err
+}
+
+func (m *Migrator) Baseline(ctx context.Context, version int64) ([]entity.Migration, error) {
+	baselined := []entity.Migration{}
+
+	known := false
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			known = true
+		}
+	}
+	if !known {
+		return baselined, fmt.Errorf("unknown migration version %d", version)
+	}
+
+	err := m.withLock(ctx, func(conn *sql.Conn, applied map[int64]entity.MigrationStatus) error {
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				break
+			}
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			if _, err := conn.ExecContext(ctx, CreateAppliedMigrationQuery, migration.Version, migration.Name); err != nil {
+				return fmt.Errorf("migration %d_%s baseline: %w", migration.Version, migration.Name, err)
+			}
+
+			baselined = append(baselined, migration)
+		}
+
+		return nil
+	})
+
+	return baselined, err
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]entity.MigrationStatus, error) {
+	statuses := []entity.MigrationStatus{}
+
+	err := m.withLock(ctx, func(conn *sql.Conn, applied map[int64]entity.MigrationStatus) error {
+		known := map[int64]bool{}
+
+		for _, migration := range m.migrations {
+			known[migration.Version] = true
+
+			status, ok := applied[migration.Version]
+			if !ok {
+				status = entity.MigrationStatus{
+					Version: migration.Version,
+					Name:    migration.Name,
+				}
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		for version, status := range applied {
+			if !known[version] {
+				statuses = append(statuses, status)
+			}
+		}
+
+		sort.Slice(statuses, func(i, j int) bool {
+			return statuses[i].Version < statuses[j].Version
+		})
+
+		return nil
+	})
+
+	return statuses, err
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn, applied map[int64]entity.MigrationStatus) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, AcquireMigrationLockQuery, int64(appconstant.MigrationAdvisoryLockKey)); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), ReleaseMigrationLockQuery, int64(appconstant.MigrationAdvisoryLockKey))
+
+	if _, err := conn.ExecContext(ctx, CreateSchemaMigrationsTableQuery); err != nil {
+		return err
+	}
+
+	applied, err := findAppliedMigrations(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	return fn(conn, applied)
+}
+
+func findAppliedMigrations(ctx context.Context, conn *sql.Conn) (map[int64]entity.MigrationStatus, error) {
+	rows, err := conn.QueryContext(ctx, FindAllAppliedMigrationsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[int64]entity.MigrationStatus{}
+
+	for rows.Next() {
+		var status entity.MigrationStatus
+
+		if err := rows.Scan(&status.Version, &status.Name, &status.AppliedAt); err != nil {
+			return nil, err
+		}
+
+		applied[status.Version] = status
+	}
+
+	return applied, rows.Err()
+}
+
+func runMigration(ctx context.Context, conn *sql.Conn, script string, recordQuery string, recordArgs ...any) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, recordQuery, recordArgs...); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
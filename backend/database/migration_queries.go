@@ -0,0 +1,35 @@
+package database
+
+const (
+	CreateSchemaMigrationsTableQuery = `
+		CREATE TABLE IF NOT EXISTS schema_migrations(
+			version BIGINT PRIMARY KEY,
+			name VARCHAR NOT NULL,
+			applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+		)
+	`
+
+	AcquireMigrationLockQuery = `
+		SELECT pg_advisory_lock($1)
+	`
+
+	ReleaseMigrationLockQuery = `
+		SELECT pg_advisory_unlock($1)
+	`
+
+	FindAllAppliedMigrationsQuery = `
+		SELECT version, name, applied_at
+		FROM schema_migrations
+		ORDER BY version
+	`
+
+	CreateAppliedMigrationQuery = `
+		INSERT INTO schema_migrations (version, name)
+		VALUES ($1, $2)
+	`
+
+	DeleteAppliedMigrationQuery = `
+		DELETE FROM schema_migrations
+		WHERE version = $1
+	`
+)
@@ -0,0 +1,39 @@
+DROP TABLE IF EXISTS
+prescription_drugs,
+prescriptions,
+chats,
+ws_chat_rooms,
+chat_rooms,
+stock_request_status,
+stock_mutation_requests,
+stock_changes,
+order_status,
+orders,
+order_pharmacies,
+order_items,
+drug_forms,
+drug_classifications,
+drugs,
+drug_categories,
+couriers,
+pharmacy_couriers,
+pharmacies,
+pharmacy_operationals,
+pharmacy_drugs,
+cart_items,
+user_addresses,
+subdistricts,
+districts,
+cities,
+provinces,
+pharmacy_managers,
+users,
+doctors,
+doctor_specializations,
+refresh_tokens,
+reset_password_tokens,
+verification_codes,
+accounts,
+genders,
+roles
+CASCADE;
@@ -1,43 +1,5 @@
-\c max_health_db
-
-DROP TABLE IF EXISTS 
-roles,
-accounts,
-verification_codes,
-reset_password_tokens,
-refresh_tokens,
-doctor_specializations,
-doctors,
-genders,
-users,
-pharmacy_managers,
-provinces,
-cities,
-districts,
-subdistricts,
-chat_rooms,
-ws_chat_rooms,
-chats,
-user_addresses,
-cart_items,
-pharmacy_drugs,
-pharmacy_operationals,
-pharmacies,
-pharmacy_couriers,
-couriers,
-drugs,
-drug_classifications,
-drug_categories,
-drug_forms,
-order_items,
-orders,
-order_pharmacies,
-order_status,
-stock_changes,
-stock_mutation_requests,
-prescriptions,
-prescription_drugs,
-stock_request_status;
+CREATE EXTENSION IF NOT EXISTS postgis;
+
 
 CREATE TABLE roles(
     role_id BIGSERIAL PRIMARY KEY,
@@ -350,7 +312,7 @@ CREATE TABLE orders(
     address VARCHAR NOT NULL,
     payment_proof TEXT NOT NULL DEFAULT '',
     total_amount DECIMAL NOT NULL,
-    expired_at TIMESTAMP NOT NULL DEFAULT NOW() + INTERVAL '1 DAY', 
+    expired_at TIMESTAMP NOT NULL DEFAULT NOW() + INTERVAL '1 DAY',
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     deleted_at TIMESTAMP DEFAULT NULL
@@ -402,7 +364,7 @@ CREATE TABLE chat_rooms(
     expired_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    deleted_at TIMESTAMP DEFAULT NULL 
+    deleted_at TIMESTAMP DEFAULT NULL
 );
 
 CREATE TABLE ws_chat_rooms(
@@ -413,7 +375,7 @@ CREATE TABLE ws_chat_rooms(
     expired_at BIGINT DEFAULT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
-    deleted_at TIMESTAMP DEFAULT NULL 
+    deleted_at TIMESTAMP DEFAULT NULL
 );
 
 CREATE TABLE chats(
@@ -444,9 +406,9 @@ CREATE TABLE prescription_drugs(
     prescription_drug_id BIGSERIAL PRIMARY KEY,
     prescription_id BIGINT NOT NULL,
     drug_id BIGINT NOT NULL,
-    quantity INTEGER NOT NULL,	
+    quantity INTEGER NOT NULL,
     note VARCHAR NOT NULL,
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     deleted_at TIMESTAMP DEFAULT NULL
-);
\ No newline at end of file
+);
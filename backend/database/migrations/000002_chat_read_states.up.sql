@@ -0,0 +1,11 @@
+CREATE TABLE chat_read_states(
+    chat_read_state_id BIGSERIAL PRIMARY KEY,
+    chat_room_id BIGINT NOT NULL,
+    account_id BIGINT NOT NULL,
+    last_delivered_chat_id BIGINT NOT NULL DEFAULT 0,
+    last_read_chat_id BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    UNIQUE (chat_room_id, account_id)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE prescriptions DROP COLUMN IF EXISTS signature;
+ALTER TABLE prescriptions DROP COLUMN IF EXISTS verification_code;
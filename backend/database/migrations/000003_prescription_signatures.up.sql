@@ -0,0 +1,2 @@
+ALTER TABLE prescriptions ADD COLUMN verification_code VARCHAR UNIQUE DEFAULT NULL;
+ALTER TABLE prescriptions ADD COLUMN signature VARCHAR DEFAULT NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS patient_allergies, drug_interactions;
+ALTER TABLE drugs DROP COLUMN IF EXISTS max_prescription_quantity;
@@ -0,0 +1,21 @@
+ALTER TABLE drugs ADD COLUMN max_prescription_quantity INTEGER DEFAULT NULL;
+
+CREATE TABLE drug_interactions(
+    drug_interaction_id BIGSERIAL PRIMARY KEY,
+    generic_name_a VARCHAR NOT NULL,
+    generic_name_b VARCHAR NOT NULL,
+    severity VARCHAR NOT NULL,
+    description VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE patient_allergies(
+    patient_allergy_id BIGSERIAL PRIMARY KEY,
+    user_account_id BIGINT NOT NULL,
+    allergen VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
@@ -0,0 +1,3 @@
+ALTER TABLE prescription_drugs DROP COLUMN IF EXISTS dispensed_quantity;
+ALTER TABLE prescriptions DROP COLUMN IF EXISTS refill_count;
+ALTER TABLE prescriptions DROP COLUMN IF EXISTS expired_at;
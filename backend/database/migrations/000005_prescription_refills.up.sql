@@ -0,0 +1,5 @@
+ALTER TABLE prescriptions ADD COLUMN expired_at TIMESTAMP NOT NULL DEFAULT NOW() + INTERVAL '30 days';
+ALTER TABLE prescriptions ADD COLUMN refill_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE prescription_drugs ADD COLUMN dispensed_quantity INTEGER NOT NULL DEFAULT 0;
+
+UPDATE prescriptions SET expired_at = created_at + INTERVAL '30 days';
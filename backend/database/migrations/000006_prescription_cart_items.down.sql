@@ -0,0 +1,2 @@
+ALTER TABLE order_items DROP COLUMN IF EXISTS prescription_id;
+ALTER TABLE cart_items DROP COLUMN IF EXISTS prescription_id;
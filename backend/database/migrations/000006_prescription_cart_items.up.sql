@@ -0,0 +1,2 @@
+ALTER TABLE cart_items ADD COLUMN prescription_id BIGINT DEFAULT NULL;
+ALTER TABLE order_items ADD COLUMN prescription_id BIGINT DEFAULT NULL;
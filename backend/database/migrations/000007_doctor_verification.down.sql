@@ -0,0 +1,3 @@
+ALTER TABLE doctors DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE doctors DROP COLUMN IF EXISTS rejection_reason;
+ALTER TABLE doctors DROP COLUMN IF EXISTS verification_status;
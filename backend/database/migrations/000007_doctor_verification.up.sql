@@ -0,0 +1,8 @@
+-- Doctors registered before verification existed were already trusted, so they start approved; new registrations start pending.
+ALTER TABLE doctors ADD COLUMN verification_status VARCHAR NOT NULL DEFAULT 'approved';
+ALTER TABLE doctors ADD COLUMN rejection_reason VARCHAR DEFAULT NULL;
+ALTER TABLE doctors ADD COLUMN reviewed_at TIMESTAMP DEFAULT NULL;
+
+UPDATE doctors SET reviewed_at = NOW();
+
+ALTER TABLE doctors ALTER COLUMN verification_status SET DEFAULT 'pending';
@@ -0,0 +1,13 @@
+CREATE TABLE doctor_reviews(
+    doctor_review_id BIGSERIAL PRIMARY KEY,
+    ws_chat_room_id BIGINT NOT NULL UNIQUE,
+    user_account_id BIGINT NOT NULL,
+    doctor_account_id BIGINT NOT NULL,
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    review TEXT DEFAULT NULL,
+    is_hidden BOOLEAN NOT NULL DEFAULT FALSE,
+    hidden_reason VARCHAR DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
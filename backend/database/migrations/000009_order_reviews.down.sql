@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pharmacy_reviews, order_item_reviews;
@@ -0,0 +1,29 @@
+CREATE TABLE order_item_reviews(
+    order_item_review_id BIGSERIAL PRIMARY KEY,
+    order_item_id BIGINT NOT NULL UNIQUE,
+    pharmacy_id BIGINT NOT NULL,
+    pharmacy_drug_id BIGINT NOT NULL,
+    drug_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    review TEXT DEFAULT NULL,
+    manager_reply TEXT DEFAULT NULL,
+    replied_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE pharmacy_reviews(
+    pharmacy_review_id BIGSERIAL PRIMARY KEY,
+    order_pharmacy_id BIGINT NOT NULL UNIQUE,
+    pharmacy_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    review TEXT DEFAULT NULL,
+    manager_reply TEXT DEFAULT NULL,
+    replied_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS health_profile_access_logs, patient_medications, patient_chronic_conditions, patient_health_profiles;
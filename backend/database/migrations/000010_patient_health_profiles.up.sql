@@ -0,0 +1,40 @@
+CREATE TABLE patient_health_profiles(
+    patient_health_profile_id BIGSERIAL PRIMARY KEY,
+    user_account_id BIGINT NOT NULL UNIQUE,
+    height_cm DECIMAL DEFAULT NULL,
+    weight_kg DECIMAL DEFAULT NULL,
+    pregnancy_status VARCHAR NOT NULL DEFAULT 'unknown',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE patient_chronic_conditions(
+    patient_chronic_condition_id BIGSERIAL PRIMARY KEY,
+    user_account_id BIGINT NOT NULL,
+    condition_name VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE patient_medications(
+    patient_medication_id BIGSERIAL PRIMARY KEY,
+    user_account_id BIGINT NOT NULL,
+    medication_name VARCHAR NOT NULL,
+    dosage VARCHAR DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE health_profile_access_logs(
+    health_profile_access_log_id BIGSERIAL PRIMARY KEY,
+    user_account_id BIGINT NOT NULL,
+    doctor_account_id BIGINT NOT NULL,
+    ws_chat_room_id BIGINT NOT NULL,
+    access_source VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
@@ -0,0 +1,22 @@
+CREATE TABLE consultation_notes(
+    consultation_note_id BIGSERIAL PRIMARY KEY,
+    ws_chat_room_id BIGINT NOT NULL UNIQUE,
+    user_account_id BIGINT NOT NULL,
+    doctor_account_id BIGINT NOT NULL,
+    complaint TEXT NOT NULL,
+    assessment TEXT NOT NULL,
+    plan TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE consultation_note_diagnoses(
+    consultation_note_diagnosis_id BIGSERIAL PRIMARY KEY,
+    consultation_note_id BIGINT NOT NULL,
+    icd10_code VARCHAR NOT NULL,
+    description VARCHAR DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
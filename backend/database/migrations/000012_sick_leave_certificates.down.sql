@@ -0,0 +1,2 @@
+ALTER TABLE chats DROP COLUMN IF EXISTS sick_leave_certificate_id;
+DROP TABLE IF EXISTS sick_leave_certificates;
@@ -0,0 +1,16 @@
+CREATE TABLE sick_leave_certificates(
+    sick_leave_certificate_id BIGSERIAL PRIMARY KEY,
+    ws_chat_room_id BIGINT NOT NULL,
+    user_account_id BIGINT NOT NULL,
+    doctor_account_id BIGINT NOT NULL,
+    start_date DATE NOT NULL,
+    days INTEGER NOT NULL CHECK (days >= 1),
+    diagnosis_summary TEXT NOT NULL,
+    verification_code VARCHAR NOT NULL UNIQUE,
+    signature VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+ALTER TABLE chats ADD COLUMN sick_leave_certificate_id BIGINT;
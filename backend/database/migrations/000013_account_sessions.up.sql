@@ -0,0 +1,22 @@
+CREATE TABLE account_sessions(
+    account_session_id BIGSERIAL PRIMARY KEY,
+    session_id VARCHAR NOT NULL UNIQUE,
+    account_id BIGINT NOT NULL,
+    user_agent VARCHAR NOT NULL DEFAULT '',
+    ip_address VARCHAR NOT NULL DEFAULT '',
+    last_used_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expired_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP DEFAULT NULL,
+    revoked_reason VARCHAR DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+-- Refresh tokens issued before sessions existed cannot be bound to one, so their holders sign in again.
+DELETE FROM refresh_tokens;
+
+ALTER TABLE refresh_tokens ADD COLUMN session_id VARCHAR NOT NULL;
+ALTER TABLE refresh_tokens ADD COLUMN used_at TIMESTAMP DEFAULT NULL;
+ALTER TABLE refresh_tokens ADD COLUMN revoked_at TIMESTAMP DEFAULT NULL;
+ALTER TABLE refresh_tokens ADD CONSTRAINT refresh_tokens_refresh_token_key UNIQUE (refresh_token);
@@ -0,0 +1,2 @@
+ALTER TABLE accounts DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE accounts DROP COLUMN IF EXISTS failed_login_attempts;
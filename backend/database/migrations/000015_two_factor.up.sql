@@ -0,0 +1,20 @@
+CREATE TABLE account_two_factors(
+    account_two_factor_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL UNIQUE,
+    secret VARCHAR NOT NULL,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    enabled_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE two_factor_recovery_codes(
+    two_factor_recovery_code_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    code_hash VARCHAR NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
@@ -0,0 +1,4 @@
+DELETE FROM roles WHERE role_name IN ('finance', 'catalog editor');
+DROP TABLE IF EXISTS role_permissions, permissions;
+ALTER TABLE roles DROP COLUMN IF EXISTS two_factor_required;
+ALTER TABLE roles DROP COLUMN IF EXISTS is_operator;
@@ -0,0 +1,72 @@
+ALTER TABLE roles ADD COLUMN is_operator BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE roles ADD COLUMN two_factor_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE permissions(
+    permission_id BIGSERIAL PRIMARY KEY,
+    permission_name VARCHAR NOT NULL UNIQUE,
+    description VARCHAR NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE role_permissions(
+    role_permission_id BIGSERIAL PRIMARY KEY,
+    role_id BIGINT NOT NULL,
+    permission_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    UNIQUE (role_id, permission_id)
+);
+
+INSERT INTO permissions (permission_name, description)
+VALUES
+('users.profile', 'View and update own user profile'),
+('addresses.manage', 'Manage own delivery addresses'),
+('carts.manage', 'Manage own cart'),
+('orders.checkout', 'Check out, pay and track own orders'),
+('prescriptions.redeem', 'View and redeem own prescriptions'),
+('consultations.book', 'Book and close consultations'),
+('reviews.write', 'Review doctors, pharmacies and order items'),
+('health_records.own', 'Manage own health profile and medical records'),
+('doctors.profile', 'View and update own doctor profile and availability'),
+('consultations.attend', 'Attend consultations, write notes and issue documents'),
+('pharmacies.manage', 'Manage own pharmacies'),
+('stock.manage', 'Manage pharmacy drugs and stock'),
+('pharmacy_orders.fulfil', 'Ship and cancel pharmacy orders'),
+('reports.pharmacy', 'View own pharmacy reports'),
+('reviews.reply', 'Reply to pharmacy and order item reviews'),
+('drugs.read', 'View the drug catalog administration'),
+('drugs.write', 'Create, update and delete catalog drugs'),
+('categories.write', 'Create, update and delete drug categories'),
+('doctors.verify', 'Review doctor registrations'),
+('reviews.moderate', 'Moderate doctor reviews'),
+('partners.manage', 'Manage pharmacy partners and their pharmacies'),
+('orders.confirm_payment', 'Confirm order payments'),
+('orders.read', 'View all orders'),
+('reports.read', 'View platform reports'),
+('accounts.manage', 'Reset account security settings'),
+('roles.manage', 'Manage roles, permissions and operator accounts');
+
+-- Roles are seeded by sql/dml.sql on a fresh database; only a database that already has roles is upgraded here.
+UPDATE roles SET two_factor_required = TRUE WHERE role_name = 'pharmacy manager';
+UPDATE roles SET is_operator = TRUE, two_factor_required = TRUE WHERE role_name = 'admin';
+
+INSERT INTO roles (role_name, is_operator, two_factor_required)
+SELECT new_role.role_name, TRUE, TRUE
+FROM (VALUES ('finance'), ('catalog editor')) AS new_role(role_name)
+WHERE EXISTS (SELECT 1 FROM roles)
+AND NOT EXISTS (SELECT 1 FROM roles r WHERE r.role_name = new_role.role_name);
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON (
+    (r.role_name = 'user' AND p.permission_name IN ('users.profile', 'addresses.manage', 'carts.manage', 'orders.checkout', 'prescriptions.redeem', 'consultations.book', 'reviews.write', 'health_records.own'))
+    OR (r.role_name = 'doctor' AND p.permission_name IN ('doctors.profile', 'consultations.attend'))
+    OR (r.role_name = 'pharmacy manager' AND p.permission_name IN ('pharmacies.manage', 'stock.manage', 'pharmacy_orders.fulfil', 'reports.pharmacy', 'reviews.reply'))
+    OR (r.role_name = 'admin' AND p.permission_name IN ('drugs.read', 'drugs.write', 'categories.write', 'doctors.verify', 'reviews.moderate', 'partners.manage', 'orders.confirm_payment', 'orders.read', 'reports.read', 'accounts.manage', 'roles.manage'))
+    OR (r.role_name = 'finance' AND p.permission_name IN ('orders.confirm_payment', 'orders.read', 'reports.read'))
+    OR (r.role_name = 'catalog editor' AND p.permission_name IN ('drugs.read', 'drugs.write', 'categories.write'))
+);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS account_identities, oidc_login_states;
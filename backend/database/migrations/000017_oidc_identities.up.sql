@@ -0,0 +1,25 @@
+CREATE TABLE oidc_login_states(
+    oidc_login_state_id BIGSERIAL PRIMARY KEY,
+    state VARCHAR NOT NULL UNIQUE,
+    provider VARCHAR NOT NULL,
+    nonce VARCHAR NOT NULL,
+    code_verifier VARCHAR NOT NULL,
+    expired_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE account_identities(
+    account_identity_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    provider VARCHAR NOT NULL,
+    subject VARCHAR NOT NULL,
+    email VARCHAR NOT NULL,
+    last_login_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL,
+    UNIQUE (provider, subject)
+);
@@ -0,0 +1,4 @@
+DELETE FROM role_permissions WHERE permission_id IN (SELECT permission_id FROM permissions WHERE permission_name = 'audit_logs.read');
+DELETE FROM permissions WHERE permission_name = 'audit_logs.read';
+DROP TABLE IF EXISTS audit_logs;
+DROP FUNCTION IF EXISTS reject_audit_log_change();
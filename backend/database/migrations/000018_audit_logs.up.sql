@@ -0,0 +1,40 @@
+CREATE TABLE audit_logs(
+    audit_log_id BIGSERIAL PRIMARY KEY,
+    actor_account_id BIGINT DEFAULT NULL,
+    actor_role VARCHAR DEFAULT NULL,
+    action VARCHAR NOT NULL,
+    entity_type VARCHAR NOT NULL,
+    entity_id BIGINT NOT NULL,
+    before_data JSONB DEFAULT NULL,
+    after_data JSONB DEFAULT NULL,
+    request_id VARCHAR DEFAULT NULL,
+    ip_address VARCHAR DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_logs_entity_idx ON audit_logs (entity_type, entity_id);
+CREATE INDEX audit_logs_actor_idx ON audit_logs (actor_account_id);
+CREATE INDEX audit_logs_created_at_idx ON audit_logs (created_at);
+
+CREATE OR REPLACE FUNCTION reject_audit_log_change() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_logs_append_only
+BEFORE UPDATE OR DELETE ON audit_logs
+FOR EACH ROW EXECUTE FUNCTION reject_audit_log_change();
+
+CREATE TRIGGER audit_logs_no_truncate
+BEFORE TRUNCATE ON audit_logs
+FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_log_change();
+
+INSERT INTO permissions (permission_name, description)
+VALUES ('audit_logs.read', 'Search and export the audit log');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON p.permission_name = 'audit_logs.read'
+WHERE r.role_name = 'admin';
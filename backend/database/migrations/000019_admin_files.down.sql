@@ -0,0 +1,3 @@
+DELETE FROM role_permissions WHERE permission_id IN (SELECT permission_id FROM permissions WHERE permission_name IN ('files.manage', 'maintenance.manage'));
+DELETE FROM permissions WHERE permission_name IN ('files.manage', 'maintenance.manage');
+DROP TABLE IF EXISTS admin_files;
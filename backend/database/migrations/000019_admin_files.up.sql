@@ -0,0 +1,23 @@
+CREATE TABLE admin_files(
+    admin_file_id BIGSERIAL PRIMARY KEY,
+    public_id VARCHAR NOT NULL UNIQUE,
+    file_url VARCHAR NOT NULL,
+    file_name VARCHAR NOT NULL,
+    content_type VARCHAR NOT NULL,
+    file_size BIGINT NOT NULL,
+    uploaded_by BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP DEFAULT NULL
+);
+
+INSERT INTO permissions (permission_name, description)
+VALUES
+('files.manage', 'Upload, list and delete admin files'),
+('maintenance.manage', 'Access the internal admin listener');
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON p.permission_name IN ('files.manage', 'maintenance.manage')
+WHERE r.role_name = 'admin';
@@ -0,0 +1,17 @@
+CREATE TABLE email_outbox(
+    email_outbox_id BIGSERIAL PRIMARY KEY,
+    email_type VARCHAR NOT NULL,
+    locale VARCHAR NOT NULL,
+    recipient VARCHAR NOT NULL,
+    template_data JSONB NOT NULL,
+    status VARCHAR NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    sent_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX email_outbox_status_idx ON email_outbox (status, updated_at);
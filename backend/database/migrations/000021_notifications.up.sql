@@ -0,0 +1,16 @@
+CREATE TABLE notifications(
+    notification_id BIGSERIAL PRIMARY KEY,
+    account_id BIGINT NOT NULL,
+    notification_type VARCHAR NOT NULL,
+    title VARCHAR NOT NULL,
+    message VARCHAR NOT NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    read_at TIMESTAMP DEFAULT NULL,
+    pushed_at TIMESTAMP DEFAULT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX notifications_account_idx ON notifications (account_id, created_at DESC);
+CREATE INDEX notifications_unread_idx ON notifications (account_id) WHERE read_at IS NULL;
+CREATE INDEX notifications_unpushed_idx ON notifications (created_at) WHERE pushed_at IS NULL;
@@ -0,0 +1,169 @@
+DROP INDEX IF EXISTS idx_drugs_drug_name_trgm;
+DROP INDEX IF EXISTS idx_drugs_deleted_active_name;
+DROP INDEX IF EXISTS idx_pharmacy_drugs_deleted_at_stock_price;
+DROP INDEX IF EXISTS idx_pharmacy_drugs_drug_id;
+DROP INDEX IF EXISTS idx_pharmacy_drugs_pharmacy_id;
+DROP INDEX IF EXISTS idx_pharmacies_geom;
+DROP INDEX IF EXISTS accounts_email_idx;
+DROP INDEX IF EXISTS admin_files_uploaded_by_idx;
+DROP INDEX IF EXISTS sick_leave_certificates_doctor_account_id_idx;
+DROP INDEX IF EXISTS sick_leave_certificates_user_account_id_idx;
+DROP INDEX IF EXISTS sick_leave_certificates_ws_chat_room_id_idx;
+DROP INDEX IF EXISTS consultation_note_diagnoses_consultation_note_id_idx;
+DROP INDEX IF EXISTS consultation_notes_doctor_account_id_idx;
+DROP INDEX IF EXISTS consultation_notes_user_account_id_idx;
+DROP INDEX IF EXISTS health_profile_access_logs_ws_chat_room_id_idx;
+DROP INDEX IF EXISTS health_profile_access_logs_doctor_account_id_idx;
+DROP INDEX IF EXISTS health_profile_access_logs_user_account_id_idx;
+DROP INDEX IF EXISTS patient_medications_user_account_id_idx;
+DROP INDEX IF EXISTS patient_chronic_conditions_user_account_id_idx;
+DROP INDEX IF EXISTS patient_allergies_user_account_id_idx;
+DROP INDEX IF EXISTS prescription_drugs_drug_id_idx;
+DROP INDEX IF EXISTS prescription_drugs_prescription_id_idx;
+DROP INDEX IF EXISTS prescriptions_doctor_account_id_idx;
+DROP INDEX IF EXISTS prescriptions_user_account_id_idx;
+DROP INDEX IF EXISTS chat_read_states_account_id_idx;
+DROP INDEX IF EXISTS chats_sick_leave_certificate_id_idx;
+DROP INDEX IF EXISTS chats_prescription_id_idx;
+DROP INDEX IF EXISTS chats_sender_account_id_idx;
+DROP INDEX IF EXISTS chats_chat_room_id_idx;
+DROP INDEX IF EXISTS doctor_reviews_doctor_account_id_idx;
+DROP INDEX IF EXISTS doctor_reviews_user_account_id_idx;
+DROP INDEX IF EXISTS ws_chat_rooms_doctor_account_id_idx;
+DROP INDEX IF EXISTS ws_chat_rooms_user_account_id_idx;
+DROP INDEX IF EXISTS chat_rooms_doctor_account_id_idx;
+DROP INDEX IF EXISTS chat_rooms_user_account_id_idx;
+DROP INDEX IF EXISTS stock_mutation_requests_status_id_idx;
+DROP INDEX IF EXISTS stock_mutation_requests_drug_id_idx;
+DROP INDEX IF EXISTS stock_mutation_requests_pharmacy_target_id_idx;
+DROP INDEX IF EXISTS stock_mutation_requests_pharmacy_requester_id_idx;
+DROP INDEX IF EXISTS stock_changes_pharmacy_drug_id_idx;
+DROP INDEX IF EXISTS pharmacy_reviews_user_id_idx;
+DROP INDEX IF EXISTS pharmacy_reviews_pharmacy_id_idx;
+DROP INDEX IF EXISTS order_item_reviews_user_id_idx;
+DROP INDEX IF EXISTS order_item_reviews_drug_id_idx;
+DROP INDEX IF EXISTS order_item_reviews_pharmacy_drug_id_idx;
+DROP INDEX IF EXISTS order_item_reviews_pharmacy_id_idx;
+DROP INDEX IF EXISTS order_items_prescription_id_idx;
+DROP INDEX IF EXISTS order_items_pharmacy_drug_id_idx;
+DROP INDEX IF EXISTS order_items_drug_id_idx;
+DROP INDEX IF EXISTS order_items_order_pharmacy_id_idx;
+DROP INDEX IF EXISTS order_pharmacies_pharmacy_courier_id_idx;
+DROP INDEX IF EXISTS order_pharmacies_order_status_id_idx;
+DROP INDEX IF EXISTS order_pharmacies_order_id_idx;
+DROP INDEX IF EXISTS orders_user_id_idx;
+DROP INDEX IF EXISTS drugs_drug_category_id_idx;
+DROP INDEX IF EXISTS drugs_form_id_idx;
+DROP INDEX IF EXISTS drugs_classification_id_idx;
+DROP INDEX IF EXISTS pharmacy_couriers_courier_id_idx;
+DROP INDEX IF EXISTS pharmacy_couriers_pharmacy_id_idx;
+DROP INDEX IF EXISTS pharmacies_pharmacy_manager_id_idx;
+DROP INDEX IF EXISTS pharmacy_operationals_pharmacy_id_idx;
+DROP INDEX IF EXISTS cart_items_prescription_id_idx;
+DROP INDEX IF EXISTS cart_items_pharmacy_drug_id_idx;
+DROP INDEX IF EXISTS cart_items_user_id_idx;
+DROP INDEX IF EXISTS user_addresses_subdistrict_id_idx;
+DROP INDEX IF EXISTS user_addresses_district_id_idx;
+DROP INDEX IF EXISTS user_addresses_city_id_idx;
+DROP INDEX IF EXISTS user_addresses_province_id_idx;
+DROP INDEX IF EXISTS user_addresses_user_id_idx;
+DROP INDEX IF EXISTS pharmacy_managers_account_id_idx;
+DROP INDEX IF EXISTS users_gender_id_idx;
+DROP INDEX IF EXISTS users_account_id_idx;
+DROP INDEX IF EXISTS doctors_specialization_id_idx;
+DROP INDEX IF EXISTS doctors_account_id_idx;
+DROP INDEX IF EXISTS refresh_tokens_account_id_idx;
+DROP INDEX IF EXISTS account_identities_account_id_idx;
+DROP INDEX IF EXISTS two_factor_recovery_codes_account_id_idx;
+DROP INDEX IF EXISTS account_sessions_account_id_idx;
+DROP INDEX IF EXISTS reset_password_tokens_account_id_idx;
+DROP INDEX IF EXISTS verification_codes_account_id_idx;
+DROP INDEX IF EXISTS accounts_role_id_idx;
+DROP INDEX IF EXISTS role_permissions_permission_id_idx;
+
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_account_id_fkey;
+ALTER TABLE admin_files DROP CONSTRAINT IF EXISTS admin_files_uploaded_by_fkey;
+ALTER TABLE sick_leave_certificates DROP CONSTRAINT IF EXISTS sick_leave_certificates_doctor_account_id_fkey;
+ALTER TABLE sick_leave_certificates DROP CONSTRAINT IF EXISTS sick_leave_certificates_user_account_id_fkey;
+ALTER TABLE sick_leave_certificates DROP CONSTRAINT IF EXISTS sick_leave_certificates_ws_chat_room_id_fkey;
+ALTER TABLE consultation_note_diagnoses DROP CONSTRAINT IF EXISTS consultation_note_diagnoses_consultation_note_id_fkey;
+ALTER TABLE consultation_notes DROP CONSTRAINT IF EXISTS consultation_notes_doctor_account_id_fkey;
+ALTER TABLE consultation_notes DROP CONSTRAINT IF EXISTS consultation_notes_user_account_id_fkey;
+ALTER TABLE consultation_notes DROP CONSTRAINT IF EXISTS consultation_notes_ws_chat_room_id_fkey;
+ALTER TABLE health_profile_access_logs DROP CONSTRAINT IF EXISTS health_profile_access_logs_ws_chat_room_id_fkey;
+ALTER TABLE health_profile_access_logs DROP CONSTRAINT IF EXISTS health_profile_access_logs_doctor_account_id_fkey;
+ALTER TABLE health_profile_access_logs DROP CONSTRAINT IF EXISTS health_profile_access_logs_user_account_id_fkey;
+ALTER TABLE patient_medications DROP CONSTRAINT IF EXISTS patient_medications_user_account_id_fkey;
+ALTER TABLE patient_chronic_conditions DROP CONSTRAINT IF EXISTS patient_chronic_conditions_user_account_id_fkey;
+ALTER TABLE patient_health_profiles DROP CONSTRAINT IF EXISTS patient_health_profiles_user_account_id_fkey;
+ALTER TABLE patient_allergies DROP CONSTRAINT IF EXISTS patient_allergies_user_account_id_fkey;
+ALTER TABLE prescription_drugs DROP CONSTRAINT IF EXISTS prescription_drugs_drug_id_fkey;
+ALTER TABLE prescription_drugs DROP CONSTRAINT IF EXISTS prescription_drugs_prescription_id_fkey;
+ALTER TABLE prescriptions DROP CONSTRAINT IF EXISTS prescriptions_doctor_account_id_fkey;
+ALTER TABLE prescriptions DROP CONSTRAINT IF EXISTS prescriptions_user_account_id_fkey;
+ALTER TABLE chat_read_states DROP CONSTRAINT IF EXISTS chat_read_states_account_id_fkey;
+ALTER TABLE chat_read_states DROP CONSTRAINT IF EXISTS chat_read_states_chat_room_id_fkey;
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_sick_leave_certificate_id_fkey;
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_prescription_id_fkey;
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_sender_account_id_fkey;
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_chat_room_id_fkey;
+ALTER TABLE doctor_reviews DROP CONSTRAINT IF EXISTS doctor_reviews_doctor_account_id_fkey;
+ALTER TABLE doctor_reviews DROP CONSTRAINT IF EXISTS doctor_reviews_user_account_id_fkey;
+ALTER TABLE doctor_reviews DROP CONSTRAINT IF EXISTS doctor_reviews_ws_chat_room_id_fkey;
+ALTER TABLE ws_chat_rooms DROP CONSTRAINT IF EXISTS ws_chat_rooms_doctor_account_id_fkey;
+ALTER TABLE ws_chat_rooms DROP CONSTRAINT IF EXISTS ws_chat_rooms_user_account_id_fkey;
+ALTER TABLE chat_rooms DROP CONSTRAINT IF EXISTS chat_rooms_doctor_account_id_fkey;
+ALTER TABLE chat_rooms DROP CONSTRAINT IF EXISTS chat_rooms_user_account_id_fkey;
+ALTER TABLE stock_mutation_requests DROP CONSTRAINT IF EXISTS stock_mutation_requests_status_id_fkey;
+ALTER TABLE stock_mutation_requests DROP CONSTRAINT IF EXISTS stock_mutation_requests_drug_id_fkey;
+ALTER TABLE stock_mutation_requests DROP CONSTRAINT IF EXISTS stock_mutation_requests_pharmacy_target_id_fkey;
+ALTER TABLE stock_mutation_requests DROP CONSTRAINT IF EXISTS stock_mutation_requests_pharmacy_requester_id_fkey;
+ALTER TABLE stock_changes DROP CONSTRAINT IF EXISTS stock_changes_pharmacy_drug_id_fkey;
+ALTER TABLE pharmacy_reviews DROP CONSTRAINT IF EXISTS pharmacy_reviews_user_id_fkey;
+ALTER TABLE pharmacy_reviews DROP CONSTRAINT IF EXISTS pharmacy_reviews_pharmacy_id_fkey;
+ALTER TABLE pharmacy_reviews DROP CONSTRAINT IF EXISTS pharmacy_reviews_order_pharmacy_id_fkey;
+ALTER TABLE order_item_reviews DROP CONSTRAINT IF EXISTS order_item_reviews_user_id_fkey;
+ALTER TABLE order_item_reviews DROP CONSTRAINT IF EXISTS order_item_reviews_drug_id_fkey;
+ALTER TABLE order_item_reviews DROP CONSTRAINT IF EXISTS order_item_reviews_pharmacy_drug_id_fkey;
+ALTER TABLE order_item_reviews DROP CONSTRAINT IF EXISTS order_item_reviews_pharmacy_id_fkey;
+ALTER TABLE order_item_reviews DROP CONSTRAINT IF EXISTS order_item_reviews_order_item_id_fkey;
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS order_items_prescription_id_fkey;
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS order_items_pharmacy_drug_id_fkey;
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS order_items_drug_id_fkey;
+ALTER TABLE order_items DROP CONSTRAINT IF EXISTS order_items_order_pharmacy_id_fkey;
+ALTER TABLE order_pharmacies DROP CONSTRAINT IF EXISTS order_pharmacies_pharmacy_courier_id_fkey;
+ALTER TABLE order_pharmacies DROP CONSTRAINT IF EXISTS order_pharmacies_order_status_id_fkey;
+ALTER TABLE order_pharmacies DROP CONSTRAINT IF EXISTS order_pharmacies_order_id_fkey;
+ALTER TABLE orders DROP CONSTRAINT IF EXISTS orders_user_id_fkey;
+ALTER TABLE drugs DROP CONSTRAINT IF EXISTS drugs_drug_category_id_fkey;
+ALTER TABLE drugs DROP CONSTRAINT IF EXISTS drugs_form_id_fkey;
+ALTER TABLE drugs DROP CONSTRAINT IF EXISTS drugs_classification_id_fkey;
+ALTER TABLE pharmacy_couriers DROP CONSTRAINT IF EXISTS pharmacy_couriers_courier_id_fkey;
+ALTER TABLE pharmacy_couriers DROP CONSTRAINT IF EXISTS pharmacy_couriers_pharmacy_id_fkey;
+ALTER TABLE pharmacies DROP CONSTRAINT IF EXISTS pharmacies_pharmacy_manager_id_fkey;
+ALTER TABLE pharmacy_operationals DROP CONSTRAINT IF EXISTS pharmacy_operationals_pharmacy_id_fkey;
+ALTER TABLE pharmacy_drugs DROP CONSTRAINT IF EXISTS pharmacy_drugs_drug_id_fkey;
+ALTER TABLE pharmacy_drugs DROP CONSTRAINT IF EXISTS pharmacy_drugs_pharmacy_id_fkey;
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_prescription_id_fkey;
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_pharmacy_drug_id_fkey;
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_user_id_fkey;
+ALTER TABLE user_addresses DROP CONSTRAINT IF EXISTS user_addresses_subdistrict_id_fkey;
+ALTER TABLE user_addresses DROP CONSTRAINT IF EXISTS user_addresses_district_id_fkey;
+ALTER TABLE user_addresses DROP CONSTRAINT IF EXISTS user_addresses_city_id_fkey;
+ALTER TABLE user_addresses DROP CONSTRAINT IF EXISTS user_addresses_province_id_fkey;
+ALTER TABLE user_addresses DROP CONSTRAINT IF EXISTS user_addresses_user_id_fkey;
+ALTER TABLE pharmacy_managers DROP CONSTRAINT IF EXISTS pharmacy_managers_account_id_fkey;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_gender_id_fkey;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_account_id_fkey;
+ALTER TABLE doctors DROP CONSTRAINT IF EXISTS doctors_specialization_id_fkey;
+ALTER TABLE doctors DROP CONSTRAINT IF EXISTS doctors_account_id_fkey;
+ALTER TABLE refresh_tokens DROP CONSTRAINT IF EXISTS refresh_tokens_account_id_fkey;
+ALTER TABLE account_identities DROP CONSTRAINT IF EXISTS account_identities_account_id_fkey;
+ALTER TABLE two_factor_recovery_codes DROP CONSTRAINT IF EXISTS two_factor_recovery_codes_account_id_fkey;
+ALTER TABLE account_two_factors DROP CONSTRAINT IF EXISTS account_two_factors_account_id_fkey;
+ALTER TABLE account_sessions DROP CONSTRAINT IF EXISTS account_sessions_account_id_fkey;
+ALTER TABLE reset_password_tokens DROP CONSTRAINT IF EXISTS reset_password_tokens_account_id_fkey;
+ALTER TABLE verification_codes DROP CONSTRAINT IF EXISTS verification_codes_account_id_fkey;
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_role_id_fkey;
+ALTER TABLE role_permissions DROP CONSTRAINT IF EXISTS role_permissions_permission_id_fkey;
+ALTER TABLE role_permissions DROP CONSTRAINT IF EXISTS role_permissions_role_id_fkey;
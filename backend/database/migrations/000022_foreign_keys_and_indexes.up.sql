@@ -0,0 +1,173 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- NOT VALID skips checking rows that already exist, so databases adopted with `migrate baseline 1` that still hold orphaned rows can take these constraints; new rows are always checked.
+ALTER TABLE role_permissions ADD CONSTRAINT role_permissions_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles (role_id) NOT VALID;
+ALTER TABLE role_permissions ADD CONSTRAINT role_permissions_permission_id_fkey FOREIGN KEY (permission_id) REFERENCES permissions (permission_id) NOT VALID;
+ALTER TABLE accounts ADD CONSTRAINT accounts_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles (role_id) NOT VALID;
+ALTER TABLE verification_codes ADD CONSTRAINT verification_codes_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE reset_password_tokens ADD CONSTRAINT reset_password_tokens_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE account_sessions ADD CONSTRAINT account_sessions_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE account_two_factors ADD CONSTRAINT account_two_factors_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE two_factor_recovery_codes ADD CONSTRAINT two_factor_recovery_codes_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE account_identities ADD CONSTRAINT account_identities_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE refresh_tokens ADD CONSTRAINT refresh_tokens_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE doctors ADD CONSTRAINT doctors_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE doctors ADD CONSTRAINT doctors_specialization_id_fkey FOREIGN KEY (specialization_id) REFERENCES doctor_specializations (specialization_id) NOT VALID;
+ALTER TABLE users ADD CONSTRAINT users_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE users ADD CONSTRAINT users_gender_id_fkey FOREIGN KEY (gender_id) REFERENCES genders (gender_id) NOT VALID;
+ALTER TABLE pharmacy_managers ADD CONSTRAINT pharmacy_managers_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE user_addresses ADD CONSTRAINT user_addresses_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (user_id) NOT VALID;
+ALTER TABLE user_addresses ADD CONSTRAINT user_addresses_province_id_fkey FOREIGN KEY (province_id) REFERENCES provinces (province_id) NOT VALID;
+ALTER TABLE user_addresses ADD CONSTRAINT user_addresses_city_id_fkey FOREIGN KEY (city_id) REFERENCES cities (city_id) NOT VALID;
+ALTER TABLE user_addresses ADD CONSTRAINT user_addresses_district_id_fkey FOREIGN KEY (district_id) REFERENCES districts (district_id) NOT VALID;
+ALTER TABLE user_addresses ADD CONSTRAINT user_addresses_subdistrict_id_fkey FOREIGN KEY (subdistrict_id) REFERENCES subdistricts (subdistrict_id) NOT VALID;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (user_id) NOT VALID;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_pharmacy_drug_id_fkey FOREIGN KEY (pharmacy_drug_id) REFERENCES pharmacy_drugs (pharmacy_drug_id) NOT VALID;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_prescription_id_fkey FOREIGN KEY (prescription_id) REFERENCES prescriptions (prescription_id) NOT VALID;
+ALTER TABLE pharmacy_drugs ADD CONSTRAINT pharmacy_drugs_pharmacy_id_fkey FOREIGN KEY (pharmacy_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE pharmacy_drugs ADD CONSTRAINT pharmacy_drugs_drug_id_fkey FOREIGN KEY (drug_id) REFERENCES drugs (drug_id) NOT VALID;
+ALTER TABLE pharmacy_operationals ADD CONSTRAINT pharmacy_operationals_pharmacy_id_fkey FOREIGN KEY (pharmacy_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE pharmacies ADD CONSTRAINT pharmacies_pharmacy_manager_id_fkey FOREIGN KEY (pharmacy_manager_id) REFERENCES pharmacy_managers (pharmacy_manager_id) NOT VALID;
+ALTER TABLE pharmacy_couriers ADD CONSTRAINT pharmacy_couriers_pharmacy_id_fkey FOREIGN KEY (pharmacy_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE pharmacy_couriers ADD CONSTRAINT pharmacy_couriers_courier_id_fkey FOREIGN KEY (courier_id) REFERENCES couriers (courier_id) NOT VALID;
+ALTER TABLE drugs ADD CONSTRAINT drugs_classification_id_fkey FOREIGN KEY (classification_id) REFERENCES drug_classifications (classification_id) NOT VALID;
+ALTER TABLE drugs ADD CONSTRAINT drugs_form_id_fkey FOREIGN KEY (form_id) REFERENCES drug_forms (form_id) NOT VALID;
+ALTER TABLE drugs ADD CONSTRAINT drugs_drug_category_id_fkey FOREIGN KEY (drug_category_id) REFERENCES drug_categories (drug_category_id) NOT VALID;
+ALTER TABLE orders ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (user_id) NOT VALID;
+ALTER TABLE order_pharmacies ADD CONSTRAINT order_pharmacies_order_id_fkey FOREIGN KEY (order_id) REFERENCES orders (order_id) NOT VALID;
+ALTER TABLE order_pharmacies ADD CONSTRAINT order_pharmacies_order_status_id_fkey FOREIGN KEY (order_status_id) REFERENCES order_status (order_status_id) NOT VALID;
+ALTER TABLE order_pharmacies ADD CONSTRAINT order_pharmacies_pharmacy_courier_id_fkey FOREIGN KEY (pharmacy_courier_id) REFERENCES pharmacy_couriers (pharmacy_courier_id) NOT VALID;
+ALTER TABLE order_items ADD CONSTRAINT order_items_order_pharmacy_id_fkey FOREIGN KEY (order_pharmacy_id) REFERENCES order_pharmacies (order_pharmacy_id) NOT VALID;
+ALTER TABLE order_items ADD CONSTRAINT order_items_drug_id_fkey FOREIGN KEY (drug_id) REFERENCES drugs (drug_id) NOT VALID;
+ALTER TABLE order_items ADD CONSTRAINT order_items_pharmacy_drug_id_fkey FOREIGN KEY (pharmacy_drug_id) REFERENCES pharmacy_drugs (pharmacy_drug_id) NOT VALID;
+ALTER TABLE order_items ADD CONSTRAINT order_items_prescription_id_fkey FOREIGN KEY (prescription_id) REFERENCES prescriptions (prescription_id) NOT VALID;
+ALTER TABLE order_item_reviews ADD CONSTRAINT order_item_reviews_order_item_id_fkey FOREIGN KEY (order_item_id) REFERENCES order_items (order_item_id) NOT VALID;
+ALTER TABLE order_item_reviews ADD CONSTRAINT order_item_reviews_pharmacy_id_fkey FOREIGN KEY (pharmacy_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE order_item_reviews ADD CONSTRAINT order_item_reviews_pharmacy_drug_id_fkey FOREIGN KEY (pharmacy_drug_id) REFERENCES pharmacy_drugs (pharmacy_drug_id) NOT VALID;
+ALTER TABLE order_item_reviews ADD CONSTRAINT order_item_reviews_drug_id_fkey FOREIGN KEY (drug_id) REFERENCES drugs (drug_id) NOT VALID;
+ALTER TABLE order_item_reviews ADD CONSTRAINT order_item_reviews_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (user_id) NOT VALID;
+ALTER TABLE pharmacy_reviews ADD CONSTRAINT pharmacy_reviews_order_pharmacy_id_fkey FOREIGN KEY (order_pharmacy_id) REFERENCES order_pharmacies (order_pharmacy_id) NOT VALID;
+ALTER TABLE pharmacy_reviews ADD CONSTRAINT pharmacy_reviews_pharmacy_id_fkey FOREIGN KEY (pharmacy_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE pharmacy_reviews ADD CONSTRAINT pharmacy_reviews_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (user_id) NOT VALID;
+ALTER TABLE stock_changes ADD CONSTRAINT stock_changes_pharmacy_drug_id_fkey FOREIGN KEY (pharmacy_drug_id) REFERENCES pharmacy_drugs (pharmacy_drug_id) NOT VALID;
+ALTER TABLE stock_mutation_requests ADD CONSTRAINT stock_mutation_requests_pharmacy_requester_id_fkey FOREIGN KEY (pharmacy_requester_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE stock_mutation_requests ADD CONSTRAINT stock_mutation_requests_pharmacy_target_id_fkey FOREIGN KEY (pharmacy_target_id) REFERENCES pharmacies (pharmacy_id) NOT VALID;
+ALTER TABLE stock_mutation_requests ADD CONSTRAINT stock_mutation_requests_drug_id_fkey FOREIGN KEY (drug_id) REFERENCES drugs (drug_id) NOT VALID;
+ALTER TABLE stock_mutation_requests ADD CONSTRAINT stock_mutation_requests_status_id_fkey FOREIGN KEY (status_id) REFERENCES stock_request_status (status_id) NOT VALID;
+ALTER TABLE chat_rooms ADD CONSTRAINT chat_rooms_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE chat_rooms ADD CONSTRAINT chat_rooms_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE ws_chat_rooms ADD CONSTRAINT ws_chat_rooms_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE ws_chat_rooms ADD CONSTRAINT ws_chat_rooms_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE doctor_reviews ADD CONSTRAINT doctor_reviews_ws_chat_room_id_fkey FOREIGN KEY (ws_chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE doctor_reviews ADD CONSTRAINT doctor_reviews_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE doctor_reviews ADD CONSTRAINT doctor_reviews_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE chats ADD CONSTRAINT chats_chat_room_id_fkey FOREIGN KEY (chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE chats ADD CONSTRAINT chats_sender_account_id_fkey FOREIGN KEY (sender_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE chats ADD CONSTRAINT chats_prescription_id_fkey FOREIGN KEY (prescription_id) REFERENCES prescriptions (prescription_id) NOT VALID;
+ALTER TABLE chats ADD CONSTRAINT chats_sick_leave_certificate_id_fkey FOREIGN KEY (sick_leave_certificate_id) REFERENCES sick_leave_certificates (sick_leave_certificate_id) NOT VALID;
+ALTER TABLE chat_read_states ADD CONSTRAINT chat_read_states_chat_room_id_fkey FOREIGN KEY (chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE chat_read_states ADD CONSTRAINT chat_read_states_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE prescriptions ADD CONSTRAINT prescriptions_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE prescriptions ADD CONSTRAINT prescriptions_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE prescription_drugs ADD CONSTRAINT prescription_drugs_prescription_id_fkey FOREIGN KEY (prescription_id) REFERENCES prescriptions (prescription_id) NOT VALID;
+ALTER TABLE prescription_drugs ADD CONSTRAINT prescription_drugs_drug_id_fkey FOREIGN KEY (drug_id) REFERENCES drugs (drug_id) NOT VALID;
+ALTER TABLE patient_allergies ADD CONSTRAINT patient_allergies_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE patient_health_profiles ADD CONSTRAINT patient_health_profiles_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE patient_chronic_conditions ADD CONSTRAINT patient_chronic_conditions_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE patient_medications ADD CONSTRAINT patient_medications_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE health_profile_access_logs ADD CONSTRAINT health_profile_access_logs_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE health_profile_access_logs ADD CONSTRAINT health_profile_access_logs_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE health_profile_access_logs ADD CONSTRAINT health_profile_access_logs_ws_chat_room_id_fkey FOREIGN KEY (ws_chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE consultation_notes ADD CONSTRAINT consultation_notes_ws_chat_room_id_fkey FOREIGN KEY (ws_chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE consultation_notes ADD CONSTRAINT consultation_notes_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE consultation_notes ADD CONSTRAINT consultation_notes_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE consultation_note_diagnoses ADD CONSTRAINT consultation_note_diagnoses_consultation_note_id_fkey FOREIGN KEY (consultation_note_id) REFERENCES consultation_notes (consultation_note_id) NOT VALID;
+ALTER TABLE sick_leave_certificates ADD CONSTRAINT sick_leave_certificates_ws_chat_room_id_fkey FOREIGN KEY (ws_chat_room_id) REFERENCES ws_chat_rooms (ws_chat_room_id) NOT VALID;
+ALTER TABLE sick_leave_certificates ADD CONSTRAINT sick_leave_certificates_user_account_id_fkey FOREIGN KEY (user_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE sick_leave_certificates ADD CONSTRAINT sick_leave_certificates_doctor_account_id_fkey FOREIGN KEY (doctor_account_id) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE admin_files ADD CONSTRAINT admin_files_uploaded_by_fkey FOREIGN KEY (uploaded_by) REFERENCES accounts (account_id) NOT VALID;
+ALTER TABLE notifications ADD CONSTRAINT notifications_account_id_fkey FOREIGN KEY (account_id) REFERENCES accounts (account_id) NOT VALID;
+
+CREATE INDEX IF NOT EXISTS role_permissions_permission_id_idx ON role_permissions (permission_id);
+CREATE INDEX IF NOT EXISTS accounts_role_id_idx ON accounts (role_id);
+CREATE INDEX IF NOT EXISTS verification_codes_account_id_idx ON verification_codes (account_id);
+CREATE INDEX IF NOT EXISTS reset_password_tokens_account_id_idx ON reset_password_tokens (account_id);
+CREATE INDEX IF NOT EXISTS account_sessions_account_id_idx ON account_sessions (account_id);
+CREATE INDEX IF NOT EXISTS two_factor_recovery_codes_account_id_idx ON two_factor_recovery_codes (account_id);
+CREATE INDEX IF NOT EXISTS account_identities_account_id_idx ON account_identities (account_id);
+CREATE INDEX IF NOT EXISTS refresh_tokens_account_id_idx ON refresh_tokens (account_id);
+CREATE INDEX IF NOT EXISTS doctors_account_id_idx ON doctors (account_id);
+CREATE INDEX IF NOT EXISTS doctors_specialization_id_idx ON doctors (specialization_id);
+CREATE INDEX IF NOT EXISTS users_account_id_idx ON users (account_id);
+CREATE INDEX IF NOT EXISTS users_gender_id_idx ON users (gender_id);
+CREATE INDEX IF NOT EXISTS pharmacy_managers_account_id_idx ON pharmacy_managers (account_id);
+CREATE INDEX IF NOT EXISTS user_addresses_user_id_idx ON user_addresses (user_id);
+CREATE INDEX IF NOT EXISTS user_addresses_province_id_idx ON user_addresses (province_id);
+CREATE INDEX IF NOT EXISTS user_addresses_city_id_idx ON user_addresses (city_id);
+CREATE INDEX IF NOT EXISTS user_addresses_district_id_idx ON user_addresses (district_id);
+CREATE INDEX IF NOT EXISTS user_addresses_subdistrict_id_idx ON user_addresses (subdistrict_id);
+CREATE INDEX IF NOT EXISTS cart_items_user_id_idx ON cart_items (user_id);
+CREATE INDEX IF NOT EXISTS cart_items_pharmacy_drug_id_idx ON cart_items (pharmacy_drug_id);
+CREATE INDEX IF NOT EXISTS cart_items_prescription_id_idx ON cart_items (prescription_id);
+CREATE INDEX IF NOT EXISTS pharmacy_operationals_pharmacy_id_idx ON pharmacy_operationals (pharmacy_id);
+CREATE INDEX IF NOT EXISTS pharmacies_pharmacy_manager_id_idx ON pharmacies (pharmacy_manager_id);
+CREATE INDEX IF NOT EXISTS pharmacy_couriers_pharmacy_id_idx ON pharmacy_couriers (pharmacy_id);
+CREATE INDEX IF NOT EXISTS pharmacy_couriers_courier_id_idx ON pharmacy_couriers (courier_id);
+CREATE INDEX IF NOT EXISTS drugs_classification_id_idx ON drugs (classification_id);
+CREATE INDEX IF NOT EXISTS drugs_form_id_idx ON drugs (form_id);
+CREATE INDEX IF NOT EXISTS drugs_drug_category_id_idx ON drugs (drug_category_id);
+CREATE INDEX IF NOT EXISTS orders_user_id_idx ON orders (user_id);
+CREATE INDEX IF NOT EXISTS order_pharmacies_order_id_idx ON order_pharmacies (order_id);
+CREATE INDEX IF NOT EXISTS order_pharmacies_order_status_id_idx ON order_pharmacies (order_status_id);
+CREATE INDEX IF NOT EXISTS order_pharmacies_pharmacy_courier_id_idx ON order_pharmacies (pharmacy_courier_id);
+CREATE INDEX IF NOT EXISTS order_items_order_pharmacy_id_idx ON order_items (order_pharmacy_id);
+CREATE INDEX IF NOT EXISTS order_items_drug_id_idx ON order_items (drug_id);
+CREATE INDEX IF NOT EXISTS order_items_pharmacy_drug_id_idx ON order_items (pharmacy_drug_id);
+CREATE INDEX IF NOT EXISTS order_items_prescription_id_idx ON order_items (prescription_id);
+CREATE INDEX IF NOT EXISTS order_item_reviews_pharmacy_id_idx ON order_item_reviews (pharmacy_id);
+CREATE INDEX IF NOT EXISTS order_item_reviews_pharmacy_drug_id_idx ON order_item_reviews (pharmacy_drug_id);
+CREATE INDEX IF NOT EXISTS order_item_reviews_drug_id_idx ON order_item_reviews (drug_id);
+CREATE INDEX IF NOT EXISTS order_item_reviews_user_id_idx ON order_item_reviews (user_id);
+CREATE INDEX IF NOT EXISTS pharmacy_reviews_pharmacy_id_idx ON pharmacy_reviews (pharmacy_id);
+CREATE INDEX IF NOT EXISTS pharmacy_reviews_user_id_idx ON pharmacy_reviews (user_id);
+CREATE INDEX IF NOT EXISTS stock_changes_pharmacy_drug_id_idx ON stock_changes (pharmacy_drug_id);
+CREATE INDEX IF NOT EXISTS stock_mutation_requests_pharmacy_requester_id_idx ON stock_mutation_requests (pharmacy_requester_id);
+CREATE INDEX IF NOT EXISTS stock_mutation_requests_pharmacy_target_id_idx ON stock_mutation_requests (pharmacy_target_id);
+CREATE INDEX IF NOT EXISTS stock_mutation_requests_drug_id_idx ON stock_mutation_requests (drug_id);
+CREATE INDEX IF NOT EXISTS stock_mutation_requests_status_id_idx ON stock_mutation_requests (status_id);
+CREATE INDEX IF NOT EXISTS chat_rooms_user_account_id_idx ON chat_rooms (user_account_id);
+CREATE INDEX IF NOT EXISTS chat_rooms_doctor_account_id_idx ON chat_rooms (doctor_account_id);
+CREATE INDEX IF NOT EXISTS ws_chat_rooms_user_account_id_idx ON ws_chat_rooms (user_account_id);
+CREATE INDEX IF NOT EXISTS ws_chat_rooms_doctor_account_id_idx ON ws_chat_rooms (doctor_account_id);
+CREATE INDEX IF NOT EXISTS doctor_reviews_user_account_id_idx ON doctor_reviews (user_account_id);
+CREATE INDEX IF NOT EXISTS doctor_reviews_doctor_account_id_idx ON doctor_reviews (doctor_account_id);
+CREATE INDEX IF NOT EXISTS chats_chat_room_id_idx ON chats (chat_room_id);
+CREATE INDEX IF NOT EXISTS chats_sender_account_id_idx ON chats (sender_account_id);
+CREATE INDEX IF NOT EXISTS chats_prescription_id_idx ON chats (prescription_id);
+CREATE INDEX IF NOT EXISTS chats_sick_leave_certificate_id_idx ON chats (sick_leave_certificate_id);
+CREATE INDEX IF NOT EXISTS chat_read_states_account_id_idx ON chat_read_states (account_id);
+CREATE INDEX IF NOT EXISTS prescriptions_user_account_id_idx ON prescriptions (user_account_id);
+CREATE INDEX IF NOT EXISTS prescriptions_doctor_account_id_idx ON prescriptions (doctor_account_id);
+CREATE INDEX IF NOT EXISTS prescription_drugs_prescription_id_idx ON prescription_drugs (prescription_id);
+CREATE INDEX IF NOT EXISTS prescription_drugs_drug_id_idx ON prescription_drugs (drug_id);
+CREATE INDEX IF NOT EXISTS patient_allergies_user_account_id_idx ON patient_allergies (user_account_id);
+CREATE INDEX IF NOT EXISTS patient_chronic_conditions_user_account_id_idx ON patient_chronic_conditions (user_account_id);
+CREATE INDEX IF NOT EXISTS patient_medications_user_account_id_idx ON patient_medications (user_account_id);
+CREATE INDEX IF NOT EXISTS health_profile_access_logs_user_account_id_idx ON health_profile_access_logs (user_account_id);
+CREATE INDEX IF NOT EXISTS health_profile_access_logs_doctor_account_id_idx ON health_profile_access_logs (doctor_account_id);
+CREATE INDEX IF NOT EXISTS health_profile_access_logs_ws_chat_room_id_idx ON health_profile_access_logs (ws_chat_room_id);
+CREATE INDEX IF NOT EXISTS consultation_notes_user_account_id_idx ON consultation_notes (user_account_id);
+CREATE INDEX IF NOT EXISTS consultation_notes_doctor_account_id_idx ON consultation_notes (doctor_account_id);
+CREATE INDEX IF NOT EXISTS consultation_note_diagnoses_consultation_note_id_idx ON consultation_note_diagnoses (consultation_note_id);
+CREATE INDEX IF NOT EXISTS sick_leave_certificates_ws_chat_room_id_idx ON sick_leave_certificates (ws_chat_room_id);
+CREATE INDEX IF NOT EXISTS sick_leave_certificates_user_account_id_idx ON sick_leave_certificates (user_account_id);
+CREATE INDEX IF NOT EXISTS sick_leave_certificates_doctor_account_id_idx ON sick_leave_certificates (doctor_account_id);
+CREATE INDEX IF NOT EXISTS admin_files_uploaded_by_idx ON admin_files (uploaded_by);
+CREATE INDEX IF NOT EXISTS accounts_email_idx ON accounts (email);
+
+CREATE INDEX IF NOT EXISTS idx_pharmacies_geom ON pharmacies USING GIST (geom);
+CREATE INDEX IF NOT EXISTS idx_pharmacy_drugs_pharmacy_id ON pharmacy_drugs (pharmacy_id);
+CREATE INDEX IF NOT EXISTS idx_pharmacy_drugs_drug_id ON pharmacy_drugs (drug_id);
+CREATE INDEX IF NOT EXISTS idx_pharmacy_drugs_deleted_at_stock_price ON pharmacy_drugs (deleted_at, stock, price);
+CREATE INDEX IF NOT EXISTS idx_drugs_deleted_active_name ON drugs (deleted_at, is_active, drug_name);
+CREATE INDEX IF NOT EXISTS idx_drugs_drug_name_trgm ON drugs USING gin (drug_name gin_trgm_ops);
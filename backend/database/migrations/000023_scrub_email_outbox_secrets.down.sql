@@ -0,0 +1,2 @@
+-- Scrubbed template data cannot be restored.
+SELECT 1;
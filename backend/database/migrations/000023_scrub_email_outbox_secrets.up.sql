@@ -0,0 +1,4 @@
+UPDATE email_outbox
+SET template_data = '{}', updated_at = NOW()
+WHERE status = 'sent'
+OR (status = 'dead' AND email_type IN ('verification', 'reset_password', 'credentials'));
@@ -0,0 +1,3 @@
+ALTER TABLE oidc_login_states DROP CONSTRAINT IF EXISTS oidc_login_states_link_account_id_fkey;
+
+ALTER TABLE oidc_login_states DROP COLUMN IF EXISTS link_account_id;
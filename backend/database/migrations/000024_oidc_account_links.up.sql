@@ -0,0 +1,3 @@
+ALTER TABLE oidc_login_states ADD COLUMN link_account_id BIGINT DEFAULT NULL;
+
+ALTER TABLE oidc_login_states ADD CONSTRAINT oidc_login_states_link_account_id_fkey FOREIGN KEY (link_account_id) REFERENCES accounts (account_id);
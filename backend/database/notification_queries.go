@@ -0,0 +1,105 @@
+package database
+
+const (
+	CreateNotificationQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	CreateNotificationForOrderUserQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		SELECT u.account_id, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::JSONB
+		FROM orders o
+		JOIN users u ON u.user_id = o.user_id
+		WHERE o.order_id = $1
+	`
+
+	CreateNotificationForOrderManagersQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		SELECT DISTINCT pm.account_id, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::JSONB
+		FROM order_pharmacies op
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		JOIN pharmacies p ON p.pharmacy_id = pc.pharmacy_id
+		JOIN pharmacy_managers pm ON pm.pharmacy_manager_id = p.pharmacy_manager_id
+		WHERE op.order_id = $1
+	`
+
+	CreateNotificationForOrderPharmacyUserQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		SELECT u.account_id, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::JSONB
+		FROM order_pharmacies op
+		JOIN orders o ON o.order_id = op.order_id
+		JOIN users u ON u.user_id = o.user_id
+		WHERE op.order_pharmacy_id = $1
+	`
+
+	CreateNotificationForOrderPharmacyManagerQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		SELECT pm.account_id, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::JSONB
+		FROM order_pharmacies op
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		JOIN pharmacies p ON p.pharmacy_id = pc.pharmacy_id
+		JOIN pharmacy_managers pm ON pm.pharmacy_manager_id = p.pharmacy_manager_id
+		WHERE op.order_pharmacy_id = $1
+	`
+
+	CreateNotificationForPharmacyManagerQuery = `
+		INSERT INTO notifications (account_id, notification_type, title, message, data)
+		SELECT pm.account_id, $2::VARCHAR, $3::VARCHAR, $4::VARCHAR, $5::JSONB
+		FROM pharmacies p
+		JOIN pharmacy_managers pm ON pm.pharmacy_manager_id = p.pharmacy_manager_id
+		WHERE p.pharmacy_id = $1
+	`
+
+	FindAllNotificationsByAccountIdQuery = `
+		SELECT notification_id, account_id, notification_type, title, message, data, read_at, created_at
+		FROM notifications
+		WHERE account_id = $1
+		AND ($2 = FALSE OR read_at IS NULL)
+		ORDER BY created_at DESC, notification_id DESC
+		LIMIT $3
+		OFFSET $4
+	`
+
+	CountNotificationsByAccountIdQuery = `
+		SELECT COUNT(*) FILTER (WHERE $2 = FALSE OR read_at IS NULL), COUNT(*) FILTER (WHERE read_at IS NULL)
+		FROM notifications
+		WHERE account_id = $1
+	`
+
+	MarkNotificationsReadByIdsQuery = `
+		UPDATE notifications
+		SET read_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND notification_id = ANY($2::BIGINT[])
+		AND read_at IS NULL
+	`
+
+	MarkAllNotificationsReadQuery = `
+		UPDATE notifications
+		SET read_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND read_at IS NULL
+	`
+
+	ClaimUnpushedNotificationsQuery = `
+		WITH stale AS (
+			UPDATE notifications
+			SET pushed_at = NOW()
+			WHERE pushed_at IS NULL
+			AND created_at < NOW() - $1 * INTERVAL '1 second'
+		)
+		UPDATE notifications
+		SET pushed_at = NOW()
+		WHERE notification_id IN (
+			SELECT notification_id
+			FROM notifications
+			WHERE pushed_at IS NULL
+			AND created_at >= NOW() - $1 * INTERVAL '1 second'
+			ORDER BY created_at, notification_id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING notification_id, account_id, notification_type, title, message, data, read_at, created_at
+	`
+)
@@ -0,0 +1,45 @@
+package database
+
+const (
+	CreateOidcLoginStateQuery = `
+		INSERT
+		INTO oidc_login_states (state, provider, nonce, code_verifier, link_account_id, expired_at)
+		VALUES ($1, $2, $3, $4, $5, NOW() + $6 * INTERVAL '1 minute')
+	`
+
+	ConsumeOidcLoginStateQuery = `
+		UPDATE oidc_login_states
+		SET used_at = NOW(), updated_at = NOW()
+		WHERE state = $1
+		AND provider = $2
+		AND used_at IS NULL
+		AND expired_at > NOW()
+		AND deleted_at IS NULL
+		RETURNING state, provider, nonce, code_verifier, link_account_id
+	`
+
+	FindAccountByIdentityQuery = `
+		SELECT a.account_id, a.email, a.password, a.role_id, r.role_name, a.account_name, a.profile_picture, a.verified_at
+		FROM account_identities ai
+		JOIN accounts a ON ai.account_id = a.account_id
+		JOIN roles r ON a.role_id = r.role_id
+		WHERE ai.provider = $1
+		AND ai.subject = $2
+		AND ai.deleted_at IS NULL
+		AND a.deleted_at IS NULL
+	`
+
+	CreateAccountIdentityQuery = `
+		INSERT
+		INTO account_identities (account_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	UpdateAccountIdentityLastLoginQuery = `
+		UPDATE account_identities
+		SET email = $3, last_login_at = NOW(), updated_at = NOW()
+		WHERE provider = $1
+		AND subject = $2
+		AND deleted_at IS NULL
+	`
+)
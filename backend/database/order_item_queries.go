@@ -2,7 +2,7 @@ package database
 
 const (
 	FindAllOrderItemByOrderPharmacyId = `
-		SELECT oi.order_item_id, oi.drug_name, oi.drug_price, oi.drug_unit, oi.quantity, d.image
+		SELECT oi.order_item_id, oi.drug_name, oi.drug_price, oi.drug_unit, oi.quantity, d.image, oi.prescription_id
 		FROM order_items oi
 		JOIN order_pharmacies op ON op.order_pharmacy_id = oi.order_pharmacy_id
 		JOIN drugs d ON oi.drug_id = d.drug_id
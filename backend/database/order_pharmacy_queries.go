@@ -69,9 +69,11 @@ const (
 	`
 
 	FindAllOrderPharmaciesByOrderId = `
-		SELECT op.order_pharmacy_id, o.user_id, op.order_status_id
+		SELECT op.order_pharmacy_id, o.user_id, op.order_status_id, p.pharmacy_manager_id
 		FROM orders o
 		JOIN order_pharmacies op ON op.order_id = o.order_id
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		JOIN pharmacies p ON p.pharmacy_id = pc.pharmacy_id
 		WHERE o.order_id = $1 
 		AND o.deleted_at IS NULL
 	`
@@ -94,9 +96,11 @@ const (
 
 	FindOrderPharmacyByOrderPharmacyId = `
 		SELECT op.order_pharmacy_id, o.user_id, op.order_id, op.order_status_id, op.pharmacy_courier_id, op.subtotal_amount, 
-			op.delivery_fee
+			op.delivery_fee, p.pharmacy_manager_id
 		FROM order_pharmacies op
 		JOIN orders o ON op.order_id = o.order_id
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		JOIN pharmacies p ON p.pharmacy_id = pc.pharmacy_id
 		WHERE op.order_pharmacy_id = $1 
 		AND op.deleted_at IS NULL
 	`
@@ -61,7 +61,7 @@ const (
 	`
 
 	CreateOrderItems = `
-		INSERT INTO order_items(order_pharmacy_id, drug_id, drug_name, pharmacy_drug_id, drug_price, drug_unit, quantity)
+		INSERT INTO order_items(order_pharmacy_id, drug_id, drug_name, pharmacy_drug_id, drug_price, drug_unit, quantity, prescription_id)
 		VALUES
 	`
 
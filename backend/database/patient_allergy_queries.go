@@ -0,0 +1,22 @@
+package database
+
+const (
+	GetAllergensByUserAccountIdQuery = `
+		SELECT allergen
+		FROM patient_allergies
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	DeleteAllergensByUserAccountIdQuery = `
+		UPDATE patient_allergies
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	CreateAllergensQuery = `
+		INSERT INTO patient_allergies (user_account_id, allergen)
+		SELECT $1, UNNEST($2::VARCHAR[])
+	`
+)
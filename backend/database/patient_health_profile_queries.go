@@ -0,0 +1,84 @@
+package database
+
+const (
+	FindHealthProfileByUserAccountIdQuery = `
+		SELECT height_cm, weight_kg, pregnancy_status, updated_at
+		FROM patient_health_profiles
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	UpsertHealthProfileQuery = `
+		INSERT INTO patient_health_profiles (user_account_id, height_cm, weight_kg, pregnancy_status)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_account_id)
+		DO UPDATE SET height_cm = EXCLUDED.height_cm,
+		weight_kg = EXCLUDED.weight_kg,
+		pregnancy_status = EXCLUDED.pregnancy_status,
+		updated_at = NOW(),
+		deleted_at = NULL
+	`
+
+	GetChronicConditionsByUserAccountIdQuery = `
+		SELECT condition_name
+		FROM patient_chronic_conditions
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+		ORDER BY patient_chronic_condition_id
+	`
+
+	DeleteChronicConditionsByUserAccountIdQuery = `
+		UPDATE patient_chronic_conditions
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	CreateChronicConditionsQuery = `
+		INSERT INTO patient_chronic_conditions (user_account_id, condition_name)
+		SELECT $1, UNNEST($2::VARCHAR[])
+	`
+
+	GetMedicationsByUserAccountIdQuery = `
+		SELECT medication_name, dosage
+		FROM patient_medications
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+		ORDER BY patient_medication_id
+	`
+
+	DeleteMedicationsByUserAccountIdQuery = `
+		UPDATE patient_medications
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	CreateMedicationQuery = `
+		INSERT INTO patient_medications (user_account_id, medication_name, dosage)
+		VALUES ($1, $2, $3)
+	`
+
+	CreateHealthProfileAccessLogQuery = `
+		INSERT INTO health_profile_access_logs (user_account_id, doctor_account_id, ws_chat_room_id, access_source)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	GetHealthProfileAccessLogsByUserAccountIdQuery = `
+		SELECT hpal.health_profile_access_log_id, hpal.user_account_id, hpal.doctor_account_id, a.account_name, hpal.ws_chat_room_id, hpal.access_source, hpal.created_at
+		FROM health_profile_access_logs hpal
+		JOIN accounts a ON a.account_id = hpal.doctor_account_id
+		WHERE hpal.user_account_id = $1
+		AND hpal.deleted_at IS NULL
+		ORDER BY hpal.created_at DESC, hpal.health_profile_access_log_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetHealthProfileAccessLogsByUserAccountIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM health_profile_access_logs
+		WHERE user_account_id = $1
+		AND deleted_at IS NULL
+	`
+)
@@ -12,10 +12,16 @@ const (
 			ST_DistanceSphere((ST_SetSRID(ST_MakePoint($2, $3), 4326)), p.geom),
 			pd.drug_id,
 			pd.price,
-			pd.stock
+			pd.stock,
+			COALESCE(pr.average_rating, 0)::FLOAT8,
+			COALESCE(pr.review_count, 0),
+			COALESCE(oir.average_rating, 0)::FLOAT8,
+			COALESCE(oir.review_count, 0)
 		FROM pharmacy_drugs pd
 		JOIN pharmacies p ON p.pharmacy_id = pd.pharmacy_id
 		JOIN drugs d ON d.drug_id = pd.drug_id
+		` + PharmacyRatingJoin + `
+		` + PharmacyDrugRatingJoin + `
 		WHERE d.drug_id = $1 
 			AND pd.deleted_at IS NULL
 			AND ST_DistanceSphere((ST_SetSRID(ST_MakePoint($2, $3), 4326)), p.geom) <= 25000
@@ -74,23 +80,26 @@ const (
 	GetProductListingQuery = `
 		SELECT 
 			total_count,
-			pharmacy_drug_id,
-			drug_id,
-			drug_name,
-			min_price,
-			max_price,
-			image,
-			is_prescription_required
-		FROM closest_drug, paging
-	`
+			cd.pharmacy_drug_id,
+			cd.drug_id,
+			cd.drug_name,
+			cd.min_price,
+			cd.max_price,
+			cd.image,
+			cd.is_prescription_required,
+			COALESCE(dr.average_rating, 0)::FLOAT8,
+			COALESCE(dr.review_count, 0)
+		FROM closest_drug cd
+		CROSS JOIN paging
+	` + DrugRatingJoin
 
 	GetProductCountQuery = `
 		SELECT COUNT(drug_id) FROM closest_drug;
 	`
 
 	GetPharmacyDrugById = `
-		select pharmacy_drug_id, pharmacy_id, drug_id, price, stock 
-		from pharmacy_drugs where pharmacy_drug_id = $1 and deleted_at is null
+		select pd.pharmacy_drug_id, pd.pharmacy_id, pd.drug_id, pd.price, pd.stock, d.is_prescription_required
+		from pharmacy_drugs pd join drugs d on d.drug_id = pd.drug_id where pd.pharmacy_drug_id = $1 and pd.deleted_at is null
 	`
 
 	GetPharmacyDrugsByCartForUpdate = `
@@ -192,6 +201,7 @@ const (
 	AddPharmacyDrug = `
 		insert into pharmacy_drugs (pharmacy_id, drug_id, stock, price)
 		VALUES ($1, $2, $3, $4)
+		RETURNING pharmacy_drug_id
 	`
 
 	GetPossibleStockMutation = `
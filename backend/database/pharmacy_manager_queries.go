@@ -4,6 +4,7 @@ const (
 	PostOnePharmacyManagerQuery = `
 		INSERT INTO pharmacy_managers (account_id)
 		VALUES ($1)
+		RETURNING pharmacy_manager_id
 	`
 
 	FindAllPharmacyManagers = `
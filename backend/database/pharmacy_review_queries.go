@@ -0,0 +1,176 @@
+package database
+
+const (
+	PharmacyRatingJoin = `
+		LEFT JOIN (
+			SELECT pharmacy_id, AVG(rating) AS average_rating, COUNT(*) AS review_count
+			FROM pharmacy_reviews
+			WHERE deleted_at IS NULL
+			GROUP BY pharmacy_id
+		) pr ON pr.pharmacy_id = p.pharmacy_id
+	`
+
+	PharmacyDrugRatingJoin = `
+		LEFT JOIN (
+			SELECT pharmacy_drug_id, AVG(rating) AS average_rating, COUNT(*) AS review_count
+			FROM order_item_reviews
+			WHERE deleted_at IS NULL
+			GROUP BY pharmacy_drug_id
+		) oir ON oir.pharmacy_drug_id = pd.pharmacy_drug_id
+	`
+
+	DrugRatingJoin = `
+		LEFT JOIN (
+			SELECT drug_id, AVG(rating) AS average_rating, COUNT(*) AS review_count
+			FROM order_item_reviews
+			WHERE deleted_at IS NULL
+			GROUP BY drug_id
+		) dr ON dr.drug_id = cd.drug_id
+	`
+
+	FindReviewableOrderPharmacyQuery = `
+		SELECT op.order_pharmacy_id, o.user_id, op.order_status_id, pc.pharmacy_id
+		FROM order_pharmacies op
+		JOIN orders o ON o.order_id = op.order_id
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		WHERE op.order_pharmacy_id = $1
+		AND op.deleted_at IS NULL
+	`
+
+	FindReviewableOrderItemQuery = `
+		SELECT oi.order_item_id, oi.order_pharmacy_id, oi.pharmacy_drug_id, oi.drug_id, o.user_id, op.order_status_id, pc.pharmacy_id
+		FROM order_items oi
+		JOIN order_pharmacies op ON op.order_pharmacy_id = oi.order_pharmacy_id
+		JOIN orders o ON o.order_id = op.order_id
+		JOIN pharmacy_couriers pc ON pc.pharmacy_courier_id = op.pharmacy_courier_id
+		WHERE oi.order_item_id = $1
+		AND oi.deleted_at IS NULL
+	`
+
+	CreatePharmacyReviewQuery = `
+		INSERT INTO pharmacy_reviews (order_pharmacy_id, pharmacy_id, user_id, rating, review)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING pharmacy_review_id
+	`
+
+	UpdatePharmacyReviewQuery = `
+		UPDATE pharmacy_reviews
+		SET rating = $1, review = $2, updated_at = NOW()
+		WHERE pharmacy_review_id = $3
+		AND deleted_at IS NULL
+	`
+
+	UpdatePharmacyReviewReplyQuery = `
+		UPDATE pharmacy_reviews
+		SET manager_reply = $1, replied_at = NOW(), updated_at = NOW()
+		WHERE pharmacy_review_id = $2
+		AND deleted_at IS NULL
+	`
+
+	GetPharmacyReviewQuery = `
+		SELECT pr.pharmacy_review_id, pr.order_pharmacy_id, pr.pharmacy_id, p.pharmacy_manager_id, pr.user_id, a.account_name, a.profile_picture,
+		pr.rating, pr.review, pr.manager_reply, pr.replied_at, pr.created_at, pr.updated_at
+		FROM pharmacy_reviews pr
+		JOIN pharmacies p ON p.pharmacy_id = pr.pharmacy_id
+		JOIN users u ON u.user_id = pr.user_id
+		JOIN accounts a ON a.account_id = u.account_id
+		WHERE pr.deleted_at IS NULL
+	`
+
+	FindPharmacyReviewByIdQuery = GetPharmacyReviewQuery + `
+		AND pr.pharmacy_review_id = $1
+	`
+
+	FindPharmacyReviewByOrderPharmacyIdQuery = GetPharmacyReviewQuery + `
+		AND pr.order_pharmacy_id = $1
+	`
+
+	GetAllPharmacyReviewByPharmacyIdQuery = GetPharmacyReviewQuery + `
+		AND pr.pharmacy_id = $1
+		ORDER BY pr.created_at DESC, pr.pharmacy_review_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetAllPharmacyReviewByPharmacyIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM pharmacy_reviews pr
+		WHERE pr.deleted_at IS NULL
+		AND pr.pharmacy_id = $1
+	`
+
+	CreateOrderItemReviewQuery = `
+		INSERT INTO order_item_reviews (order_item_id, pharmacy_id, pharmacy_drug_id, drug_id, user_id, rating, review)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING order_item_review_id
+	`
+
+	UpdateOrderItemReviewQuery = `
+		UPDATE order_item_reviews
+		SET rating = $1, review = $2, updated_at = NOW()
+		WHERE order_item_review_id = $3
+		AND deleted_at IS NULL
+	`
+
+	UpdateOrderItemReviewReplyQuery = `
+		UPDATE order_item_reviews
+		SET manager_reply = $1, replied_at = NOW(), updated_at = NOW()
+		WHERE order_item_review_id = $2
+		AND deleted_at IS NULL
+	`
+
+	GetOrderItemReviewQuery = `
+		SELECT oir.order_item_review_id, oir.order_item_id, oir.pharmacy_id, p.pharmacy_name, p.pharmacy_manager_id, oir.pharmacy_drug_id, oir.drug_id,
+		oir.user_id, a.account_name, a.profile_picture, oir.rating, oir.review, oir.manager_reply, oir.replied_at, oir.created_at, oir.updated_at
+		FROM order_item_reviews oir
+		JOIN pharmacies p ON p.pharmacy_id = oir.pharmacy_id
+		JOIN users u ON u.user_id = oir.user_id
+		JOIN accounts a ON a.account_id = u.account_id
+		WHERE oir.deleted_at IS NULL
+	`
+
+	FindOrderItemReviewByIdQuery = GetOrderItemReviewQuery + `
+		AND oir.order_item_review_id = $1
+	`
+
+	FindOrderItemReviewByOrderItemIdQuery = GetOrderItemReviewQuery + `
+		AND oir.order_item_id = $1
+	`
+
+	GetAllOrderItemReviewByDrugIdQuery = GetOrderItemReviewQuery + `
+		AND oir.drug_id = $1
+		ORDER BY oir.created_at DESC, oir.order_item_review_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetAllOrderItemReviewByDrugIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM order_item_reviews oir
+		WHERE oir.deleted_at IS NULL
+		AND oir.drug_id = $1
+	`
+
+	FindPharmacyRatingReport = `
+		WITH pharmacy_rating AS (
+			SELECT DATE_TRUNC('month', created_at) AS period, COUNT(*) AS review_count, AVG(rating) AS average_rating
+			FROM pharmacy_reviews
+			WHERE pharmacy_id = $1
+			AND created_at <= $2 AND created_at >= $3
+			AND deleted_at IS NULL
+			GROUP BY DATE_TRUNC('month', created_at)
+		), order_item_rating AS (
+			SELECT DATE_TRUNC('month', created_at) AS period, COUNT(*) AS review_count, AVG(rating) AS average_rating
+			FROM order_item_reviews
+			WHERE pharmacy_id = $1
+			AND created_at <= $2 AND created_at >= $3
+			AND deleted_at IS NULL
+			GROUP BY DATE_TRUNC('month', created_at)
+		)
+		SELECT COALESCE(pr.period, oir.period) AS period,
+		COALESCE(pr.review_count, 0), COALESCE(pr.average_rating, 0)::FLOAT8,
+		COALESCE(oir.review_count, 0), COALESCE(oir.average_rating, 0)::FLOAT8
+		FROM pharmacy_rating pr
+		FULL OUTER JOIN order_item_rating oir ON oir.period = pr.period
+	`
+)
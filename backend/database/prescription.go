@@ -2,13 +2,13 @@ package database
 
 const (
 	CreateOnePrescriptionQuery = `
-		INSERT INTO prescriptions (user_account_id, doctor_account_id)
-		VALUES ($1, $2)
+		INSERT INTO prescriptions (user_account_id, doctor_account_id, expired_at, refill_count)
+		VALUES ($1, $2, $3, $4)
 		RETURNING prescription_id
 	`
 
 	GetPrescriptionByIdQuery = `
-		SELECT user_account_id, doctor_account_id, redeemed_at, ordered_at
+		SELECT user_account_id, doctor_account_id, redeemed_at, ordered_at, expired_at, refill_count
 		FROM prescriptions
 		WHERE prescription_id = $1
 		AND deleted_at IS NULL
@@ -21,7 +21,7 @@ const (
 	`
 
 	GetPrescriptionListByUserAccountIdQuery = `
-		SELECT p.prescription_id, p.user_account_id, a1.account_name, p.doctor_account_id, a2.account_name, p.redeemed_at, p.ordered_at, p.created_at
+		SELECT p.prescription_id, p.user_account_id, a1.account_name, p.doctor_account_id, a2.account_name, p.redeemed_at, p.ordered_at, p.expired_at, p.refill_count, p.created_at
 		FROM prescriptions p
 		JOIN accounts a1 ON a1.account_id = p.user_account_id
 		JOIN accounts a2 ON a2.account_id = p.doctor_account_id
@@ -37,9 +37,58 @@ const (
 		WHERE p.user_account_id = $1 AND p.deleted_at IS NULL
 	`
 
+	GetPrescriptionDocumentQuery = `
+		SELECT p.prescription_id, p.user_account_id, a1.account_name, g.gender_name, u.date_of_birth,
+			p.doctor_account_id, a2.account_name, ds.specialization_name, d.certificate,
+			p.verification_code, p.signature, p.redeemed_at, p.ordered_at, p.expired_at, p.refill_count, p.created_at
+		FROM prescriptions p
+		JOIN accounts a1 ON a1.account_id = p.user_account_id
+		LEFT JOIN users u ON u.account_id = p.user_account_id
+		LEFT JOIN genders g ON g.gender_id = u.gender_id
+		JOIN accounts a2 ON a2.account_id = p.doctor_account_id
+		JOIN doctors d ON d.account_id = p.doctor_account_id
+		LEFT JOIN doctor_specializations ds ON ds.specialization_id = d.specialization_id
+		WHERE p.deleted_at IS NULL
+	`
+
+	GetPrescriptionDocumentByIdQuery = GetPrescriptionDocumentQuery + `
+		AND p.prescription_id = $1
+	`
+
+	GetPrescriptionDocumentByVerificationCodeQuery = GetPrescriptionDocumentQuery + `
+		AND p.verification_code = $1
+	`
+
+	SetPrescriptionSignatureQuery = `
+		UPDATE prescriptions
+		SET verification_code = $2, signature = $3, updated_at = NOW()
+		WHERE prescription_id = $1
+		AND verification_code IS NULL
+		AND deleted_at IS NULL
+	`
+
 	SetPrescriptionOrderedAtNowQuery = `
 		UPDATE prescriptions
 		SET ordered_at = NOW(), updated_at = NOW()
 		WHERE prescription_id = $1
+		AND ordered_at IS NULL
+	`
+
+	MedicalRecordPrescriptionQuery = `
+		SELECT p.prescription_id, p.user_account_id, a1.account_name, p.doctor_account_id, a2.account_name, p.redeemed_at, p.ordered_at, p.expired_at, p.refill_count,
+			(SELECT c.chat_room_id FROM chats c WHERE c.prescription_id = p.prescription_id AND c.deleted_at IS NULL LIMIT 1), p.created_at
+		FROM prescriptions p
+		JOIN accounts a1 ON a1.account_id = p.user_account_id
+		JOIN accounts a2 ON a2.account_id = p.doctor_account_id
+		WHERE p.deleted_at IS NULL
+	`
+
+	GetMedicalRecordPrescriptionByIdQuery = MedicalRecordPrescriptionQuery + `
+		AND p.prescription_id = $1
+	`
+
+	GetMedicalRecordPrescriptionsByUserAccountIdQuery = MedicalRecordPrescriptionQuery + `
+		AND p.user_account_id = $1
+		ORDER BY p.created_at DESC, p.prescription_id DESC
 	`
 )
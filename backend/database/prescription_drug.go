@@ -7,9 +7,38 @@ const (
 	`
 
 	GetAllPrescriptionDrugQuery = `
-		SELECT pd.prescription_drug_id, d.drug_id, d.drug_name, d.image, d.is_active, pd.quantity, pd.note
+		SELECT pd.prescription_drug_id, d.drug_id, d.drug_name, d.image, d.is_active, pd.quantity, pd.dispensed_quantity, pd.note
 		FROM prescription_drugs pd 
 		JOIN drugs d ON d.drug_id = pd.drug_id
 		WHERE pd.prescription_id = $1
+		ORDER BY pd.prescription_drug_id
+	`
+
+	GetRemainingPrescriptionQuantityQuery = `
+		SELECT pd.quantity * (p.refill_count + 1) - pd.dispensed_quantity
+		FROM prescription_drugs pd
+		JOIN prescriptions p ON p.prescription_id = pd.prescription_id
+		JOIN pharmacy_drugs phd ON phd.drug_id = pd.drug_id
+		WHERE pd.prescription_id = $1
+		AND p.user_account_id = $2
+		AND phd.pharmacy_drug_id = $3
+		AND p.expired_at > NOW()
+		AND pd.deleted_at IS NULL
+		AND p.deleted_at IS NULL
+	`
+
+	DispensePrescriptionDrugQuery = `
+		UPDATE prescription_drugs pd
+		SET dispensed_quantity = pd.dispensed_quantity + $3, updated_at = NOW()
+		FROM prescriptions p, pharmacy_drugs phd
+		WHERE pd.prescription_id = $1
+		AND p.prescription_id = pd.prescription_id
+		AND phd.pharmacy_drug_id = $2
+		AND phd.drug_id = pd.drug_id
+		AND p.user_account_id = $4
+		AND pd.dispensed_quantity + $3 <= pd.quantity * (p.refill_count + 1)
+		AND p.expired_at > NOW()
+		AND pd.deleted_at IS NULL
+		AND p.deleted_at IS NULL
 	`
 )
@@ -3,20 +3,35 @@ package database
 const (
 	PostOneRefreshTokenQuery = `
 		INSERT 
-		INTO refresh_tokens (account_id, refresh_token, expired_at)
-		VALUES ($1, $2, NOW() + INTERVAL '1 day')
+		INTO refresh_tokens (account_id, session_id, refresh_token, expired_at)
+		VALUES ($1, $2, $3, NOW() + INTERVAL '1 day')
 	`
 
-	InvalidateRefreshTokensQuery = `
+	FindOneRefreshTokenForUpdateQuery = `
+		SELECT refresh_token_id, account_id, session_id, expired_at, used_at, revoked_at
+		FROM refresh_tokens
+		WHERE refresh_token = $1
+		AND deleted_at IS NULL
+		FOR UPDATE
+	`
+
+	MarkRefreshTokenUsedQuery = `
 		UPDATE refresh_tokens
-		SET expired_at = NOW(), updated_at = NOW()
-		WHERE account_id = $1
+		SET used_at = NOW(), updated_at = NOW()
+		WHERE refresh_token_id = $1
 	`
 
-	FindOneRefreshTokenQuery = `
-		SELECT refresh_token
-		FROM refresh_tokens
-		WHERE refresh_token = $1 
-		AND expired_at > NOW()
+	RevokeRefreshTokensBySessionIdQuery = `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE session_id = $1
+		AND revoked_at IS NULL
+	`
+
+	RevokeRefreshTokensByAccountIdQuery = `
+		UPDATE refresh_tokens
+		SET revoked_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND revoked_at IS NULL
 	`
-)
\ No newline at end of file
+)
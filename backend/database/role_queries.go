@@ -0,0 +1,75 @@
+package database
+
+const (
+	RoleQuery = `
+		SELECT role_id, role_name, is_operator, two_factor_required
+		FROM roles
+		WHERE deleted_at IS NULL
+	`
+
+	GetRolesQuery = RoleQuery + `
+		ORDER BY role_id
+	`
+
+	FindRoleByIdQuery = RoleQuery + `
+		AND role_id = $1
+	`
+
+	FindRoleByNameQuery = RoleQuery + `
+		AND role_name = $1
+	`
+
+	FindRoleByAccountIdQuery = `
+		SELECT r.role_id, r.role_name, r.is_operator, r.two_factor_required
+		FROM accounts a
+		JOIN roles r ON r.role_id = a.role_id
+		WHERE a.account_id = $1
+		AND a.deleted_at IS NULL
+		AND r.deleted_at IS NULL
+	`
+
+	GetRolePermissionsQuery = `
+		SELECT rp.role_id, p.permission_name
+		FROM role_permissions rp
+		JOIN permissions p ON p.permission_id = rp.permission_id
+		WHERE rp.deleted_at IS NULL
+		AND p.deleted_at IS NULL
+		ORDER BY p.permission_name
+	`
+
+	GetPermissionsQuery = `
+		SELECT permission_id, permission_name, description
+		FROM permissions
+		WHERE deleted_at IS NULL
+		ORDER BY permission_name
+	`
+
+	CountPermissionsByNamesQuery = `
+		SELECT COUNT(*)
+		FROM permissions
+		WHERE permission_name = ANY($1::VARCHAR[])
+		AND deleted_at IS NULL
+	`
+
+	DeleteRolePermissionsQuery = `
+		DELETE
+		FROM role_permissions
+		WHERE role_id = $1
+	`
+
+	CreateRolePermissionsQuery = `
+		INSERT
+		INTO role_permissions (role_id, permission_id)
+		SELECT $1, permission_id
+		FROM permissions
+		WHERE permission_name = ANY($2::VARCHAR[])
+		AND deleted_at IS NULL
+	`
+
+	UpdateAccountRoleQuery = `
+		UPDATE accounts
+		SET role_id = $2, updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+)
@@ -0,0 +1,58 @@
+package database
+
+const (
+	CreateSickLeaveCertificateQuery = `
+		INSERT INTO sick_leave_certificates (ws_chat_room_id, user_account_id, doctor_account_id, start_date, days, diagnosis_summary, verification_code, signature)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING sick_leave_certificate_id
+	`
+
+	SickLeaveCertificateQuery = `
+		SELECT slc.sick_leave_certificate_id, slc.ws_chat_room_id, slc.user_account_id, a1.account_name, g.gender_name, u.date_of_birth,
+			slc.doctor_account_id, a2.account_name, ds.specialization_name, d.certificate,
+			slc.start_date, slc.days, slc.diagnosis_summary, slc.verification_code, slc.signature, slc.created_at
+		FROM sick_leave_certificates slc
+		JOIN accounts a1 ON a1.account_id = slc.user_account_id
+		LEFT JOIN users u ON u.account_id = slc.user_account_id
+		LEFT JOIN genders g ON g.gender_id = u.gender_id
+		JOIN accounts a2 ON a2.account_id = slc.doctor_account_id
+		JOIN doctors d ON d.account_id = slc.doctor_account_id
+		LEFT JOIN doctor_specializations ds ON ds.specialization_id = d.specialization_id
+		WHERE slc.deleted_at IS NULL
+	`
+
+	FindSickLeaveCertificateByIdQuery = SickLeaveCertificateQuery + `
+		AND slc.sick_leave_certificate_id = $1
+	`
+
+	FindSickLeaveCertificateByVerificationCodeQuery = SickLeaveCertificateQuery + `
+		AND slc.verification_code = $1
+	`
+
+	UserDocumentQuery = `
+		SELECT 'prescription' AS document_type, p.prescription_id AS document_id, a.account_name AS doctor_name, p.created_at AS issued_at
+		FROM prescriptions p
+		JOIN accounts a ON a.account_id = p.doctor_account_id
+		WHERE p.user_account_id = $1
+		AND p.deleted_at IS NULL
+		UNION ALL
+		SELECT 'sick_leave_certificate' AS document_type, slc.sick_leave_certificate_id AS document_id, a.account_name AS doctor_name, slc.created_at AS issued_at
+		FROM sick_leave_certificates slc
+		JOIN accounts a ON a.account_id = slc.doctor_account_id
+		WHERE slc.user_account_id = $1
+		AND slc.deleted_at IS NULL
+	`
+
+	GetUserDocumentsByUserAccountIdQuery = `
+		SELECT document_type, document_id, doctor_name, issued_at
+		FROM (` + UserDocumentQuery + `) user_documents
+		ORDER BY issued_at DESC, document_id DESC
+		LIMIT $2
+		OFFSET $3
+	`
+
+	GetUserDocumentsByUserAccountIdTotalItemQuery = `
+		SELECT COUNT(*)
+		FROM (` + UserDocumentQuery + `) user_documents
+	`
+)
@@ -0,0 +1,73 @@
+package database
+
+const (
+	FindTwoFactorByAccountIdQuery = `
+		SELECT account_id, secret, last_used_step, enabled_at
+		FROM account_two_factors
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	FindTwoFactorByAccountIdForUpdateQuery = FindTwoFactorByAccountIdQuery + `
+		FOR UPDATE
+	`
+
+	UpsertPendingTwoFactorQuery = `
+		INSERT
+		INTO account_two_factors (account_id, secret)
+		VALUES ($1, $2)
+		ON CONFLICT (account_id)
+		DO UPDATE SET secret = EXCLUDED.secret, last_used_step = 0, enabled_at = NULL, deleted_at = NULL, updated_at = NOW()
+	`
+
+	EnableTwoFactorQuery = `
+		UPDATE account_two_factors
+		SET enabled_at = NOW(), last_used_step = $2, updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	UpdateTwoFactorLastUsedStepQuery = `
+		UPDATE account_two_factors
+		SET last_used_step = $2, updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	DeleteTwoFactorQuery = `
+		UPDATE account_two_factors
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+
+	CreateRecoveryCodesQuery = `
+		INSERT
+		INTO two_factor_recovery_codes (account_id, code_hash)
+		SELECT $1, UNNEST($2::VARCHAR[])
+	`
+
+	UseRecoveryCodeQuery = `
+		UPDATE two_factor_recovery_codes
+		SET used_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND code_hash = $2
+		AND used_at IS NULL
+		AND deleted_at IS NULL
+	`
+
+	CountRemainingRecoveryCodesQuery = `
+		SELECT COUNT(*)
+		FROM two_factor_recovery_codes
+		WHERE account_id = $1
+		AND used_at IS NULL
+		AND deleted_at IS NULL
+	`
+
+	DeleteRecoveryCodesQuery = `
+		UPDATE two_factor_recovery_codes
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE account_id = $1
+		AND deleted_at IS NULL
+	`
+)
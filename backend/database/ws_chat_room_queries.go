@@ -32,7 +32,8 @@ const (
 			CASE 
 				WHEN c.chat_message IS NULL THEN cr.created_at
 				ELSE c.created_at
-			END
+			END AS last_activity_at,
+			unread.unread_count
 		FROM ws_chat_rooms cr 
 		JOIN accounts a  ON a.account_id  =
 			CASE
@@ -44,12 +45,22 @@ const (
 			FROM chats c2
 			WHERE c2.chat_room_id = cr.ws_chat_room_id 
 			ORDER BY created_at DESC limit 1)
+		LEFT JOIN chat_read_states rs ON rs.chat_room_id = cr.ws_chat_room_id 
+			AND rs.account_id = $1
+			AND rs.deleted_at IS NULL
+		CROSS JOIN LATERAL
+			(SELECT COUNT(c3.chat_id) AS unread_count
+			FROM chats c3
+			WHERE c3.chat_room_id = cr.ws_chat_room_id
+			AND c3.sender_account_id <> $1
+			AND c3.chat_id > COALESCE(rs.last_read_chat_id, 0)
+			AND c3.deleted_at IS NULL) unread
 		WHERE cr.deleted_at IS NULL AND 
 			CASE
 				WHEN cr.user_account_id = $1 THEN cr.user_account_id = $1
 				WHEN cr.doctor_account_id = $1 THEN cr.doctor_account_id = $1
 			END
-		ORDER BY created_at DESC;
+		ORDER BY unread.unread_count > 0 DESC, last_activity_at DESC;
 	`
 
 	FindWsChatRoomByIdQuery = `
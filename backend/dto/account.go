@@ -50,6 +50,8 @@ type VerifyTokenReq struct {
 type TokenDataRes struct {
 	AccountId int64  `json:"account_id"`
 	Role      string `json:"role"`
+	SessionId string `json:"session_id"`
+	TwoFactor bool   `json:"two_factor"`
 }
 
 func RegisterRequestToAccount(RegisterRquest RegisterRequest) entity.Account {
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+)
+
+type AccountSessionResponse struct {
+	SessionId  string    `json:"session_id"`
+	UserAgent  string    `json:"user_agent"`
+	IpAddress  string    `json:"ip_address"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiredAt  time.Time `json:"expired_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	IsCurrent  bool      `json:"is_current"`
+}
+
+func ConvertToAccountSessionResponses(sessions []entity.AccountSession, currentSessionId string) []AccountSessionResponse {
+	responses := []AccountSessionResponse{}
+	for _, session := range sessions {
+		responses = append(responses, AccountSessionResponse{
+			SessionId:  session.SessionId,
+			UserAgent:  session.UserAgent,
+			IpAddress:  session.IpAddress,
+			LastUsedAt: session.LastUsedAt,
+			ExpiredAt:  session.ExpiredAt,
+			CreatedAt:  session.CreatedAt,
+			IsCurrent:  session.SessionId == currentSessionId,
+		})
+	}
+
+	return responses
+}
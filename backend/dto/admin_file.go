@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+)
+
+type AdminFileResponse struct {
+	Id          int64     `json:"admin_file_id"`
+	Url         string    `json:"file_url"`
+	Name        string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"file_size"`
+	UploadedBy  int64     `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type AdminFileListResponse struct {
+	PageInfo entity.PageInfo     `json:"page_info"`
+	Files    []AdminFileResponse `json:"files"`
+}
+
+func ConvertToAdminFileResponse(adminFile entity.AdminFile) AdminFileResponse {
+	return AdminFileResponse{
+		Id:          adminFile.Id,
+		Url:         adminFile.Url,
+		Name:        adminFile.Name,
+		ContentType: adminFile.ContentType,
+		Size:        adminFile.Size,
+		UploadedBy:  adminFile.UploadedBy,
+		CreatedAt:   adminFile.CreatedAt,
+	}
+}
+
+func ConvertToAdminFileListResponse(adminFiles []entity.AdminFile, pageInfo entity.PageInfo) AdminFileListResponse {
+	response := AdminFileListResponse{
+		PageInfo: pageInfo,
+		Files:    []AdminFileResponse{},
+	}
+
+	for _, adminFile := range adminFiles {
+		response.Files = append(response.Files, ConvertToAdminFileResponse(adminFile))
+	}
+
+	return response
+}
@@ -0,0 +1,99 @@
+package dto
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+type AuditLogResponse struct {
+	Id             int64           `json:"audit_log_id"`
+	ActorAccountId *int64          `json:"actor_account_id"`
+	ActorRole      *string         `json:"actor_role"`
+	Action         string          `json:"action"`
+	EntityType     string          `json:"entity_type"`
+	EntityId       int64           `json:"entity_id"`
+	Before         json.RawMessage `json:"before"`
+	After          json.RawMessage `json:"after"`
+	RequestId      *string         `json:"request_id"`
+	IpAddress      *string         `json:"ip_address"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type AuditLogListResponse struct {
+	PageInfo  entity.PageInfo    `json:"page_info"`
+	AuditLogs []AuditLogResponse `json:"audit_logs"`
+}
+
+func ConvertToAuditLogListResponse(auditLogs []entity.AuditLog, pageInfo entity.PageInfo) AuditLogListResponse {
+	response := AuditLogListResponse{
+		PageInfo:  pageInfo,
+		AuditLogs: []AuditLogResponse{},
+	}
+
+	for _, auditLog := range auditLogs {
+		response.AuditLogs = append(response.AuditLogs, AuditLogResponse{
+			Id:             auditLog.Id,
+			ActorAccountId: auditLog.ActorAccountId,
+			ActorRole:      auditLog.ActorRole,
+			Action:         auditLog.Action,
+			EntityType:     auditLog.EntityType,
+			EntityId:       auditLog.EntityId,
+			Before:         auditLogData(auditLog.Before),
+			After:          auditLogData(auditLog.After),
+			RequestId:      auditLog.RequestId,
+			IpAddress:      auditLog.IpAddress,
+			CreatedAt:      auditLog.CreatedAt,
+		})
+	}
+
+	return response
+}
+
+func ConvertToAuditLogCsvRecords(auditLogs []entity.AuditLog) [][]string {
+	records := [][]string{
+		{"audit_log_id", "created_at", "actor_account_id", "actor_role", "action", "entity_type", "entity_id", "before", "after", "request_id", "ip_address"},
+	}
+
+	for _, auditLog := range auditLogs {
+		actorAccountId := ""
+		if auditLog.ActorAccountId != nil {
+			actorAccountId = strconv.FormatInt(*auditLog.ActorAccountId, 10)
+		}
+
+		records = append(records, []string{
+			strconv.FormatInt(auditLog.Id, 10),
+			auditLog.CreatedAt.Format(appconstant.ChatTimeFormat),
+			actorAccountId,
+			auditLogString(auditLog.ActorRole),
+			auditLog.Action,
+			auditLog.EntityType,
+			strconv.FormatInt(auditLog.EntityId, 10),
+			string(auditLog.Before),
+			string(auditLog.After),
+			auditLogString(auditLog.RequestId),
+			auditLogString(auditLog.IpAddress),
+		})
+	}
+
+	return records
+}
+
+func auditLogData(data []byte) json.RawMessage {
+	if data == nil {
+		return json.RawMessage("null")
+	}
+
+	return json.RawMessage(data)
+}
+
+func auditLogString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
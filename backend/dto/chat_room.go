@@ -12,6 +12,7 @@ type RoomPreviewResponse struct {
 	ParticipantPictureUrl string `json:"participant_picture_url"`
 	ExpiredAt             *int64 `json:"expired_at,omitempty"`
 	LastChat              Chat   `json:"last_chat"`
+	UnreadCount           int    `json:"unread_count"`
 }
 
 type RoomListResponse struct {
@@ -28,6 +29,7 @@ func ToRoomPreviewResponse(room entity.WsChatRoomPreview) RoomPreviewResponse {
 		ParticipantPictureUrl: room.ParticipantPictureUrl,
 		ExpiredAt:             room.ExpiredAt,
 		LastChat:              ConvertToChatDTO(room.LastChat),
+		UnreadCount:           room.UnreadCount,
 	}
 }
 
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"max-health/entity"
 
 	"github.com/shopspring/decimal"
@@ -20,6 +22,8 @@ type DoctorDto struct {
 	Experience         int             `json:"experience"`
 	Name               string          `json:"name" `
 	SpecializationName string          `json:"specialization"`
+	Rating             float64         `json:"rating"`
+	ReviewCount        int             `json:"review_count"`
 }
 
 type UpdateDoctorDataRequest struct {
@@ -42,6 +46,10 @@ type DoctorProfileResponse struct {
 	FeePerPatient      decimal.Decimal `json:"fee_per_patient"`
 	SpecializationId   int64           `json:"specialization_id"`
 	SpecializationName string          `json:"specialization_name"`
+	VerificationStatus string          `json:"verification_status,omitempty"`
+	RejectionReason    *string         `json:"rejection_reason,omitempty"`
+	Rating             float64         `json:"rating"`
+	ReviewCount        int             `json:"review_count"`
 }
 
 type UpdateDoctorStatusRequest struct {
@@ -81,3 +89,54 @@ func ConvertToDoctorSpecializationDTOList(doctorSpecializationList []entity.Doct
 
 	return specializationListDTO
 }
+
+type DoctorVerificationResponse struct {
+	DoctorId           int64      `json:"doctor_id"`
+	AccountId          int64      `json:"account_id"`
+	Name               string     `json:"name"`
+	Email              string     `json:"email"`
+	SpecializationName string     `json:"specialization_name"`
+	CertificateUrl     string     `json:"certificate_url"`
+	Status             string     `json:"status"`
+	RejectionReason    *string    `json:"rejection_reason,omitempty"`
+	ReviewedAt         *time.Time `json:"reviewed_at,omitempty"`
+	RegisteredAt       time.Time  `json:"registered_at"`
+}
+
+type DoctorVerificationListResponse struct {
+	PageInfo entity.PageInfo              `json:"page_info"`
+	Doctors  []DoctorVerificationResponse `json:"doctors"`
+}
+
+type UpdateDoctorVerificationRequest struct {
+	Status string `json:"status" binding:"required,oneof=approved rejected"`
+	Reason string `json:"reason" binding:"required_if=Status rejected"`
+}
+
+func ConvertToDoctorVerificationResponse(doctorVerification entity.DoctorVerification) DoctorVerificationResponse {
+	return DoctorVerificationResponse{
+		DoctorId:           doctorVerification.DoctorId,
+		AccountId:          doctorVerification.AccountId,
+		Name:               doctorVerification.Name,
+		Email:              doctorVerification.Email,
+		SpecializationName: doctorVerification.SpecializationName,
+		CertificateUrl:     doctorVerification.Certificate,
+		Status:             doctorVerification.Status,
+		RejectionReason:    doctorVerification.RejectionReason,
+		ReviewedAt:         doctorVerification.ReviewedAt,
+		RegisteredAt:       doctorVerification.RegisteredAt,
+	}
+}
+
+func ConvertToDoctorVerificationListResponse(doctorVerificationList []entity.DoctorVerification, pageInfo entity.PageInfo) DoctorVerificationListResponse {
+	response := DoctorVerificationListResponse{
+		PageInfo: pageInfo,
+		Doctors:  []DoctorVerificationResponse{},
+	}
+
+	for _, doctorVerification := range doctorVerificationList {
+		response.Doctors = append(response.Doctors, ConvertToDoctorVerificationResponse(doctorVerification))
+	}
+
+	return response
+}
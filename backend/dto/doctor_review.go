@@ -0,0 +1,93 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+)
+
+type CreateDoctorReviewRequest struct {
+	Rating int     `json:"rating" binding:"required,gte=1,lte=5"`
+	Review *string `json:"review" binding:"omitempty,max=1000"`
+}
+
+type UpdateDoctorReviewVisibilityRequest struct {
+	IsHidden *bool  `json:"is_hidden" binding:"required"`
+	Reason   string `json:"reason" binding:"required_if=IsHidden true"`
+}
+
+type DoctorReviewResponse struct {
+	Id                 int64     `json:"doctor_review_id"`
+	RoomId             int64     `json:"room_id"`
+	UserName           string    `json:"user_name"`
+	UserProfilePicture string    `json:"user_profile_picture"`
+	Rating             int       `json:"rating"`
+	Review             *string   `json:"review"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type AdminDoctorReviewResponse struct {
+	DoctorReviewResponse
+	UserAccountId   int64   `json:"user_account_id"`
+	DoctorAccountId int64   `json:"doctor_account_id"`
+	IsHidden        bool    `json:"is_hidden"`
+	HiddenReason    *string `json:"hidden_reason"`
+}
+
+type DoctorReviewListResponse struct {
+	PageInfo entity.PageInfo        `json:"page_info"`
+	Reviews  []DoctorReviewResponse `json:"reviews"`
+}
+
+type AdminDoctorReviewListResponse struct {
+	PageInfo entity.PageInfo             `json:"page_info"`
+	Reviews  []AdminDoctorReviewResponse `json:"reviews"`
+}
+
+func ConvertToDoctorReviewResponse(doctorReview entity.DoctorReview) DoctorReviewResponse {
+	return DoctorReviewResponse{
+		Id:                 doctorReview.Id,
+		RoomId:             doctorReview.WsChatRoomId,
+		UserName:           doctorReview.UserName,
+		UserProfilePicture: doctorReview.UserProfilePicture,
+		Rating:             doctorReview.Rating,
+		Review:             doctorReview.Review,
+		CreatedAt:          doctorReview.CreatedAt,
+	}
+}
+
+func ConvertToAdminDoctorReviewResponse(doctorReview entity.DoctorReview) AdminDoctorReviewResponse {
+	return AdminDoctorReviewResponse{
+		DoctorReviewResponse: ConvertToDoctorReviewResponse(doctorReview),
+		UserAccountId:        doctorReview.UserAccountId,
+		DoctorAccountId:      doctorReview.DoctorAccountId,
+		IsHidden:             doctorReview.IsHidden,
+		HiddenReason:         doctorReview.HiddenReason,
+	}
+}
+
+func ConvertToDoctorReviewListResponse(doctorReviewList []entity.DoctorReview, pageInfo entity.PageInfo) DoctorReviewListResponse {
+	response := DoctorReviewListResponse{
+		PageInfo: pageInfo,
+		Reviews:  []DoctorReviewResponse{},
+	}
+
+	for _, doctorReview := range doctorReviewList {
+		response.Reviews = append(response.Reviews, ConvertToDoctorReviewResponse(doctorReview))
+	}
+
+	return response
+}
+
+func ConvertToAdminDoctorReviewListResponse(doctorReviewList []entity.DoctorReview, pageInfo entity.PageInfo) AdminDoctorReviewListResponse {
+	response := AdminDoctorReviewListResponse{
+		PageInfo: pageInfo,
+		Reviews:  []AdminDoctorReviewResponse{},
+	}
+
+	for _, doctorReview := range doctorReviewList {
+		response.Reviews = append(response.Reviews, ConvertToAdminDoctorReviewResponse(doctorReview))
+	}
+
+	return response
+}
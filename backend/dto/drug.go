@@ -126,7 +126,7 @@ type PharmacyDrugsByPharmacyResponse struct {
 }
 
 type PrescriptionDrugItemRequest struct {
-	Id    int64  `json:"id"`
+	Id    int64  `json:"id" validate:"required,gte=1"`
 	Name  string `json:"name"`
 	Image string `json:"image"`
 }
@@ -264,3 +264,45 @@ func ConvertToMutationPharmacyDrugs(pharmacyDrugs []entity.PharmacyDrugDetail) [
 	}
 	return res
 }
+
+type DrugAuditData struct {
+	Name                   string          `json:"name"`
+	GenericName            string          `json:"generic_name"`
+	Content                string          `json:"content"`
+	Manufacture            string          `json:"manufacture"`
+	Description            string          `json:"description"`
+	ClassificationId       int64           `json:"classification_id"`
+	FormId                 int64           `json:"form_id"`
+	CategoryId             int64           `json:"category_id"`
+	UnitInPack             string          `json:"unit_in_pack"`
+	SellingUnit            string          `json:"selling_unit"`
+	Weight                 decimal.Decimal `json:"weight"`
+	Height                 decimal.Decimal `json:"height"`
+	Length                 decimal.Decimal `json:"length"`
+	Width                  decimal.Decimal `json:"width"`
+	Image                  string          `json:"image"`
+	IsActive               bool            `json:"is_active"`
+	IsPrescriptionRequired bool            `json:"is_prescription_required"`
+}
+
+func ConvertToDrugAuditData(drug entity.Drug) DrugAuditData {
+	return DrugAuditData{
+		Name:                   drug.Name,
+		GenericName:            drug.GenericName,
+		Content:                drug.Content,
+		Manufacture:            drug.Manufacture,
+		Description:            drug.Description,
+		ClassificationId:       drug.Classification.Id,
+		FormId:                 drug.Form.Id,
+		CategoryId:             drug.Category.Id,
+		UnitInPack:             drug.UnitInPack,
+		SellingUnit:            drug.SellingUnit,
+		Weight:                 drug.Weight,
+		Height:                 drug.Height,
+		Length:                 drug.Length,
+		Width:                  drug.Width,
+		Image:                  drug.Image,
+		IsActive:               drug.IsActive,
+		IsPrescriptionRequired: drug.IsPrescriptionRequired,
+	}
+}
@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+)
+
+type EmailOutboxResponse struct {
+	Id            int64      `json:"email_outbox_id"`
+	EmailType     string     `json:"email_type"`
+	Locale        string     `json:"locale"`
+	Recipient     string     `json:"recipient"`
+	Status        string     `json:"status"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     *string    `json:"last_error"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type EmailOutboxListResponse struct {
+	PageInfo entity.PageInfo       `json:"page_info"`
+	Emails   []EmailOutboxResponse `json:"emails"`
+}
+
+type EmailOutboxSendResult struct {
+	Claimed      int
+	Sent         int
+	Retrying     int
+	DeadLettered int
+}
+
+func ConvertToEmailOutboxResponse(emailOutbox entity.EmailOutbox) EmailOutboxResponse {
+	return EmailOutboxResponse{
+		Id:            emailOutbox.Id,
+		EmailType:     emailOutbox.EmailType,
+		Locale:        emailOutbox.Locale,
+		Recipient:     emailOutbox.Recipient,
+		Status:        emailOutbox.Status,
+		Attempts:      emailOutbox.Attempts,
+		NextAttemptAt: emailOutbox.NextAttemptAt,
+		LastError:     emailOutbox.LastError,
+		SentAt:        emailOutbox.SentAt,
+		CreatedAt:     emailOutbox.CreatedAt,
+	}
+}
+
+func ConvertToEmailOutboxListResponse(emails []entity.EmailOutbox, pageInfo entity.PageInfo) EmailOutboxListResponse {
+	response := EmailOutboxListResponse{
+		PageInfo: pageInfo,
+		Emails:   []EmailOutboxResponse{},
+	}
+
+	for _, email := range emails {
+		response.Emails = append(response.Emails, ConvertToEmailOutboxResponse(email))
+	}
+
+	return response
+}
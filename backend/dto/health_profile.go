@@ -0,0 +1,123 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type PatientMedicationRequest struct {
+	Name   string  `json:"name" binding:"required,max=255"`
+	Dosage *string `json:"dosage" binding:"omitempty,max=255"`
+}
+
+type UpdatePatientHealthProfileRequest struct {
+	Allergies         []string                   `json:"allergies" binding:"omitempty,max=50,dive,required,max=255"`
+	ChronicConditions []string                   `json:"chronic_conditions" binding:"omitempty,max=50,dive,required,max=255"`
+	Medications       []PatientMedicationRequest `json:"medications" binding:"omitempty,max=50,dive"`
+	HeightCm          *decimal.Decimal           `json:"height_cm"`
+	WeightKg          *decimal.Decimal           `json:"weight_kg"`
+	PregnancyStatus   string                     `json:"pregnancy_status" binding:"required,oneof=unknown not_pregnant pregnant breastfeeding"`
+}
+
+type PatientMedicationResponse struct {
+	Name   string  `json:"name"`
+	Dosage *string `json:"dosage"`
+}
+
+type PatientHealthProfileResponse struct {
+	UserAccountId     int64                       `json:"user_account_id"`
+	Allergies         []string                    `json:"allergies"`
+	ChronicConditions []string                    `json:"chronic_conditions"`
+	Medications       []PatientMedicationResponse `json:"medications"`
+	HeightCm          *decimal.Decimal            `json:"height_cm"`
+	WeightKg          *decimal.Decimal            `json:"weight_kg"`
+	PregnancyStatus   string                      `json:"pregnancy_status"`
+	UpdatedAt         *time.Time                  `json:"updated_at"`
+}
+
+type HealthProfileAccessLogResponse struct {
+	Id              int64     `json:"health_profile_access_log_id"`
+	DoctorAccountId int64     `json:"doctor_account_id"`
+	DoctorName      string    `json:"doctor_name"`
+	RoomId          int64     `json:"room_id"`
+	AccessSource    string    `json:"access_source"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type HealthProfileAccessLogListResponse struct {
+	PageInfo   entity.PageInfo                  `json:"page_info"`
+	AccessLogs []HealthProfileAccessLogResponse `json:"access_logs"`
+}
+
+func (r UpdatePatientHealthProfileRequest) ToPatientHealthProfile(userAccountId int64) entity.PatientHealthProfile {
+	profile := entity.PatientHealthProfile{
+		UserAccountId:     userAccountId,
+		Allergies:         r.Allergies,
+		ChronicConditions: r.ChronicConditions,
+		HeightCm:          r.HeightCm,
+		WeightKg:          r.WeightKg,
+		PregnancyStatus:   r.PregnancyStatus,
+	}
+
+	for _, medication := range r.Medications {
+		profile.Medications = append(profile.Medications, entity.PatientMedication{
+			Name:   medication.Name,
+			Dosage: medication.Dosage,
+		})
+	}
+
+	return profile
+}
+
+func ConvertToPatientHealthProfileResponse(profile entity.PatientHealthProfile) PatientHealthProfileResponse {
+	response := PatientHealthProfileResponse{
+		UserAccountId:     profile.UserAccountId,
+		Allergies:         profile.Allergies,
+		ChronicConditions: profile.ChronicConditions,
+		Medications:       []PatientMedicationResponse{},
+		HeightCm:          profile.HeightCm,
+		WeightKg:          profile.WeightKg,
+		PregnancyStatus:   profile.PregnancyStatus,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+
+	if response.Allergies == nil {
+		response.Allergies = []string{}
+	}
+
+	if response.ChronicConditions == nil {
+		response.ChronicConditions = []string{}
+	}
+
+	for _, medication := range profile.Medications {
+		response.Medications = append(response.Medications, PatientMedicationResponse{
+			Name:   medication.Name,
+			Dosage: medication.Dosage,
+		})
+	}
+
+	return response
+}
+
+func ConvertToHealthProfileAccessLogListResponse(accessLogList []entity.HealthProfileAccessLog, pageInfo entity.PageInfo) HealthProfileAccessLogListResponse {
+	response := HealthProfileAccessLogListResponse{
+		PageInfo:   pageInfo,
+		AccessLogs: []HealthProfileAccessLogResponse{},
+	}
+
+	for _, accessLog := range accessLogList {
+		response.AccessLogs = append(response.AccessLogs, HealthProfileAccessLogResponse{
+			Id:              accessLog.Id,
+			DoctorAccountId: accessLog.DoctorAccountId,
+			DoctorName:      accessLog.DoctorName,
+			RoomId:          accessLog.WsChatRoomId,
+			AccessSource:    accessLog.AccessSource,
+			CreatedAt:       accessLog.CreatedAt,
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,22 @@
+package dto
+
+import "time"
+
+type MaintenanceStatusResponse struct {
+	IsUnderMaintenance bool       `json:"is_under_maintenance"`
+	Message            string     `json:"message"`
+	Since              *time.Time `json:"since"`
+	IsDatabaseUp       bool       `json:"is_database_up"`
+	OpenConnections    int        `json:"open_connections"`
+	InUseConnections   int        `json:"in_use_connections"`
+}
+
+type UpdateMaintenanceModeRequest struct {
+	IsUnderMaintenance *bool  `json:"is_under_maintenance" binding:"required"`
+	Message            string `json:"message"`
+}
+
+type PurgeExpiredDataResponse struct {
+	RetentionHours int              `json:"retention_hours"`
+	Purged         map[string]int64 `json:"purged"`
+}
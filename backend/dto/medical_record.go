@@ -0,0 +1,183 @@
+package dto
+
+import (
+	"strings"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+type ConsultationDiagnosisRequest struct {
+	Code        string  `json:"code" binding:"required,ICD10"`
+	Description *string `json:"description" binding:"omitempty,max=255"`
+}
+
+type UpsertConsultationNoteRequest struct {
+	Complaint  string                         `json:"complaint" binding:"required,max=2000"`
+	Assessment string                         `json:"assessment" binding:"required,max=2000"`
+	Plan       string                         `json:"plan" binding:"required,max=2000"`
+	Diagnoses  []ConsultationDiagnosisRequest `json:"diagnoses" binding:"omitempty,max=20,dive"`
+}
+
+type ConsultationDiagnosisResponse struct {
+	Code        string  `json:"code"`
+	Description *string `json:"description"`
+}
+
+type ConsultationNoteResponse struct {
+	Id              int64                           `json:"consultation_note_id"`
+	RoomId          int64                           `json:"room_id"`
+	UserAccountId   int64                           `json:"user_account_id"`
+	DoctorAccountId int64                           `json:"doctor_account_id"`
+	DoctorName      string                          `json:"doctor_name"`
+	Complaint       string                          `json:"complaint"`
+	Assessment      string                          `json:"assessment"`
+	Plan            string                          `json:"plan"`
+	Diagnoses       []ConsultationDiagnosisResponse `json:"diagnoses"`
+	CreatedAt       time.Time                       `json:"created_at"`
+	UpdatedAt       time.Time                       `json:"updated_at"`
+}
+
+type MedicalHistoryEntryResponse struct {
+	Type             string                     `json:"type"`
+	OccurredAt       time.Time                  `json:"occurred_at"`
+	ConsultationNote *ConsultationNoteResponse  `json:"consultation_note,omitempty"`
+	Prescription     *MedicalRecordPrescription `json:"prescription,omitempty"`
+}
+
+type MedicalHistoryResponse struct {
+	PageInfo entity.PageInfo               `json:"page_info"`
+	Entries  []MedicalHistoryEntryResponse `json:"entries"`
+}
+
+type MedicalRecordPrescription struct {
+	PrescriptionResponse
+	RoomId *int64 `json:"room_id"`
+}
+
+type MedicalRecordPatient struct {
+	AccountId   int64      `json:"account_id"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Gender      *string    `json:"gender"`
+	DateOfBirth *time.Time `json:"date_of_birth"`
+}
+
+type MedicalRecordExport struct {
+	Format            string                       `json:"format"`
+	Version           int                          `json:"version"`
+	ExportedAt        time.Time                    `json:"exported_at"`
+	Patient           MedicalRecordPatient         `json:"patient"`
+	HealthProfile     PatientHealthProfileResponse `json:"health_profile"`
+	ConsultationNotes []ConsultationNoteResponse   `json:"consultation_notes"`
+	Prescriptions     []MedicalRecordPrescription  `json:"prescriptions"`
+}
+
+func (r UpsertConsultationNoteRequest) ToConsultationNote(room entity.WsChatRoom) entity.ConsultationNote {
+	consultationNote := entity.ConsultationNote{
+		WsChatRoomId:    room.Id,
+		UserAccountId:   room.UserAccountId,
+		DoctorAccountId: room.DoctorAccountId,
+		Complaint:       r.Complaint,
+		Assessment:      r.Assessment,
+		Plan:            r.Plan,
+	}
+
+	for _, diagnosis := range r.Diagnoses {
+		consultationNote.Diagnoses = append(consultationNote.Diagnoses, entity.ConsultationDiagnosis{
+			Code:        strings.ToUpper(strings.TrimSpace(diagnosis.Code)),
+			Description: diagnosis.Description,
+		})
+	}
+
+	return consultationNote
+}
+
+func ConvertToConsultationNoteResponse(consultationNote entity.ConsultationNote) ConsultationNoteResponse {
+	response := ConsultationNoteResponse{
+		Id:              consultationNote.Id,
+		RoomId:          consultationNote.WsChatRoomId,
+		UserAccountId:   consultationNote.UserAccountId,
+		DoctorAccountId: consultationNote.DoctorAccountId,
+		DoctorName:      consultationNote.DoctorName,
+		Complaint:       consultationNote.Complaint,
+		Assessment:      consultationNote.Assessment,
+		Plan:            consultationNote.Plan,
+		Diagnoses:       []ConsultationDiagnosisResponse{},
+		CreatedAt:       consultationNote.CreatedAt,
+		UpdatedAt:       consultationNote.UpdatedAt,
+	}
+
+	for _, diagnosis := range consultationNote.Diagnoses {
+		response.Diagnoses = append(response.Diagnoses, ConsultationDiagnosisResponse{
+			Code:        diagnosis.Code,
+			Description: diagnosis.Description,
+		})
+	}
+
+	return response
+}
+
+func ConvertToMedicalRecordPrescription(prescription entity.Prescription) MedicalRecordPrescription {
+	return MedicalRecordPrescription{
+		PrescriptionResponse: ConvertToPrescriptionResponse(prescription),
+		RoomId:               prescription.WsChatRoomId,
+	}
+}
+
+func ConvertToMedicalHistoryResponse(entryList []entity.MedicalHistoryEntry, pageInfo entity.PageInfo) MedicalHistoryResponse {
+	response := MedicalHistoryResponse{
+		PageInfo: pageInfo,
+		Entries:  []MedicalHistoryEntryResponse{},
+	}
+
+	for _, entry := range entryList {
+		entryResponse := MedicalHistoryEntryResponse{
+			Type:       entry.Type,
+			OccurredAt: entry.OccurredAt,
+		}
+
+		if entry.ConsultationNote != nil {
+			consultationNote := ConvertToConsultationNoteResponse(*entry.ConsultationNote)
+			entryResponse.ConsultationNote = &consultationNote
+		}
+
+		if entry.Prescription != nil {
+			prescription := ConvertToMedicalRecordPrescription(*entry.Prescription)
+			entryResponse.Prescription = &prescription
+		}
+
+		response.Entries = append(response.Entries, entryResponse)
+	}
+
+	return response
+}
+
+func ConvertToMedicalRecordExport(medicalRecord entity.MedicalRecord) MedicalRecordExport {
+	export := MedicalRecordExport{
+		Format:     appconstant.MedicalRecordExportFormat,
+		Version:    appconstant.MedicalRecordExportVersion,
+		ExportedAt: medicalRecord.ExportedAt,
+		Patient: MedicalRecordPatient{
+			AccountId:   medicalRecord.Account.Id,
+			Name:        medicalRecord.Account.Name,
+			Email:       medicalRecord.Account.Email,
+			Gender:      medicalRecord.User.GenderName,
+			DateOfBirth: medicalRecord.User.DateOfBirth,
+		},
+		HealthProfile:     ConvertToPatientHealthProfileResponse(medicalRecord.HealthProfile),
+		ConsultationNotes: []ConsultationNoteResponse{},
+		Prescriptions:     []MedicalRecordPrescription{},
+	}
+
+	for _, consultationNote := range medicalRecord.ConsultationNotes {
+		export.ConsultationNotes = append(export.ConsultationNotes, ConvertToConsultationNoteResponse(consultationNote))
+	}
+
+	for _, prescription := range medicalRecord.Prescriptions {
+		export.Prescriptions = append(export.Prescriptions, ConvertToMedicalRecordPrescription(prescription))
+	}
+
+	return export
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"max-health/entity"
+)
+
+type NotificationResponse struct {
+	Id        int64           `json:"notification_id"`
+	Type      string          `json:"type"`
+	Title     string          `json:"title"`
+	Message   string          `json:"message"`
+	Data      json.RawMessage `json:"data"`
+	IsRead    bool            `json:"is_read"`
+	ReadAt    *time.Time      `json:"read_at"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	PageInfo      entity.PageInfo        `json:"page_info"`
+	UnreadCount   int                    `json:"unread_count"`
+	Notifications []NotificationResponse `json:"notifications"`
+}
+
+type MarkNotificationsReadRequest struct {
+	NotificationIds []int64 `json:"notification_ids" binding:"required_without=All,omitempty,max=100,dive,gte=1"`
+	All             bool    `json:"all"`
+}
+
+type MarkNotificationsReadResponse struct {
+	UpdatedCount int64 `json:"updated_count"`
+	UnreadCount  int   `json:"unread_count"`
+}
+
+func ConvertToNotificationResponse(notification entity.Notification) NotificationResponse {
+	data := json.RawMessage(notification.Data)
+	if len(data) == 0 {
+		data = json.RawMessage("{}")
+	}
+
+	return NotificationResponse{
+		Id:        notification.Id,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Message:   notification.Message,
+		Data:      data,
+		IsRead:    notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
+
+func ConvertToNotificationListResponse(notifications []entity.Notification, pageInfo entity.PageInfo, unreadCount int) NotificationListResponse {
+	response := NotificationListResponse{
+		PageInfo:      pageInfo,
+		UnreadCount:   unreadCount,
+		Notifications: []NotificationResponse{},
+	}
+
+	for _, notification := range notifications {
+		response.Notifications = append(response.Notifications, ConvertToNotificationResponse(notification))
+	}
+
+	return response
+}
+
+type NotificationPushResult struct {
+	Claimed int
+	Pushed  int
+	Failed  int
+}
@@ -0,0 +1,19 @@
+package dto
+
+type OidcProvidersResponse struct {
+	Providers []string `json:"providers"`
+}
+
+type OidcAuthorizationResponse struct {
+	AuthorizationUrl string `json:"authorization_url"`
+}
+
+type OidcCallbackRequest struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+}
+
+type OidcLinkRequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
@@ -57,12 +57,13 @@ type OrderPharmacyResponse struct {
 }
 
 type OrderItemResponse struct {
-	Id        int64           `json:"id,omitempty"`
-	DrugName  string          `json:"drug_name"`
-	DrugPrice decimal.Decimal `json:"drug_price"`
-	DrugUnit  string          `json:"drug_unit"`
-	Quantity  int             `json:"quantity"`
-	DrugImage string          `json:"drug_image"`
+	Id             int64           `json:"id,omitempty"`
+	DrugName       string          `json:"drug_name"`
+	DrugPrice      decimal.Decimal `json:"drug_price"`
+	DrugUnit       string          `json:"drug_unit"`
+	Quantity       int             `json:"quantity"`
+	DrugImage      string          `json:"drug_image"`
+	PrescriptionId *int64          `json:"prescription_id,omitempty"`
 }
 
 func ConvertToOrderResponse(order entity.Order) OrderResponse {
@@ -105,12 +106,13 @@ func ConvertToOrderPharmacyResponse(orderPharmacy entity.OrderPharmacy, orderIte
 
 func ConvertToOrderItemResponse(orderItem entity.OrderItem) *OrderItemResponse {
 	return &OrderItemResponse{
-		Id:        orderItem.Id,
-		DrugName:  orderItem.DrugName,
-		DrugPrice: orderItem.DrugPrice,
-		DrugUnit:  orderItem.DrugUnit,
-		Quantity:  orderItem.Quantity,
-		DrugImage: orderItem.DrugImage,
+		Id:             orderItem.Id,
+		DrugName:       orderItem.DrugName,
+		DrugPrice:      orderItem.DrugPrice,
+		DrugUnit:       orderItem.DrugUnit,
+		Quantity:       orderItem.Quantity,
+		DrugImage:      orderItem.DrugImage,
+		PrescriptionId: orderItem.PrescriptionId,
 	}
 }
 
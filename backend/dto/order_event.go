@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+type OrderEventResponse struct {
+	Id              int64     `json:"event_id"`
+	Type            string    `json:"type"`
+	OrderId         int64     `json:"order_id"`
+	OrderPharmacyId int64     `json:"order_pharmacy_id"`
+	OrderStatusId   int64     `json:"order_status_id"`
+	OrderStatus     string    `json:"order_status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func ConvertToOrderEventResponse(event entity.OrderEvent) OrderEventResponse {
+	return OrderEventResponse{
+		Id:              event.Id,
+		Type:            event.Type,
+		OrderId:         event.OrderId,
+		OrderPharmacyId: event.OrderPharmacyId,
+		OrderStatusId:   event.OrderStatusId,
+		OrderStatus:     appconstant.OrderStatusNames[event.OrderStatusId],
+		CreatedAt:       event.CreatedAt,
+	}
+}
@@ -14,6 +14,8 @@ type PharmacyDrug struct {
 	Stock            int             `json:"stock"`
 	CartItemId       *int            `json:"cart_item_id,omitempty"`
 	CartItemQuantity *int            `json:"cart_item_quantity,omitempty"`
+	Rating           float64         `json:"rating"`
+	ReviewCount      int             `json:"review_count"`
 }
 
 type PharmacyRequest struct {
@@ -61,6 +63,8 @@ type Pharmacy struct {
 	Latitude                string  `json:"latitude,omitempty"`
 	Longitude               string  `json:"longitude,omitempty"`
 	Distance                float64 `json:"distance,omitempty"`
+	Rating                  float64 `json:"rating,omitempty"`
+	ReviewCount             int     `json:"review_count,omitempty"`
 }
 
 type UpdatePharmacyDrugReq struct {
@@ -114,12 +118,16 @@ func ConvertToPharmacyDrugDTO(pharmacyDrug entity.PharmacyDrug) PharmacyDrug {
 			PharmacistName:          pharmacyDrug.Pharmacy.PharmacistName,
 			PharmacistLicenseNumber: pharmacyDrug.Pharmacy.PharmacistLicenseNumber,
 			PharmacistPhoneNumber:   pharmacyDrug.Pharmacy.PharmacistPhoneNumber,
-			Distance:                pharmacyDrug.Pharmacy.Distance},
+			Distance:                pharmacyDrug.Pharmacy.Distance,
+			Rating:                  pharmacyDrug.Pharmacy.AverageRating,
+			ReviewCount:             pharmacyDrug.Pharmacy.ReviewCount},
 		DrugId:           pharmacyDrug.DrugId,
 		Price:            pharmacyDrug.Price,
 		Stock:            pharmacyDrug.Stock,
 		CartItemId:       pharmacyDrug.CartItemId,
 		CartItemQuantity: pharmacyDrug.CartItemQuantity,
+		Rating:           pharmacyDrug.AverageRating,
+		ReviewCount:      pharmacyDrug.ReviewCount,
 	}
 }
 
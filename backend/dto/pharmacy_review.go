@@ -0,0 +1,148 @@
+package dto
+
+import (
+	"time"
+
+	"max-health/entity"
+)
+
+type PharmacyReviewRequest struct {
+	Rating int     `json:"rating" binding:"required,gte=1,lte=5"`
+	Review *string `json:"review" binding:"omitempty,max=1000"`
+}
+
+type ReviewReplyRequest struct {
+	Reply string `json:"reply" binding:"required,max=1000"`
+}
+
+type PharmacyReviewResponse struct {
+	Id                 int64      `json:"pharmacy_review_id"`
+	OrderPharmacyId    int64      `json:"order_pharmacy_id"`
+	PharmacyId         int64      `json:"pharmacy_id"`
+	UserName           string     `json:"user_name"`
+	UserProfilePicture string     `json:"user_profile_picture"`
+	Rating             int        `json:"rating"`
+	Review             *string    `json:"review"`
+	ManagerReply       *string    `json:"manager_reply"`
+	RepliedAt          *time.Time `json:"replied_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type OrderItemReviewResponse struct {
+	Id                 int64      `json:"order_item_review_id"`
+	OrderItemId        int64      `json:"order_item_id"`
+	PharmacyId         int64      `json:"pharmacy_id"`
+	PharmacyName       string     `json:"pharmacy_name"`
+	PharmacyDrugId     int64      `json:"pharmacy_drug_id"`
+	DrugId             int64      `json:"drug_id"`
+	UserName           string     `json:"user_name"`
+	UserProfilePicture string     `json:"user_profile_picture"`
+	Rating             int        `json:"rating"`
+	Review             *string    `json:"review"`
+	ManagerReply       *string    `json:"manager_reply"`
+	RepliedAt          *time.Time `json:"replied_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type PharmacyReviewListResponse struct {
+	PageInfo entity.PageInfo          `json:"page_info"`
+	Reviews  []PharmacyReviewResponse `json:"reviews"`
+}
+
+type OrderItemReviewListResponse struct {
+	PageInfo entity.PageInfo           `json:"page_info"`
+	Reviews  []OrderItemReviewResponse `json:"reviews"`
+}
+
+type PharmacyRatingReportResponse struct {
+	Period                 string  `json:"period"`
+	PharmacyReviewCount    int     `json:"pharmacy_review_count"`
+	PharmacyAverageRating  float64 `json:"pharmacy_average_rating"`
+	OrderItemReviewCount   int     `json:"order_item_review_count"`
+	OrderItemAverageRating float64 `json:"order_item_average_rating"`
+}
+
+type AllPharmacyRatingReportResponse struct {
+	Ratings []PharmacyRatingReportResponse `json:"ratings"`
+}
+
+func ConvertToPharmacyReviewResponse(pharmacyReview entity.PharmacyReview) PharmacyReviewResponse {
+	return PharmacyReviewResponse{
+		Id:                 pharmacyReview.Id,
+		OrderPharmacyId:    pharmacyReview.OrderPharmacyId,
+		PharmacyId:         pharmacyReview.PharmacyId,
+		UserName:           pharmacyReview.UserName,
+		UserProfilePicture: pharmacyReview.UserProfilePicture,
+		Rating:             pharmacyReview.Rating,
+		Review:             pharmacyReview.Review,
+		ManagerReply:       pharmacyReview.ManagerReply,
+		RepliedAt:          pharmacyReview.RepliedAt,
+		CreatedAt:          pharmacyReview.CreatedAt,
+		UpdatedAt:          pharmacyReview.UpdatedAt,
+	}
+}
+
+func ConvertToOrderItemReviewResponse(orderItemReview entity.OrderItemReview) OrderItemReviewResponse {
+	return OrderItemReviewResponse{
+		Id:                 orderItemReview.Id,
+		OrderItemId:        orderItemReview.OrderItemId,
+		PharmacyId:         orderItemReview.PharmacyId,
+		PharmacyName:       orderItemReview.PharmacyName,
+		PharmacyDrugId:     orderItemReview.PharmacyDrugId,
+		DrugId:             orderItemReview.DrugId,
+		UserName:           orderItemReview.UserName,
+		UserProfilePicture: orderItemReview.UserProfilePicture,
+		Rating:             orderItemReview.Rating,
+		Review:             orderItemReview.Review,
+		ManagerReply:       orderItemReview.ManagerReply,
+		RepliedAt:          orderItemReview.RepliedAt,
+		CreatedAt:          orderItemReview.CreatedAt,
+		UpdatedAt:          orderItemReview.UpdatedAt,
+	}
+}
+
+func ConvertToPharmacyReviewListResponse(pharmacyReviewList []entity.PharmacyReview, pageInfo entity.PageInfo) PharmacyReviewListResponse {
+	response := PharmacyReviewListResponse{
+		PageInfo: pageInfo,
+		Reviews:  []PharmacyReviewResponse{},
+	}
+
+	for _, pharmacyReview := range pharmacyReviewList {
+		response.Reviews = append(response.Reviews, ConvertToPharmacyReviewResponse(pharmacyReview))
+	}
+
+	return response
+}
+
+func ConvertToOrderItemReviewListResponse(orderItemReviewList []entity.OrderItemReview, pageInfo entity.PageInfo) OrderItemReviewListResponse {
+	response := OrderItemReviewListResponse{
+		PageInfo: pageInfo,
+		Reviews:  []OrderItemReviewResponse{},
+	}
+
+	for _, orderItemReview := range orderItemReviewList {
+		response.Reviews = append(response.Reviews, ConvertToOrderItemReviewResponse(orderItemReview))
+	}
+
+	return response
+}
+
+func ConvertToAllPharmacyRatingReportResponse(pharmacyRatingReport []entity.PharmacyRatingReport) *AllPharmacyRatingReportResponse {
+	response := AllPharmacyRatingReportResponse{
+		Ratings: []PharmacyRatingReportResponse{},
+	}
+
+	for _, report := range pharmacyRatingReport {
+		response.Ratings = append(response.Ratings, PharmacyRatingReportResponse{
+			Period:                 report.Period.Format("2006-01"),
+			PharmacyReviewCount:    report.PharmacyReviewCount,
+			PharmacyAverageRating:  report.PharmacyAverageRating,
+			OrderItemReviewCount:   report.OrderItemReviewCount,
+			OrderItemAverageRating: report.OrderItemAverageRating,
+		})
+	}
+
+	return &response
+}
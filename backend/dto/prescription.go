@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"strings"
 	"time"
 
 	"max-health/entity"
@@ -14,12 +15,14 @@ type PrescriptionDrugRequest struct {
 }
 
 type PrescriptionDrugResponse struct {
-	Id         int64        `json:"id"`
-	Drug       DrugResponse `json:"drug"`
-	Quantity   int          `json:"quantity"`
-	Note       string       `json:"note"`
-	RedeemedAt *string      `json:"redeemed_at,omitempty"`
-	OrderedAt  *string      `json:"ordered_at,omitempty"`
+	Id                int64        `json:"id"`
+	Drug              DrugResponse `json:"drug"`
+	Quantity          int          `json:"quantity"`
+	DispensedQuantity int          `json:"dispensed_quantity"`
+	RemainingQuantity int          `json:"remaining_quantity"`
+	Note              string       `json:"note"`
+	RedeemedAt        *string      `json:"redeemed_at,omitempty"`
+	OrderedAt         *string      `json:"ordered_at,omitempty"`
 }
 
 type PrescriptionResponse struct {
@@ -30,6 +33,9 @@ type PrescriptionResponse struct {
 	DoctorName        string                     `json:"doctor_name"`
 	RedeemedAt        *time.Time                 `json:"redeemed_at"`
 	OrderedAt         *time.Time                 `json:"ordered_at"`
+	ExpiredAt         *time.Time                 `json:"expired_at"`
+	RefillCount       int                        `json:"refill_count"`
+	IsExpired         bool                       `json:"is_expired"`
 	CreatedAt         *time.Time                 `json:"created_at"`
 	PrescriptionDrugs []PrescriptionDrugResponse `json:"prescription_drugs"`
 }
@@ -42,22 +48,24 @@ type PrescriptionResponseList struct {
 	} `json:"page_info"`
 }
 
-func ConvertToPrescriptionDrugListResponse(prescriptionDrugList []entity.PrescriptionDrug) []PrescriptionDrugResponse {
+func ConvertToPrescriptionDrugListResponse(prescription entity.Prescription) []PrescriptionDrugResponse {
 	var list []PrescriptionDrugResponse
 
-	for _, prescriptionDrug := range prescriptionDrugList {
-		list = append(list, ConvertToPrescriptionDrugResponse(prescriptionDrug))
+	for _, prescriptionDrug := range prescription.PrescriptionDrugs {
+		list = append(list, ConvertToPrescriptionDrugResponse(prescription, prescriptionDrug))
 	}
 
 	return list
 }
 
-func ConvertToPrescriptionDrugResponse(prescriptionDrug entity.PrescriptionDrug) PrescriptionDrugResponse {
+func ConvertToPrescriptionDrugResponse(prescription entity.Prescription, prescriptionDrug entity.PrescriptionDrug) PrescriptionDrugResponse {
 	return PrescriptionDrugResponse{
-		Id:       prescriptionDrug.Id,
-		Drug:     ConvertToDrugResponse(prescriptionDrug.Drug),
-		Quantity: prescriptionDrug.Quantity,
-		Note:     prescriptionDrug.Note,
+		Id:                prescriptionDrug.Id,
+		Drug:              ConvertToDrugResponse(prescriptionDrug.Drug),
+		Quantity:          prescriptionDrug.Quantity,
+		DispensedQuantity: prescriptionDrug.DispensedQuantity,
+		RemainingQuantity: prescription.RemainingQuantity(prescriptionDrug),
+		Note:              prescriptionDrug.Note,
 	}
 }
 
@@ -70,8 +78,11 @@ func ConvertToPrescriptionResponse(prescription entity.Prescription) Prescriptio
 		DoctorName:        prescription.DoctorName,
 		RedeemedAt:        prescription.RedeemedAt,
 		OrderedAt:         prescription.OrderedAt,
+		ExpiredAt:         prescription.ExpiredAt,
+		RefillCount:       prescription.RefillCount,
+		IsExpired:         prescription.IsExpired(),
 		CreatedAt:         prescription.CreatedAt,
-		PrescriptionDrugs: ConvertToPrescriptionDrugListResponse(prescription.PrescriptionDrugs),
+		PrescriptionDrugs: ConvertToPrescriptionDrugListResponse(prescription),
 	}
 }
 
@@ -90,3 +101,66 @@ func ConvertToPrescriptionResponseList(prescriptionList []entity.Prescription, t
 		}{TotalPage: totalPage, TotalItem: totalItem},
 	}
 }
+
+type PrescriptionVerificationResponse struct {
+	IsValid              bool       `json:"is_valid"`
+	IssuedAt             *time.Time `json:"issued_at,omitempty"`
+	DoctorName           string     `json:"doctor_name,omitempty"`
+	DoctorSpecialization string     `json:"doctor_specialization,omitempty"`
+	PatientName          string     `json:"patient_name,omitempty"`
+}
+
+func ConvertToPrescriptionVerificationResponse(verification entity.PrescriptionVerification) PrescriptionVerificationResponse {
+	if !verification.IsValid {
+		return PrescriptionVerificationResponse{}
+	}
+
+	document := verification.Document
+
+	return PrescriptionVerificationResponse{
+		IsValid:              true,
+		IssuedAt:             &document.IssuedAt,
+		DoctorName:           document.DoctorName,
+		DoctorSpecialization: document.DoctorSpecialization,
+		PatientName:          maskName(document.PatientName),
+	}
+}
+
+func maskName(name string) string {
+	words := strings.Fields(name)
+	for i, word := range words {
+		runes := []rune(word)
+		words[i] = string(runes[0]) + strings.Repeat("*", len(runes)-1)
+	}
+
+	return strings.Join(words, " ")
+}
+
+type PrescriptionIssueResponse struct {
+	Code          string `json:"code"`
+	DrugId        int64  `json:"drug_id"`
+	RelatedDrugId *int64 `json:"related_drug_id,omitempty"`
+	Message       string `json:"message"`
+}
+
+type PrescriptionValidationResponse struct {
+	Errors   []PrescriptionIssueResponse `json:"errors"`
+	Warnings []PrescriptionIssueResponse `json:"warnings"`
+}
+
+func ToPrescriptionIssueListResponse(issueList []entity.PrescriptionIssue) []PrescriptionIssueResponse {
+	response := []PrescriptionIssueResponse{}
+
+	for _, issue := range issueList {
+		response = append(response, PrescriptionIssueResponse(issue))
+	}
+
+	return response
+}
+
+func ToPrescriptionValidationResponse(validation entity.PrescriptionValidation) PrescriptionValidationResponse {
+	return PrescriptionValidationResponse{
+		Errors:   ToPrescriptionIssueListResponse(validation.Errors),
+		Warnings: ToPrescriptionIssueListResponse(validation.Warnings),
+	}
+}
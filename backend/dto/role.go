@@ -0,0 +1,76 @@
+package dto
+
+import "max-health/entity"
+
+type RoleResponse struct {
+	Id                  int64    `json:"id"`
+	Name                string   `json:"name"`
+	IsOperator          bool     `json:"is_operator"`
+	IsTwoFactorRequired bool     `json:"is_two_factor_required"`
+	Permissions         []string `json:"permissions"`
+}
+
+type PermissionResponse struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type UpdateRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+type UpdateAccountRoleRequest struct {
+	RoleId int64 `json:"role_id" binding:"required"`
+}
+
+type CreateOperatorRequest struct {
+	Email  string `json:"email" binding:"required,email"`
+	Name   string `json:"name" binding:"required"`
+	RoleId int64  `json:"role_id" binding:"required"`
+}
+
+func ConvertToRoleResponse(role entity.Role) RoleResponse {
+	permissions := role.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return RoleResponse{
+		Id:                  role.Id,
+		Name:                role.Name,
+		IsOperator:          role.IsOperator,
+		IsTwoFactorRequired: role.IsTwoFactorRequired,
+		Permissions:         permissions,
+	}
+}
+
+func ConvertToRoleResponses(roles []entity.Role) []RoleResponse {
+	responses := []RoleResponse{}
+	for _, role := range roles {
+		responses = append(responses, ConvertToRoleResponse(role))
+	}
+
+	return responses
+}
+
+func ConvertToPermissionResponses(permissions []entity.Permission) []PermissionResponse {
+	responses := []PermissionResponse{}
+	for _, permission := range permissions {
+		responses = append(responses, PermissionResponse{
+			Id:          permission.Id,
+			Name:        permission.Name,
+			Description: permission.Description,
+		})
+	}
+
+	return responses
+}
+
+func CreateOperatorRequestToAccount(request CreateOperatorRequest) entity.Account {
+	return entity.Account{
+		Email:  request.Email,
+		Name:   request.Name,
+		RoleId: request.RoleId,
+	}
+}
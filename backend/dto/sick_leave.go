@@ -0,0 +1,118 @@
+package dto
+
+import (
+	"fmt"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+type IssueSickLeaveRequest struct {
+	StartDate        string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	Days             int    `json:"days" binding:"required,gte=1,lte=30"`
+	DiagnosisSummary string `json:"diagnosis_summary" binding:"required,max=1000"`
+}
+
+type SickLeaveCertificateResponse struct {
+	Id               int64     `json:"sick_leave_certificate_id"`
+	RoomId           int64     `json:"room_id"`
+	UserAccountId    int64     `json:"user_account_id,omitempty"`
+	PatientName      string    `json:"patient_name,omitempty"`
+	DoctorAccountId  int64     `json:"doctor_account_id,omitempty"`
+	DoctorName       string    `json:"doctor_name,omitempty"`
+	StartDate        string    `json:"start_date"`
+	EndDate          string    `json:"end_date"`
+	Days             int       `json:"days"`
+	DiagnosisSummary string    `json:"diagnosis_summary"`
+	VerificationCode string    `json:"verification_code"`
+	DocumentUrl      string    `json:"document_url"`
+	IssuedAt         time.Time `json:"issued_at"`
+}
+
+type SickLeaveVerificationResponse struct {
+	IsValid              bool      `json:"is_valid"`
+	Id                   int64     `json:"sick_leave_certificate_id"`
+	VerificationCode     string    `json:"verification_code"`
+	PatientName          string    `json:"patient_name"`
+	DoctorName           string    `json:"doctor_name"`
+	DoctorSpecialization string    `json:"doctor_specialization"`
+	DoctorCertificate    string    `json:"doctor_certificate"`
+	StartDate            string    `json:"start_date"`
+	EndDate              string    `json:"end_date"`
+	Days                 int       `json:"days"`
+	IssuedAt             time.Time `json:"issued_at"`
+}
+
+type UserDocumentResponse struct {
+	Type        string    `json:"type"`
+	Id          int64     `json:"id"`
+	DoctorName  string    `json:"doctor_name"`
+	IssuedAt    time.Time `json:"issued_at"`
+	DocumentUrl string    `json:"document_url"`
+}
+
+type UserDocumentListResponse struct {
+	PageInfo  entity.PageInfo        `json:"page_info"`
+	Documents []UserDocumentResponse `json:"documents"`
+}
+
+func ConvertToSickLeaveCertificateResponse(certificate entity.SickLeaveCertificate) SickLeaveCertificateResponse {
+	return SickLeaveCertificateResponse{
+		Id:               certificate.Id,
+		RoomId:           certificate.WsChatRoomId,
+		UserAccountId:    certificate.UserAccountId,
+		PatientName:      certificate.PatientName,
+		DoctorAccountId:  certificate.DoctorAccountId,
+		DoctorName:       certificate.DoctorName,
+		StartDate:        certificate.StartDate.Format(appconstant.SickLeaveDateFormat),
+		EndDate:          certificate.EndDate().Format(appconstant.SickLeaveDateFormat),
+		Days:             certificate.Days,
+		DiagnosisSummary: certificate.DiagnosisSummary,
+		VerificationCode: certificate.VerificationCode,
+		DocumentUrl:      fmt.Sprintf(appconstant.SickLeaveDocumentPath, certificate.Id),
+		IssuedAt:         certificate.IssuedAt,
+	}
+}
+
+func ConvertToSickLeaveVerificationResponse(verification entity.SickLeaveVerification) SickLeaveVerificationResponse {
+	certificate := verification.Certificate
+
+	return SickLeaveVerificationResponse{
+		IsValid:              verification.IsValid,
+		Id:                   certificate.Id,
+		VerificationCode:     certificate.VerificationCode,
+		PatientName:          certificate.PatientName,
+		DoctorName:           certificate.DoctorName,
+		DoctorSpecialization: certificate.DoctorSpecialization,
+		DoctorCertificate:    certificate.DoctorCertificate,
+		StartDate:            certificate.StartDate.Format(appconstant.SickLeaveDateFormat),
+		EndDate:              certificate.EndDate().Format(appconstant.SickLeaveDateFormat),
+		Days:                 certificate.Days,
+		IssuedAt:             certificate.IssuedAt,
+	}
+}
+
+func ConvertToUserDocumentListResponse(documentList []entity.UserDocument, pageInfo entity.PageInfo) UserDocumentListResponse {
+	response := UserDocumentListResponse{
+		PageInfo:  pageInfo,
+		Documents: []UserDocumentResponse{},
+	}
+
+	for _, document := range documentList {
+		documentPath := appconstant.PrescriptionDocumentPath
+		if document.Type == appconstant.UserDocumentSickLeaveCertificate {
+			documentPath = appconstant.SickLeaveDocumentPath
+		}
+
+		response.Documents = append(response.Documents, UserDocumentResponse{
+			Type:        document.Type,
+			Id:          document.Id,
+			DoctorName:  document.DoctorName,
+			IssuedAt:    document.IssuedAt,
+			DocumentUrl: fmt.Sprintf(documentPath, document.Id),
+		})
+	}
+
+	return response
+}
@@ -3,6 +3,7 @@ package dto
 import (
 	"time"
 
+	"max-health/appconstant"
 	"max-health/entity"
 
 	"github.com/shopspring/decimal"
@@ -27,13 +28,15 @@ type UserCreateRoomResponse struct {
 }
 
 type Chat struct {
-	Id              int64                `json:"id"`
-	RoomId          int64                `json:"room_id,omitempty"`
-	SenderAccountId int64                `json:"sender_account_id,omitempty"`
-	Message         *string              `json:"message"`
-	Attachment      Attachment           `json:"attachment,omitempty"`
-	Prescription    PrescriptionResponse `json:"prescription"`
-	CreatedAt       *string              `json:"created_at"`
+	Id              int64                         `json:"id"`
+	RoomId          int64                         `json:"room_id,omitempty"`
+	SenderAccountId int64                         `json:"sender_account_id,omitempty"`
+	Type            string                        `json:"type"`
+	Message         *string                       `json:"message"`
+	Attachment      Attachment                    `json:"attachment,omitempty"`
+	Prescription    PrescriptionResponse          `json:"prescription"`
+	SickLeave       *SickLeaveCertificateResponse `json:"sick_leave_certificate,omitempty"`
+	CreatedAt       *string                       `json:"created_at"`
 }
 
 type Attachment struct {
@@ -86,15 +89,28 @@ type DrugQuantity struct {
 }
 
 func ConvertToChatDTO(chat entity.Chat) Chat {
-	return Chat{
+	chatDTO := Chat{
 		Id:              chat.Id,
 		RoomId:          chat.RoomId,
 		SenderAccountId: chat.SenderAccountId,
+		Type:            appconstant.ChatTypeMessage,
 		Message:         chat.Message,
 		Attachment:      (Attachment)(chat.Attachment),
 		Prescription:    ConvertToPrescriptionResponse(chat.Prescription),
 		CreatedAt:       chat.CreatedAt,
 	}
+
+	if chat.Prescription.Id != nil {
+		chatDTO.Type = appconstant.ChatTypePrescription
+	}
+
+	if chat.SickLeave != nil {
+		sickLeave := ConvertToSickLeaveCertificateResponse(*chat.SickLeave)
+		chatDTO.Type = appconstant.ChatTypeSickLeaveCertificate
+		chatDTO.SickLeave = &sickLeave
+	}
+
+	return chatDTO
 }
 
 func ConvertToChatListDTO(chatList []entity.Chat) []Chat {
@@ -205,17 +221,24 @@ func ConvertPrepareForCheckoutToResponse(checkoutItemList []entity.PrepareForChe
 }
 
 type WsChatRoomRes struct {
-	Id                   int64  `json:"room_id"`
-	Hash                 string `json:"room_hash"`
-	DoctorAccountId      int64  `json:"doctor_account_id"`
-	UserAccountId        int64  `json:"user_account_id"`
-	DoctorCertificateUrl string `json:"doctor_certificate_url"`
-	ExpiredAt            *int64 `json:"expired_at"`
-	Chats                []Chat `json:"chats"`
+	Id                   int64                         `json:"room_id"`
+	Hash                 string                        `json:"room_hash"`
+	DoctorAccountId      int64                         `json:"doctor_account_id"`
+	UserAccountId        int64                         `json:"user_account_id"`
+	DoctorCertificateUrl string                        `json:"doctor_certificate_url"`
+	ExpiredAt            *int64                        `json:"expired_at"`
+	Chats                []Chat                        `json:"chats"`
+	NextChatCursor       *int64                        `json:"next_chat_cursor,omitempty"`
+	PatientHealthProfile *PatientHealthProfileResponse `json:"patient_health_profile,omitempty"`
+}
+
+type ChatHistoryRes struct {
+	Chats      []Chat `json:"chats"`
+	NextCursor *int64 `json:"next_cursor"`
 }
 
 func ToWsChatRoomRes(wsChatRoom entity.WsChatRoom) WsChatRoomRes {
-	return WsChatRoomRes{
+	res := WsChatRoomRes{
 		Id:                   wsChatRoom.Id,
 		Hash:                 wsChatRoom.Hash,
 		DoctorAccountId:      wsChatRoom.DoctorAccountId,
@@ -223,6 +246,26 @@ func ToWsChatRoomRes(wsChatRoom entity.WsChatRoom) WsChatRoomRes {
 		DoctorCertificateUrl: wsChatRoom.DoctorCertificateUrl,
 		ExpiredAt:            wsChatRoom.ExpiredAt,
 		Chats:                ConvertToChatListDTO(wsChatRoom.Chats),
+		NextChatCursor:       wsChatRoom.NextChatCursor,
+	}
+
+	if wsChatRoom.PatientHealthProfile != nil {
+		profile := ConvertToPatientHealthProfileResponse(*wsChatRoom.PatientHealthProfile)
+		res.PatientHealthProfile = &profile
+	}
+
+	return res
+}
+
+func ToChatHistoryRes(chatHistory entity.ChatHistory) ChatHistoryRes {
+	chats := ConvertToChatListDTO(chatHistory.Chats)
+	if chats == nil {
+		chats = []Chat{}
+	}
+
+	return ChatHistoryRes{
+		Chats:      chats,
+		NextCursor: chatHistory.NextCursor,
 	}
 }
 
@@ -0,0 +1,78 @@
+package dto
+
+import "max-health/entity"
+
+type LoginResponse struct {
+	AccessToken       string `json:"access_token,omitempty"`
+	RefreshToken      string `json:"refresh_token,omitempty"`
+	TwoFactorRequired bool   `json:"two_factor_required"`
+	ChallengeToken    string `json:"challenge_token,omitempty"`
+}
+
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type TwoFactorStatusResponse struct {
+	IsEnabled              bool `json:"is_enabled"`
+	IsRequired             bool `json:"is_required"`
+	RemainingRecoveryCodes int  `json:"remaining_recovery_codes"`
+}
+
+type TwoFactorEnrolmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningUri string `json:"provisioning_uri"`
+	QrCode          string `json:"qr_code"`
+}
+
+type TwoFactorActivationResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+	AccessToken   string   `json:"access_token"`
+	RefreshToken  string   `json:"refresh_token"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func ConvertLoginResultToResponse(loginResult entity.LoginResult) LoginResponse {
+	response := LoginResponse{
+		TwoFactorRequired: loginResult.TwoFactorRequired,
+		ChallengeToken:    loginResult.ChallengeToken,
+	}
+	if loginResult.Tokens != nil {
+		response.AccessToken = loginResult.Tokens.AccessToken
+		response.RefreshToken = loginResult.Tokens.RefreshToken
+	}
+
+	return response
+}
+
+func ConvertToTwoFactorStatusResponse(status entity.TwoFactorStatus) TwoFactorStatusResponse {
+	return TwoFactorStatusResponse{
+		IsEnabled:              status.IsEnabled,
+		IsRequired:             status.IsRequired,
+		RemainingRecoveryCodes: status.RemainingRecoveryCodes,
+	}
+}
+
+func ConvertToTwoFactorEnrolmentResponse(enrolment entity.TwoFactorEnrolment) TwoFactorEnrolmentResponse {
+	return TwoFactorEnrolmentResponse{
+		Secret:          enrolment.Secret,
+		ProvisioningUri: enrolment.ProvisioningUri,
+		QrCode:          enrolment.QrCode,
+	}
+}
+
+func ConvertToTwoFactorActivationResponse(activation entity.TwoFactorActivation) TwoFactorActivationResponse {
+	return TwoFactorActivationResponse{
+		RecoveryCodes: activation.RecoveryCodes,
+		AccessToken:   activation.Tokens.AccessToken,
+		RefreshToken:  activation.Tokens.RefreshToken,
+	}
+}
@@ -79,7 +79,8 @@ type UpdateUserAddressRequest struct {
 }
 
 type CreateOneCartRequest struct {
-	PharmacyDrugId int64 `json:"pharmacy_drug_id" binding:"required"`
+	PharmacyDrugId int64  `json:"pharmacy_drug_id" binding:"required"`
+	PrescriptionId *int64 `json:"prescription_id" binding:"omitempty,gte=1"`
 }
 
 type UpdateQtyCartRequest struct {
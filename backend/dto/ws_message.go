@@ -11,14 +11,53 @@ type AuthWsData struct {
 	Channel      string `json:"channel" validate:"required,min=1"`
 	ChannelToken string `json:"channel_token" validate:"required,min=1"`
 	ClientToken  string `json:"client_token" validate:"required,min=1"`
+	LastChatId   *int64 `json:"last_chat_id" validate:"omitempty,gte=1"`
 }
 
 type WsChatData struct {
-	Channel           string                    `json:"channel" validate:"required,min=1"`
-	Side              int                       `json:"side" validate:"required,gte=1"`
-	Message           string                    `json:"message" validate:"required,min=1"`
-	Attachment        Attachment                `json:"attachment"`
-	PrescriptionDrugs []PrescriptionDrugRequest `json:"prescription_drugs"`
+	Channel             string                    `json:"channel" validate:"required,min=1"`
+	Message             string                    `json:"message" validate:"required,min=1"`
+	Attachment          Attachment                `json:"attachment"`
+	PrescriptionDrugs   []PrescriptionDrugRequest `json:"prescription_drugs" validate:"omitempty,min=1,dive"`
+	AcknowledgeWarnings bool                      `json:"acknowledge_warnings"`
+	ValidDays           int                       `json:"valid_days" validate:"omitempty,gte=1,lte=365"`
+	Refills             int                       `json:"refills" validate:"omitempty,gte=0,lte=12"`
+	SickLeaveId         *int64                    `json:"sick_leave_certificate_id" validate:"omitempty,gte=1"`
+}
+
+type WsReplayData struct {
+	Channel    string `json:"channel" validate:"required,min=1"`
+	LastChatId int64  `json:"last_chat_id" validate:"gte=0"`
+}
+
+type WsTypingData struct {
+	Channel  string `json:"channel" validate:"required,min=1"`
+	IsTyping bool   `json:"is_typing"`
+}
+
+type WsReceiptData struct {
+	Channel string `json:"channel" validate:"required,min=1"`
+	ChatId  int64  `json:"chat_id" validate:"required,gte=1"`
+}
+
+type WsTypingEvent struct {
+	AccountId int64 `json:"account_id"`
+	IsTyping  bool  `json:"is_typing"`
+}
+
+type WsReceiptEvent struct {
+	AccountId int64 `json:"account_id"`
+	ChatId    int64 `json:"chat_id"`
+}
+
+type WsReplayEvent struct {
+	LastChatId int64 `json:"last_chat_id"`
+	HasMore    bool  `json:"has_more"`
+}
+
+type WsErrorEvent struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 }
 
 func AuthWsDataToEntity(dto AuthWsData) entity.WsToken {
@@ -28,13 +67,23 @@ func AuthWsDataToEntity(dto AuthWsData) entity.WsToken {
 			ChannelToken: dto.ChannelToken,
 			ClientToken:  dto.ClientToken,
 		},
+		LastChatId: dto.LastChatId,
 	}
 }
 
-func ToChatEntity(dto WsChatData) (string, int, entity.Chat) {
-	return dto.Channel, dto.Side, entity.Chat{
+func ToChatEntity(dto WsChatData) (string, entity.Chat) {
+	prescription := ConvertToPrescriptionEntity(dto.PrescriptionDrugs)
+	prescription.RefillCount = dto.Refills
+
+	chat := entity.Chat{
 		Message:      &dto.Message,
 		Attachment:   ToAttachmentEntity(dto.Attachment),
-		Prescription: ConvertToPrescriptionEntity(dto.PrescriptionDrugs),
+		Prescription: prescription,
+	}
+
+	if dto.SickLeaveId != nil {
+		chat.SickLeave = &entity.SickLeaveCertificate{Id: *dto.SickLeaveId}
 	}
+
+	return dto.Channel, chat
 }
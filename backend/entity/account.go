@@ -3,8 +3,11 @@ package entity
 import "time"
 
 type Role struct {
-	Id   int64
-	Name string
+	Id                  int64
+	Name                string
+	IsOperator          bool
+	IsTwoFactorRequired bool
+	Permissions         []string
 }
 
 type Account struct {
@@ -32,9 +35,17 @@ type ResetPasswordToken struct {
 	ExpiredAt time.Time
 }
 
+type LoginAttempt struct {
+	FailedAttempts int
+	LockedFor      int
+}
+
 type RefreshToken struct {
 	Id        int64
 	AccountId int64
+	SessionId string
 	Token     string
 	ExpiredAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
 }
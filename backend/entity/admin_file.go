@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type AdminFile struct {
+	Id          int64
+	PublicId    string
+	Url         string
+	Name        string
+	ContentType string
+	Size        int64
+	UploadedBy  int64
+	CreatedAt   time.Time
+}
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+type AuditActor struct {
+	AccountId int64
+	Role      string
+	RequestId string
+	IpAddress string
+}
+
+type AuditLog struct {
+	Id             int64
+	ActorAccountId *int64
+	ActorRole      *string
+	Action         string
+	EntityType     string
+	EntityId       int64
+	Before         []byte
+	After          []byte
+	RequestId      *string
+	IpAddress      *string
+	CreatedAt      time.Time
+}
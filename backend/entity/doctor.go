@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -13,6 +15,23 @@ type Doctor struct {
 	Experience         int
 	SpecializationId   int64
 	SpecializationName string
+	VerificationStatus string
+	RejectionReason    *string
+	AverageRating      float64
+	ReviewCount        int
+}
+
+type DoctorVerification struct {
+	DoctorId           int64
+	AccountId          int64
+	Name               string
+	Email              string
+	SpecializationName string
+	Certificate        string
+	Status             string
+	RejectionReason    *string
+	ReviewedAt         *time.Time
+	RegisteredAt       time.Time
 }
 
 type DetailedDoctor struct {
@@ -25,9 +44,25 @@ type DetailedDoctor struct {
 	Experience         int
 	SpecializationId   int64
 	SpecializationName string
+	AverageRating      float64
+	ReviewCount        int
 }
 
 type DoctorSpecialization struct {
 	Id   int64
 	Name string
 }
+
+type DoctorReview struct {
+	Id                 int64
+	WsChatRoomId       int64
+	UserAccountId      int64
+	UserName           string
+	UserProfilePicture string
+	DoctorAccountId    int64
+	Rating             int
+	Review             *string
+	IsHidden           bool
+	HiddenReason       *string
+	CreatedAt          time.Time
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type EmailOutbox struct {
+	Id            int64
+	EmailType     string
+	Locale        string
+	Recipient     string
+	TemplateData  []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	SentAt        *time.Time
+	CreatedAt     time.Time
+}
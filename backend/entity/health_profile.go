@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type PatientHealthProfile struct {
+	UserAccountId     int64
+	Allergies         []string
+	ChronicConditions []string
+	Medications       []PatientMedication
+	HeightCm          *decimal.Decimal
+	WeightKg          *decimal.Decimal
+	PregnancyStatus   string
+	UpdatedAt         *time.Time
+}
+
+type PatientMedication struct {
+	Name   string
+	Dosage *string
+}
+
+type HealthProfileAccessLog struct {
+	Id              int64
+	UserAccountId   int64
+	DoctorAccountId int64
+	DoctorName      string
+	WsChatRoomId    int64
+	AccessSource    string
+	CreatedAt       time.Time
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+type ConsultationNote struct {
+	Id              int64
+	WsChatRoomId    int64
+	UserAccountId   int64
+	DoctorAccountId int64
+	DoctorName      string
+	Complaint       string
+	Assessment      string
+	Plan            string
+	Diagnoses       []ConsultationDiagnosis
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ConsultationDiagnosis struct {
+	Code        string
+	Description *string
+}
+
+type MedicalHistoryEntry struct {
+	Type             string
+	Id               int64
+	OccurredAt       time.Time
+	ConsultationNote *ConsultationNote
+	Prescription     *Prescription
+}
+
+type MedicalRecord struct {
+	Account           Account
+	User              User
+	HealthProfile     PatientHealthProfile
+	ConsultationNotes []ConsultationNote
+	Prescriptions     []Prescription
+	ExportedAt        time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type Notification struct {
+	Id        int64
+	AccountId int64
+	Type      string
+	Title     string
+	Message   string
+	Data      []byte
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+type NotificationCount struct {
+	TotalItem   int
+	UnreadCount int
+}
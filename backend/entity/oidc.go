@@ -0,0 +1,17 @@
+package entity
+
+type OidcLoginState struct {
+	State         string
+	Provider      string
+	Nonce         string
+	CodeVerifier  string
+	LinkAccountId *int64
+}
+
+type OidcIdentity struct {
+	Provider        string
+	Subject         string
+	Email           string
+	IsEmailVerified bool
+	Name            string
+}
@@ -14,6 +14,7 @@ type OrderItem struct {
 	DrugUnit        string
 	Quantity        int
 	DrugImage       string
+	PrescriptionId  *int64
 }
 
 type OrderPharmacy struct {
@@ -23,6 +24,7 @@ type OrderPharmacy struct {
 	UserId                int64
 	OrderStatusId         int64
 	PharmacyCourierId     int64
+	PharmacyManagerId     int64
 	SubtotalAmount        decimal.Decimal
 	DeliveryFee           decimal.Decimal
 	PharmacyName          string
@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type OrderEvent struct {
+	Id                int64
+	Type              string
+	OrderId           int64
+	OrderPharmacyId   int64
+	OrderStatusId     int64
+	UserId            int64
+	PharmacyManagerId int64
+	CreatedAt         time.Time
+}
@@ -1,6 +1,8 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -17,6 +19,8 @@ type PharmacyDrug struct {
 	Stock            int
 	CartItemId       *int
 	CartItemQuantity *int
+	AverageRating    float64
+	ReviewCount      int
 }
 
 type DrugListing struct {
@@ -27,6 +31,8 @@ type DrugListing struct {
 	MaxPrice               decimal.Decimal `json:"max_price"`
 	Image                  string          `json:"image_url"`
 	IsPrescriptionRequired string          `json:"prescription_required"`
+	AverageRating          float64         `json:"rating"`
+	ReviewCount            int             `json:"review_count"`
 }
 
 type PharmacyOperational struct {
@@ -50,6 +56,8 @@ type Pharmacy struct {
 	Latitude                string
 	Longitude               string
 	Distance                float64
+	AverageRating           float64
+	ReviewCount             int
 }
 
 type PharmacyJoinPharmacyDrug struct {
@@ -81,13 +89,14 @@ type Courier struct {
 }
 
 type PharmacyDrugDetail struct {
-	Id              int64
-	PharmacyId      int64
-	PharmacyName    string
-	PharmacyAddress string
-	DrugId          int64
-	Price           decimal.Decimal
-	Stock           int
+	Id                     int64
+	PharmacyId             int64
+	PharmacyName           string
+	PharmacyAddress        string
+	DrugId                 int64
+	Price                  decimal.Decimal
+	Stock                  int
+	IsPrescriptionRequired bool
 }
 
 type CourierOption struct {
@@ -126,3 +135,63 @@ type DetailPharmacyDrug struct {
 	Drug  Drug
 	Price decimal.Decimal
 }
+
+type ReviewableOrderPharmacy struct {
+	OrderPharmacyId int64
+	UserId          int64
+	OrderStatusId   int64
+	PharmacyId      int64
+}
+
+type ReviewableOrderItem struct {
+	OrderItemId     int64
+	OrderPharmacyId int64
+	PharmacyDrugId  int64
+	DrugId          int64
+	UserId          int64
+	OrderStatusId   int64
+	PharmacyId      int64
+}
+
+type PharmacyReview struct {
+	Id                 int64
+	OrderPharmacyId    int64
+	PharmacyId         int64
+	PharmacyManagerId  int64
+	UserId             int64
+	UserName           string
+	UserProfilePicture string
+	Rating             int
+	Review             *string
+	ManagerReply       *string
+	RepliedAt          *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type OrderItemReview struct {
+	Id                 int64
+	OrderItemId        int64
+	PharmacyId         int64
+	PharmacyName       string
+	PharmacyManagerId  int64
+	PharmacyDrugId     int64
+	DrugId             int64
+	UserId             int64
+	UserName           string
+	UserProfilePicture string
+	Rating             int
+	Review             *string
+	ManagerReply       *string
+	RepliedAt          *time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type PharmacyRatingReport struct {
+	Period                 time.Time
+	PharmacyReviewCount    int
+	PharmacyAverageRating  float64
+	OrderItemReviewCount   int
+	OrderItemAverageRating float64
+}
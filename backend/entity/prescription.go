@@ -3,10 +3,11 @@ package entity
 import "time"
 
 type PrescriptionDrug struct {
-	Id       int64
-	Drug     Drug
-	Quantity int
-	Note     string
+	Id                int64
+	Drug              Drug
+	Quantity          int
+	DispensedQuantity int
+	Note              string
 }
 
 type Prescription struct {
@@ -18,5 +19,88 @@ type Prescription struct {
 	PrescriptionDrugs []PrescriptionDrug
 	RedeemedAt        *time.Time
 	OrderedAt         *time.Time
+	ExpiredAt         *time.Time
+	RefillCount       int
+	WsChatRoomId      *int64
 	CreatedAt         *time.Time
 }
+
+func (p Prescription) IsExpired() bool {
+	return p.ExpiredAt != nil && !time.Now().Before(*p.ExpiredAt)
+}
+
+func (p Prescription) RemainingQuantity(prescriptionDrug PrescriptionDrug) int {
+	remaining := prescriptionDrug.Quantity*(p.RefillCount+1) - prescriptionDrug.DispensedQuantity
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+func (p Prescription) IsExhausted() bool {
+	for _, prescriptionDrug := range p.PrescriptionDrugs {
+		if p.RemainingQuantity(prescriptionDrug) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+type PrescriptionDocument struct {
+	PrescriptionId       int64
+	PatientAccountId     int64
+	PatientName          string
+	PatientGender        *string
+	PatientDateOfBirth   *time.Time
+	DoctorAccountId      int64
+	DoctorName           string
+	DoctorSpecialization string
+	DoctorCertificate    string
+	PrescriptionDrugs    []PrescriptionDrug
+	VerificationCode     *string
+	Signature            *string
+	RedeemedAt           *time.Time
+	OrderedAt            *time.Time
+	ExpiredAt            time.Time
+	RefillCount          int
+	IssuedAt             time.Time
+}
+
+type PrescriptionVerification struct {
+	Document PrescriptionDocument
+	IsValid  bool
+}
+
+type PrescribableDrug struct {
+	Id                      int64
+	Name                    string
+	GenericName             string
+	IsActive                bool
+	MaxPrescriptionQuantity *int
+}
+
+type DrugInteraction struct {
+	Id           int64
+	GenericNameA string
+	GenericNameB string
+	Severity     string
+	Description  string
+}
+
+type PrescriptionIssue struct {
+	Code          string
+	DrugId        int64
+	RelatedDrugId *int64
+	Message       string
+}
+
+type PrescriptionValidation struct {
+	Errors   []PrescriptionIssue
+	Warnings []PrescriptionIssue
+}
+
+func (v PrescriptionValidation) IsRejected(acknowledgeWarnings bool) bool {
+	return len(v.Errors) > 0 || (len(v.Warnings) > 0 && !acknowledgeWarnings)
+}
@@ -0,0 +1,17 @@
+package entity
+
+type Permission struct {
+	Id          int64
+	Name        string
+	Description string
+}
+
+func (r Role) HasPermission(permission string) bool {
+	for _, rolePermission := range r.Permissions {
+		if rolePermission == permission {
+			return true
+		}
+	}
+
+	return false
+}
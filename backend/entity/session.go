@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+type AccountSession struct {
+	SessionId  string
+	AccountId  int64
+	UserAgent  string
+	IpAddress  string
+	LastUsedAt time.Time
+	ExpiredAt  time.Time
+	CreatedAt  time.Time
+}
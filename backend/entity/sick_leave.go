@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+type SickLeaveCertificate struct {
+	Id                   int64
+	WsChatRoomId         int64
+	UserAccountId        int64
+	PatientName          string
+	PatientGender        *string
+	PatientDateOfBirth   *time.Time
+	DoctorAccountId      int64
+	DoctorName           string
+	DoctorSpecialization string
+	DoctorCertificate    string
+	StartDate            time.Time
+	Days                 int
+	DiagnosisSummary     string
+	VerificationCode     string
+	Signature            string
+	IssuedAt             time.Time
+}
+
+func (c SickLeaveCertificate) EndDate() time.Time {
+	return c.StartDate.AddDate(0, 0, c.Days-1)
+}
+
+type SickLeaveVerification struct {
+	Certificate SickLeaveCertificate
+	IsValid     bool
+}
+
+type UserDocument struct {
+	Type       string
+	Id         int64
+	DoctorName string
+	IssuedAt   time.Time
+}
@@ -11,6 +11,7 @@ type Chat struct {
 	Message         *string
 	Attachment      Attachment
 	Prescription    Prescription
+	SickLeave       *SickLeaveCertificate
 	CreatedAt       *string
 }
 
@@ -37,6 +38,13 @@ type WsChatRoom struct {
 	DoctorCertificateUrl string
 	ExpiredAt            *int64
 	Chats                []Chat
+	NextChatCursor       *int64
+	PatientHealthProfile *PatientHealthProfile
+}
+
+type ChatHistory struct {
+	Chats      []Chat
+	NextCursor *int64
 }
 
 type ChatRoomPreview struct {
@@ -54,6 +62,7 @@ type WsChatRoomPreview struct {
 	ParticipantPictureUrl string
 	ExpiredAt             *int64
 	LastChat              Chat
+	UnreadCount           int
 }
 
 type Participant struct {
@@ -8,4 +8,6 @@ type Tokens struct {
 type TokenData struct {
 	AccountId int64
 	Role      string
+	SessionId string
+	TwoFactor bool
 }
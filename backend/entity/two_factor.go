@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+type TwoFactor struct {
+	AccountId    int64
+	Secret       string
+	LastUsedStep int64
+	EnabledAt    *time.Time
+}
+
+type TwoFactorStatus struct {
+	IsEnabled              bool
+	IsRequired             bool
+	RemainingRecoveryCodes int
+}
+
+type TwoFactorEnrolment struct {
+	Secret          string
+	ProvisioningUri string
+	QrCode          string
+}
+
+type TwoFactorActivation struct {
+	RecoveryCodes []string
+	Tokens        *Tokens
+}
+
+type LoginResult struct {
+	Tokens            *Tokens
+	TwoFactorRequired bool
+	ChallengeToken    string
+}
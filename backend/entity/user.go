@@ -50,13 +50,21 @@ type CartItem struct {
 }
 
 type CartItemForCheckout struct {
-	Id             int64
-	DrugId         int64
-	DrugName       string
+	Id                     int64
+	DrugId                 int64
+	DrugName               string
+	PharmacyDrugId         int64
+	Price                  int
+	Unit                   string
+	Quantity               int
+	PrescriptionId         *int64
+	IsPrescriptionRequired bool
+}
+
+type CartItemPrescription struct {
+	CartItemId     int64
 	PharmacyDrugId int64
-	Price          int
-	Unit           string
-	Quantity       int
+	PrescriptionId *int64
 }
 
 type CartItemChanges struct {
@@ -1,8 +1,9 @@
 package entity
 
 type WsToken struct {
-	Token   CentrifugoToken
-	Channel string
+	Token      CentrifugoToken
+	Channel    string
+	LastChatId *int64
 }
 
 type CentrifugoToken struct {
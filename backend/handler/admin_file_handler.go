@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminFileHandler struct {
+	adminFileUsecase usecase.AdminFileUsecase
+}
+
+func NewAdminFileHandler(adminFileUsecase usecase.AdminFileUsecase) AdminFileHandler {
+	return AdminFileHandler{
+		adminFileUsecase: adminFileUsecase,
+	}
+}
+
+func (h *AdminFileHandler) UploadFile(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, appconstant.AdminFileMaxRequestSize)
+
+	file, fileHeader, err := ctx.Request.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			ctx.Error(apperror.NewAppError(http.StatusRequestEntityTooLarge, err, appconstant.MsgTooLargeFile))
+			return
+		}
+		if file == nil {
+			ctx.Error(apperror.FileNotAttachedError())
+			return
+		}
+		ctx.Error(err)
+		return
+	}
+	defer file.Close()
+
+	adminFile, err := h.adminFileUsecase.UploadFile(ctx.Request.Context(), accountId.(int64), file, *fileHeader)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseCreated(ctx, adminFile)
+}
+
+func (h *AdminFileHandler) GetFiles(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	adminFiles, err := h.adminFileUsecase.GetFiles(ctx.Request.Context(), ctx.Query("page"), ctx.Query("limit"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, adminFiles)
+}
+
+func (h *AdminFileHandler) DeleteFile(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	adminFileId, err := strconv.Atoi(ctx.Param(appconstant.AdminFileIdString))
+	if err != nil || adminFileId < 1 {
+		ctx.Error(apperror.AdminFileNotFoundError())
+		return
+	}
+
+	if err = h.adminFileUsecase.DeleteFile(ctx.Request.Context(), int64(adminFileId)); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
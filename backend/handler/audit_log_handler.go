@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuditLogHandler struct {
+	auditLogUsecase usecase.AuditLogUsecase
+}
+
+func NewAuditLogHandler(auditLogUsecase usecase.AuditLogUsecase) AuditLogHandler {
+	return AuditLogHandler{
+		auditLogUsecase: auditLogUsecase,
+	}
+}
+
+func (h *AuditLogHandler) GetAuditLogs(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	validatedQuery, err := bindAuditLogQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	auditLogs, err := h.auditLogUsecase.GetAuditLogs(ctx.Request.Context(), *validatedQuery)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, auditLogs)
+}
+
+func (h *AuditLogHandler) ExportAuditLogs(ctx *gin.Context) {
+	validatedQuery, err := bindAuditLogQuery(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	auditLogs, err := h.auditLogUsecase.ExportAuditLogs(ctx.Request.Context(), *validatedQuery)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", appconstant.AuditLogExportFileName))
+	ctx.Header("Content-Type", appconstant.CsvContentType)
+	ctx.Status(http.StatusOK)
+
+	writer := csv.NewWriter(ctx.Writer)
+	if err = writer.WriteAll(dto.ConvertToAuditLogCsvRecords(auditLogs)); err != nil {
+		ctx.Error(apperror.InternalServerError(err))
+		return
+	}
+}
+
+func bindAuditLogQuery(ctx *gin.Context) (*util.ValidatedGetAuditLogQuery, error) {
+	query := util.GetAuditLogQuery{}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
+	if err := validator.New().Struct(query); err != nil {
+		return nil, err
+	}
+
+	return util.ValidateGetAuditLogQuery(query)
+}
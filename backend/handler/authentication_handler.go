@@ -6,7 +6,9 @@ import (
 	"net/http"
 
 	"max-health/apperror"
+	"max-health/appconstant"
 	"max-health/dto"
+	"max-health/entity"
 	"max-health/usecase"
 	"max-health/util"
 
@@ -33,15 +35,18 @@ func (h *AuthenticationHandler) Login(ctx *gin.Context) {
 		return
 	}
 	account := dto.LoginRequestToAccount(loginRequest)
+	session := entity.AccountSession{
+		UserAgent: ctx.Request.UserAgent(),
+		IpAddress: ctx.ClientIP(),
+	}
 
-	tokens, err := h.authenticationUsecase.Login(ctx.Request.Context(), account)
+	loginResult, err := h.authenticationUsecase.Login(ctx.Request.Context(), account, session)
 	if err != nil {
 		ctx.Error(err)
 		return
 	}
 
-	resTokens := dto.ConvertTokensToResponse(*tokens)
-	util.ResponseOK(ctx, resTokens)
+	util.ResponseOK(ctx, dto.ConvertLoginResultToResponse(*loginResult))
 }
 
 func (h *AuthenticationHandler) RegisterUser(ctx *gin.Context) {
@@ -140,14 +145,14 @@ func (h *AuthenticationHandler) GetNewAccessToken(ctx *gin.Context) {
 		return
 	}
 
-	accessToken, err := h.authenticationUsecase.GetNewAccessToken(ctx.Request.Context(), accessTokenRequest.RefreshToken)
+	tokens, err := h.authenticationUsecase.GetNewAccessToken(ctx.Request.Context(), accessTokenRequest.RefreshToken)
 	if err != nil {
 		ctx.Error(err)
 		return
 	}
 
-	accessTokenRes := dto.ConvertAccessTokenToResponse(accessToken)
-	util.ResponseOK(ctx, accessTokenRes)
+	resTokens := dto.ConvertTokensToResponse(*tokens)
+	util.ResponseOK(ctx, resTokens)
 }
 
 func (h *AuthenticationHandler) VerifyOneAccount(ctx *gin.Context) {
@@ -218,4 +223,76 @@ func (h *AuthenticationHandler) VerifyToken(ctx *gin.Context) {
 	}
 
 	util.ResponseOK(ctx, dto.ToTokenDataDTO(*data))
-}
\ No newline at end of file
+}
+
+func (h *AuthenticationHandler) Logout(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	sessionId, exists := ctx.Get(appconstant.SessionId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	err := h.authenticationUsecase.Logout(ctx.Request.Context(), sessionId.(string))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
+
+func (h *AuthenticationHandler) LogoutAll(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	err := h.authenticationUsecase.LogoutAll(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
+
+func (h *AuthenticationHandler) GetActiveSessions(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	sessions, err := h.authenticationUsecase.GetActiveSessions(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToAccountSessionResponses(sessions, ctx.GetString(appconstant.SessionId)))
+}
+
+func (h *AuthenticationHandler) RevokeSession(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	err := h.authenticationUsecase.RevokeSession(ctx.Request.Context(), accountId.(int64), ctx.Param(appconstant.SessionIdString))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
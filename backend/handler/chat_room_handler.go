@@ -151,3 +151,53 @@ func (h *ChatRoomHandler) GetRoomDetail(ctx *gin.Context) {
 
 	util.ResponseOK(ctx, dto.ToWsChatRoomRes(*roomDetail))
 }
+
+func (h *ChatRoomHandler) GetChatHistory(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomIdStr := ctx.Param(appconstant.RoomIdString)
+
+	roomId, err := strconv.Atoi(roomIdStr)
+	if err != nil {
+		ctx.Error(apperror.BadRequestError(err))
+		return
+	}
+
+	var beforeChatId *int64
+
+	beforeStr := ctx.Query(appconstant.Before)
+	if beforeStr != "" {
+		before, err := strconv.ParseInt(beforeStr, 10, 64)
+		if err != nil || before < 1 {
+			ctx.Error(apperror.InvalidChatCursorError())
+			return
+		}
+
+		beforeChatId = &before
+	}
+
+	limit := appconstant.ChatHistoryDefaultLimit
+
+	limitStr := ctx.Query(appconstant.Limit)
+	if limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 || limit > appconstant.ChatHistoryMaxLimit {
+			ctx.Error(apperror.InvalidLimitError())
+			return
+		}
+	}
+
+	chatHistory, err := h.chatRoomUsecase.GetChatHistory(ctx.Request.Context(), accountId.(int64), int64(roomId), beforeChatId, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ToChatHistoryRes(*chatHistory))
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ConsultationNoteHandler struct {
+	consultationNoteUsecase usecase.ConsultationNoteUsecase
+}
+
+func NewConsultationNoteHandler(consultationNoteUsecase usecase.ConsultationNoteUsecase) ConsultationNoteHandler {
+	return ConsultationNoteHandler{
+		consultationNoteUsecase: consultationNoteUsecase,
+	}
+}
+
+func (h *ConsultationNoteHandler) UpsertConsultationNote(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomId, err := strconv.Atoi(ctx.Param(appconstant.RoomIdString))
+	if err != nil || roomId < 1 {
+		ctx.Error(apperror.ChatRoomNotFoundError())
+		return
+	}
+
+	var request dto.UpsertConsultationNoteRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	consultationNote, err := h.consultationNoteUsecase.UpsertConsultationNote(ctx.Request.Context(), accountId.(int64), int64(roomId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToConsultationNoteResponse(*consultationNote))
+}
+
+func (h *ConsultationNoteHandler) GetConsultationNote(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomId, err := strconv.Atoi(ctx.Param(appconstant.RoomIdString))
+	if err != nil || roomId < 1 {
+		ctx.Error(apperror.ChatRoomNotFoundError())
+		return
+	}
+
+	consultationNote, err := h.consultationNoteUsecase.GetConsultationNote(ctx.Request.Context(), accountId.(int64), int64(roomId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToConsultationNoteResponse(*consultationNote))
+}
+
+func (h *ConsultationNoteHandler) GetMedicalHistory(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	medicalHistory, err := h.consultationNoteUsecase.GetMedicalHistory(ctx.Request.Context(), accountId.(int64), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, medicalHistory)
+}
+
+func (h *ConsultationNoteHandler) ExportMedicalRecord(ctx *gin.Context) {
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	medicalRecord, err := h.consultationNoteUsecase.ExportMedicalRecord(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	export, err := json.MarshalIndent(dto.ConvertToMedicalRecordExport(*medicalRecord), "", "  ")
+	if err != nil {
+		ctx.Error(apperror.InternalServerError(err))
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fmt.Sprintf(appconstant.MedicalRecordExportFileName, accountId.(int64))))
+	ctx.Data(http.StatusOK, "application/json", export)
+}
@@ -43,7 +43,7 @@ func (h *DoctorHandler) GetAllDoctors(ctx *gin.Context) {
 		return
 	}
 
-	doctors, err := h.doctorUsecase.GetAllDoctors(ctx.Request.Context(), params.Sort, params.SortBy, params.Limit, params.SpecializationId, params.Page)
+	doctors, err := h.doctorUsecase.GetAllDoctors(ctx.Request.Context(), params.Sort, params.SortBy, params.Limit, params.SpecializationId, params.Page, ctx.DefaultQuery("min_rating", ""))
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -178,3 +178,63 @@ func (h *DoctorHandler) GetDoctorIsOnline(ctx *gin.Context) {
 
 	util.ResponseOK(ctx, isOnline)
 }
+
+func (h *DoctorHandler) GetAllDoctorVerifications(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	status := ctx.Query(appconstant.DoctorVerificationStatusString)
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	doctorVerificationList, err := h.doctorUsecase.GetAllDoctorVerifications(ctx.Request.Context(), status, page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, doctorVerificationList)
+}
+
+func (h *DoctorHandler) GetDoctorVerification(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	doctorId, err := strconv.Atoi(ctx.Param(appconstant.DoctorIdString))
+	if err != nil || doctorId < 1 {
+		ctx.Error(apperror.DoctorNotFoundError())
+		return
+	}
+
+	doctorVerification, err := h.doctorUsecase.GetDoctorVerification(ctx.Request.Context(), int64(doctorId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, doctorVerification)
+}
+
+func (h *DoctorHandler) UpdateDoctorVerification(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	doctorId, err := strconv.Atoi(ctx.Param(appconstant.DoctorIdString))
+	if err != nil || doctorId < 1 {
+		ctx.Error(apperror.DoctorNotFoundError())
+		return
+	}
+
+	var request dto.UpdateDoctorVerificationRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	err = h.doctorUsecase.UpdateDoctorVerification(ctx.Request.Context(), int64(doctorId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
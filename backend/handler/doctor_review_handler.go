@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DoctorReviewHandler struct {
+	doctorReviewUsecase usecase.DoctorReviewUsecase
+}
+
+func NewDoctorReviewHandler(doctorReviewUsecase usecase.DoctorReviewUsecase) DoctorReviewHandler {
+	return DoctorReviewHandler{
+		doctorReviewUsecase: doctorReviewUsecase,
+	}
+}
+
+func (h *DoctorReviewHandler) CreateDoctorReview(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomId, err := strconv.Atoi(ctx.Param(appconstant.RoomIdString))
+	if err != nil || roomId < 1 {
+		ctx.Error(apperror.ChatRoomNotFoundError())
+		return
+	}
+
+	var request dto.CreateDoctorReviewRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	doctorReview, err := h.doctorReviewUsecase.CreateDoctorReview(ctx.Request.Context(), accountId.(int64), int64(roomId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToDoctorReviewResponse(*doctorReview))
+}
+
+func (h *DoctorReviewHandler) GetAllDoctorReviewsForPublic(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	doctorId, err := strconv.Atoi(ctx.Param(appconstant.DoctorIdString))
+	if err != nil || doctorId < 1 {
+		ctx.Error(apperror.DoctorNotFoundError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	doctorReviewList, err := h.doctorReviewUsecase.GetAllDoctorReviewsForPublic(ctx.Request.Context(), int64(doctorId), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, doctorReviewList)
+}
+
+func (h *DoctorReviewHandler) GetAllDoctorReviews(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	visibility := ctx.Query(appconstant.DoctorReviewVisibilityString)
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	doctorReviewList, err := h.doctorReviewUsecase.GetAllDoctorReviews(ctx.Request.Context(), visibility, page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, doctorReviewList)
+}
+
+func (h *DoctorReviewHandler) UpdateDoctorReviewVisibility(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	doctorReviewId, err := strconv.Atoi(ctx.Param(appconstant.DoctorReviewIdString))
+	if err != nil || doctorReviewId < 1 {
+		ctx.Error(apperror.DoctorReviewNotFoundError())
+		return
+	}
+
+	var request dto.UpdateDoctorReviewVisibilityRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	err = h.doctorReviewUsecase.UpdateDoctorReviewVisibility(ctx.Request.Context(), int64(doctorReviewId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
@@ -275,7 +275,7 @@ func (h *DrugHandler) UpdateDrugsByPharmacyDrugId(ctx *gin.Context) {
 		return
 	}
 
-	err = h.drugUsecase.UpdateDrugsByPharmacyDrugId(ctx, int64(paramPharmacyDrugIdInt), updateDrugReq.Stock, updateDrugReq.Price)
+	err = h.drugUsecase.UpdateDrugsByPharmacyDrugId(ctx.Request.Context(), int64(paramPharmacyDrugIdInt), updateDrugReq.Stock, updateDrugReq.Price)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -298,7 +298,7 @@ func (h *DrugHandler) AddDrugsByPharmacyManager(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.drugUsecase.AddDrugsByPharmacyDrugId(ctx, int64(addDrugReq.PharmacyId), int64(addDrugReq.DrugId), addDrugReq.Stock, addDrugReq.Price); err != nil {
+	if err := h.drugUsecase.AddDrugsByPharmacyDrugId(ctx.Request.Context(), int64(addDrugReq.PharmacyId), int64(addDrugReq.DrugId), addDrugReq.Stock, addDrugReq.Price); err != nil {
 		ctx.Error(err)
 		return
 	}
@@ -311,7 +311,7 @@ func (h *DrugHandler) DeleteDrugsByPharmacyDrugId(ctx *gin.Context) {
 	paramPharmacyDrugId := ctx.Param(appconstant.PharmacyDrugIdString)
 	pharmacyDrugId, _ := strconv.Atoi(paramPharmacyDrugId)
 
-	if err := h.drugUsecase.DeleteDrugsByPharmacyDrugId(ctx, int64(pharmacyDrugId)); err != nil {
+	if err := h.drugUsecase.DeleteDrugsByPharmacyDrugId(ctx.Request.Context(), int64(pharmacyDrugId)); err != nil {
 		ctx.Error(err)
 		return
 	}
@@ -344,7 +344,7 @@ func (h *DrugHandler) PostStockMutation(ctx *gin.Context) {
 	}
 
 	postStockMutationReq.RecipientPharmacyDrugId = int64(pharmacyDrugId)
-	err := h.drugUsecase.PostStockMutation(ctx, postStockMutationReq)
+	err := h.drugUsecase.PostStockMutation(ctx.Request.Context(), postStockMutationReq)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailOutboxHandler struct {
+	emailOutboxUsecase usecase.EmailOutboxUsecase
+}
+
+func NewEmailOutboxHandler(emailOutboxUsecase usecase.EmailOutboxUsecase) EmailOutboxHandler {
+	return EmailOutboxHandler{
+		emailOutboxUsecase: emailOutboxUsecase,
+	}
+}
+
+func (h *EmailOutboxHandler) GetDeadLetters(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	emails, err := h.emailOutboxUsecase.GetDeadLetters(ctx.Request.Context(), ctx.Query("page"), ctx.Query("limit"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, emails)
+}
+
+func (h *EmailOutboxHandler) RequeueDeadLetter(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	emailOutboxId, err := strconv.Atoi(ctx.Param(appconstant.EmailOutboxIdString))
+	if err != nil || emailOutboxId < 1 {
+		ctx.Error(apperror.EmailOutboxNotFoundError())
+		return
+	}
+
+	if err = h.emailOutboxUsecase.RequeueDeadLetter(ctx.Request.Context(), int64(emailOutboxId)); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
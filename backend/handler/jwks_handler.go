@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"max-health/appconstant"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type JwksHandler struct {
+	jwksProvider util.JwksProvider
+}
+
+func NewJwksHandler(jwksProvider util.JwksProvider) JwksHandler {
+	return JwksHandler{
+		jwksProvider: jwksProvider,
+	}
+}
+
+func (h *JwksHandler) GetJwks(ctx *gin.Context) {
+	ctx.Header(appconstant.CacheControlHeader, appconstant.JwksCacheControl)
+	ctx.JSON(http.StatusOK, h.jwksProvider.Jwks())
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"max-health/appconstant"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceHandler struct {
+	maintenanceUsecase usecase.MaintenanceUsecase
+	metrics            *util.HttpMetrics
+}
+
+func NewMaintenanceHandler(maintenanceUsecase usecase.MaintenanceUsecase, metrics *util.HttpMetrics) MaintenanceHandler {
+	return MaintenanceHandler{
+		maintenanceUsecase: maintenanceUsecase,
+		metrics:            metrics,
+	}
+}
+
+func (h *MaintenanceHandler) GetMetrics(ctx *gin.Context) {
+	ctx.Header("Content-Type", appconstant.MetricsContentType)
+	ctx.Status(http.StatusOK)
+
+	h.metrics.WritePrometheus(ctx.Writer)
+	util.WriteDbMetrics(ctx.Writer, h.maintenanceUsecase.GetDbStats())
+	util.WriteRuntimeMetrics(ctx.Writer)
+}
+
+func (h *MaintenanceHandler) GetStatus(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	util.ResponseOK(ctx, h.maintenanceUsecase.GetStatus(ctx.Request.Context()))
+}
+
+func (h *MaintenanceHandler) UpdateMaintenanceMode(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	var request dto.UpdateMaintenanceModeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	response, err := h.maintenanceUsecase.SetMaintenanceMode(ctx.Request.Context(), *request.IsUnderMaintenance, request.Message)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, response)
+}
+
+func (h *MaintenanceHandler) PurgeExpiredData(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	purged, err := h.maintenanceUsecase.PurgeExpiredData(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, purged)
+}
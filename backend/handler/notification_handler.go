@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationHandler struct {
+	notificationUsecase usecase.NotificationUsecase
+}
+
+func NewNotificationHandler(notificationUsecase usecase.NotificationUsecase) NotificationHandler {
+	return NotificationHandler{
+		notificationUsecase: notificationUsecase,
+	}
+}
+
+func (h *NotificationHandler) GetNotifications(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	unreadOnly := ctx.Query("unread") == "true"
+
+	notifications, err := h.notificationUsecase.GetNotifications(ctx.Request.Context(), accountId.(int64), unreadOnly, ctx.Query("page"), ctx.Query("limit"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, notifications)
+}
+
+func (h *NotificationHandler) MarkNotificationsRead(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	var request dto.MarkNotificationsReadRequest
+
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	response, err := h.notificationUsecase.MarkNotificationsRead(ctx.Request.Context(), accountId.(int64), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, response)
+}
+
+func (h *NotificationHandler) GenerateToken(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	wsToken, err := h.notificationUsecase.GenerateToken(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ToWsTokenDTO(wsToken))
+}
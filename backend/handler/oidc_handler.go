@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"max-health/appconstant"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *AuthenticationHandler) GetOidcProviders(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	util.ResponseOK(ctx, dto.OidcProvidersResponse{Providers: h.authenticationUsecase.GetOidcProviders()})
+}
+
+func (h *AuthenticationHandler) StartOidcLogin(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	authorizationUrl, err := h.authenticationUsecase.StartOidcLogin(ctx.Request.Context(), ctx.Param(appconstant.ProviderString))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.OidcAuthorizationResponse{AuthorizationUrl: authorizationUrl})
+}
+
+func (h *AuthenticationHandler) StartOidcLink(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	tokenData, err := getTokenData(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var request dto.OidcLinkRequest
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	authorizationUrl, err := h.authenticationUsecase.StartOidcLink(ctx.Request.Context(), tokenData.AccountId, ctx.Param(appconstant.ProviderString), request.Password, request.Code)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.OidcAuthorizationResponse{AuthorizationUrl: authorizationUrl})
+}
+
+func (h *AuthenticationHandler) CompleteOidcLogin(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	var request dto.OidcCallbackRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	session := entity.AccountSession{
+		UserAgent: ctx.Request.UserAgent(),
+		IpAddress: ctx.ClientIP(),
+	}
+
+	loginResult, err := h.authenticationUsecase.CompleteOidcLogin(ctx.Request.Context(), ctx.Param(appconstant.ProviderString), request.Code, request.State, session)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertLoginResultToResponse(*loginResult))
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderEventHandler struct {
+	orderEventUsecase usecase.OrderEventUsecase
+}
+
+func NewOrderEventHandler(orderEventUsecase usecase.OrderEventUsecase) OrderEventHandler {
+	return OrderEventHandler{
+		orderEventUsecase: orderEventUsecase,
+	}
+}
+
+func (h *OrderEventHandler) StreamOrderEvents(ctx *gin.Context) {
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	lastEventIdString := ctx.GetHeader(appconstant.LastEventIdHeader)
+	if lastEventIdString == "" {
+		lastEventIdString = ctx.Query(appconstant.LastEventIdQuery)
+	}
+
+	var lastEventId int64
+	if lastEventIdString != "" {
+		parsedLastEventId, err := strconv.ParseInt(lastEventIdString, 10, 64)
+		if err != nil || parsedLastEventId < 0 {
+			ctx.Error(apperror.InvalidLastEventIdError())
+			return
+		}
+		lastEventId = parsedLastEventId
+	}
+
+	subscription, err := h.orderEventUsecase.Subscribe(ctx.Request.Context(), accountId.(int64), lastEventId)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer subscription.Close()
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+
+	fmt.Fprintf(ctx.Writer, "retry: %d\n\n", appconstant.OrderEventRetryMilliseconds)
+
+	if subscription.Resync {
+		fmt.Fprintf(ctx.Writer, "event: %s\ndata: {}\n\n", appconstant.OrderEventTypeResync)
+	}
+
+	for _, event := range subscription.Backlog {
+		if err := writeOrderEvent(ctx.Writer, event); err != nil {
+			return
+		}
+	}
+	ctx.Writer.Flush()
+
+	heartbeat := time.NewTicker(appconstant.OrderEventHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return
+			}
+			if err := writeOrderEvent(ctx.Writer, event); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := io.WriteString(ctx.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+
+		ctx.Writer.Flush()
+	}
+}
+
+func writeOrderEvent(w io.Writer, event entity.OrderEvent) error {
+	data, err := json.Marshal(dto.ConvertToOrderEventResponse(event))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+	return err
+}
@@ -81,7 +81,7 @@ func (h *OrderHandler) ConfirmPayment(ctx *gin.Context) {
 		return
 	}
 
-	err = h.orderUsecase.ConfirmPayment(ctx, int64(orderId), req.StatusId)
+	err = h.orderUsecase.ConfirmPayment(ctx.Request.Context(), int64(orderId), req.StatusId)
 	if err != nil {
 		ctx.Error(err)
 		return
@@ -126,7 +126,7 @@ func (h *PartnerHandler) DeletePartner(ctx *gin.Context) {
 	paramPharmacyManagerId := ctx.Param(appconstant.PharmacyManagerIdString)
 	pharmacyManagerId, _ := strconv.Atoi(paramPharmacyManagerId)
 
-	if err := h.partnerUsecase.DeleteOnePartner(ctx, int64(pharmacyManagerId)); err != nil {
+	if err := h.partnerUsecase.DeleteOnePartner(ctx.Request.Context(), int64(pharmacyManagerId)); err != nil {
 		ctx.Error(err)
 		return
 	}
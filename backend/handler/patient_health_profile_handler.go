@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PatientHealthProfileHandler struct {
+	patientHealthProfileUsecase usecase.PatientHealthProfileUsecase
+}
+
+func NewPatientHealthProfileHandler(patientHealthProfileUsecase usecase.PatientHealthProfileUsecase) PatientHealthProfileHandler {
+	return PatientHealthProfileHandler{
+		patientHealthProfileUsecase: patientHealthProfileUsecase,
+	}
+}
+
+func (h *PatientHealthProfileHandler) GetHealthProfile(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	profile, err := h.patientHealthProfileUsecase.GetHealthProfile(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPatientHealthProfileResponse(*profile))
+}
+
+func (h *PatientHealthProfileHandler) UpdateHealthProfile(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	var request dto.UpdatePatientHealthProfileRequest
+
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	profile, err := h.patientHealthProfileUsecase.UpdateHealthProfile(ctx.Request.Context(), request.ToPatientHealthProfile(accountId.(int64)))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPatientHealthProfileResponse(*profile))
+}
+
+func (h *PatientHealthProfileHandler) GetAccessLogs(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	accessLogList, err := h.patientHealthProfileUsecase.GetAccessLogs(ctx.Request.Context(), accountId.(int64), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, accessLogList)
+}
+
+func (h *PatientHealthProfileHandler) GetHealthProfileForDoctor(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomId, err := strconv.Atoi(ctx.Param(appconstant.RoomIdString))
+	if err != nil || roomId < 1 {
+		ctx.Error(apperror.ChatRoomNotFoundError())
+		return
+	}
+
+	profile, err := h.patientHealthProfileUsecase.GetHealthProfileForDoctor(ctx.Request.Context(), accountId.(int64), int64(roomId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPatientHealthProfileResponse(*profile))
+}
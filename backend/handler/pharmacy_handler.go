@@ -175,7 +175,7 @@ func (h *PharmacyHandler) DeleteOnePharmacy(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.pharmacyUsecase.DeleteOnePharmacyById(ctx, accountId.(int64), int64(pharmacyId)); err != nil {
+	if err := h.pharmacyUsecase.DeleteOnePharmacyById(ctx.Request.Context(), accountId.(int64), int64(pharmacyId)); err != nil {
 		ctx.Error(err)
 		return
 	}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PharmacyReviewHandler struct {
+	pharmacyReviewUsecase usecase.PharmacyReviewUsecase
+}
+
+func NewPharmacyReviewHandler(pharmacyReviewUsecase usecase.PharmacyReviewUsecase) PharmacyReviewHandler {
+	return PharmacyReviewHandler{
+		pharmacyReviewUsecase: pharmacyReviewUsecase,
+	}
+}
+
+func (h *PharmacyReviewHandler) CreatePharmacyReview(ctx *gin.Context) {
+	h.savePharmacyReview(ctx, h.pharmacyReviewUsecase.CreatePharmacyReview)
+}
+
+func (h *PharmacyReviewHandler) UpdatePharmacyReview(ctx *gin.Context) {
+	h.savePharmacyReview(ctx, h.pharmacyReviewUsecase.UpdatePharmacyReview)
+}
+
+func (h *PharmacyReviewHandler) CreateOrderItemReview(ctx *gin.Context) {
+	h.saveOrderItemReview(ctx, h.pharmacyReviewUsecase.CreateOrderItemReview)
+}
+
+func (h *PharmacyReviewHandler) UpdateOrderItemReview(ctx *gin.Context) {
+	h.saveOrderItemReview(ctx, h.pharmacyReviewUsecase.UpdateOrderItemReview)
+}
+
+func (h *PharmacyReviewHandler) ReplyPharmacyReview(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	pharmacyReviewId, err := strconv.Atoi(ctx.Param(appconstant.PharmacyReviewIdString))
+	if err != nil || pharmacyReviewId < 1 {
+		ctx.Error(apperror.PharmacyReviewNotFoundError())
+		return
+	}
+
+	var request dto.ReviewReplyRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	pharmacyReview, err := h.pharmacyReviewUsecase.ReplyPharmacyReview(ctx.Request.Context(), accountId.(int64), int64(pharmacyReviewId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPharmacyReviewResponse(*pharmacyReview))
+}
+
+func (h *PharmacyReviewHandler) ReplyOrderItemReview(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	orderItemReviewId, err := strconv.Atoi(ctx.Param(appconstant.OrderItemReviewIdString))
+	if err != nil || orderItemReviewId < 1 {
+		ctx.Error(apperror.OrderItemReviewNotFoundError())
+		return
+	}
+
+	var request dto.ReviewReplyRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	orderItemReview, err := h.pharmacyReviewUsecase.ReplyOrderItemReview(ctx.Request.Context(), accountId.(int64), int64(orderItemReviewId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToOrderItemReviewResponse(*orderItemReview))
+}
+
+func (h *PharmacyReviewHandler) GetAllPharmacyReviews(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	pharmacyId, err := strconv.Atoi(ctx.Param(appconstant.PharmacyIdString))
+	if err != nil || pharmacyId < 1 {
+		ctx.Error(apperror.PharmacyNotFoundError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	pharmacyReviewList, err := h.pharmacyReviewUsecase.GetAllPharmacyReviews(ctx.Request.Context(), int64(pharmacyId), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, pharmacyReviewList)
+}
+
+func (h *PharmacyReviewHandler) GetAllDrugReviews(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	drugId, err := strconv.Atoi(ctx.Param(appconstant.DrugIdString))
+	if err != nil || drugId < 1 {
+		ctx.Error(apperror.DrugNotFoundError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	orderItemReviewList, err := h.pharmacyReviewUsecase.GetAllDrugReviews(ctx.Request.Context(), int64(drugId), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, orderItemReviewList)
+}
+
+func (h *PharmacyReviewHandler) savePharmacyReview(ctx *gin.Context, save func(ctx context.Context, accountId, orderPharmacyId int64, request dto.PharmacyReviewRequest) (*entity.PharmacyReview, error)) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	orderPharmacyId, err := strconv.Atoi(ctx.Param(appconstant.OrderPharmacyIdString))
+	if err != nil || orderPharmacyId < 1 {
+		ctx.Error(apperror.PharmacyOrderNotFoundError())
+		return
+	}
+
+	var request dto.PharmacyReviewRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	pharmacyReview, err := save(ctx.Request.Context(), accountId.(int64), int64(orderPharmacyId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPharmacyReviewResponse(*pharmacyReview))
+}
+
+func (h *PharmacyReviewHandler) saveOrderItemReview(ctx *gin.Context, save func(ctx context.Context, accountId, orderItemId int64, request dto.PharmacyReviewRequest) (*entity.OrderItemReview, error)) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	orderItemId, err := strconv.Atoi(ctx.Param(appconstant.OrderItemIdString))
+	if err != nil || orderItemId < 1 {
+		ctx.Error(apperror.OrderItemNotFoundError())
+		return
+	}
+
+	var request dto.PharmacyReviewRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	orderItemReview, err := save(ctx.Request.Context(), accountId.(int64), int64(orderItemId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToOrderItemReviewResponse(*orderItemReview))
+}
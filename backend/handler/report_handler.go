@@ -147,3 +147,67 @@ func (h *ReportHandler) GetDrugReport(ctx *gin.Context) {
 
 	util.ResponseOK(ctx, drugReport)
 }
+
+func (h *ReportHandler) GetPharmacyRatingReport(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	query := util.GetReportQuery{}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(apperror.BadRequestError(err))
+		return
+	}
+
+	if err := validator.New().Struct(query); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	validatedQuery, err := util.ValidateGetReportQuery(query)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	pharmacyRatingReport, err := h.reportUsecase.GetPharmacyRatingReport(ctx.Request.Context(), accountId.(int64), *validatedQuery)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, pharmacyRatingReport)
+}
+
+func (h *ReportHandler) GetRatingReport(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	query := util.GetReportQuery{}
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.Error(apperror.BadRequestError(err))
+		return
+	}
+
+	if err := validator.New().Struct(query); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	validatedQuery, err := util.ValidateGetReportQuery(query)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ratingReport, err := h.reportUsecase.GetRatingReport(ctx.Request.Context(), *validatedQuery)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, ratingReport)
+}
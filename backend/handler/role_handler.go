@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type RoleHandler struct {
+	roleUsecase usecase.RoleUsecase
+}
+
+func NewRoleHandler(roleUsecase usecase.RoleUsecase) RoleHandler {
+	return RoleHandler{
+		roleUsecase: roleUsecase,
+	}
+}
+
+func (h *RoleHandler) GetRoles(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	roles, err := h.roleUsecase.GetRoles(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToRoleResponses(roles))
+}
+
+func (h *RoleHandler) GetPermissions(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	permissions, err := h.roleUsecase.GetPermissions(ctx.Request.Context())
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPermissionResponses(permissions))
+}
+
+func (h *RoleHandler) UpdateRolePermissions(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	roleId, err := strconv.Atoi(ctx.Param(appconstant.RoleIdString))
+	if err != nil || roleId < 1 {
+		ctx.Error(apperror.RoleNotFoundError())
+		return
+	}
+
+	var request dto.UpdateRolePermissionsRequest
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	role, err := h.roleUsecase.UpdateRolePermissions(ctx.Request.Context(), int64(roleId), request.Permissions)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToRoleResponse(*role))
+}
+
+func (h *RoleHandler) UpdateAccountRole(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	adminAccountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	accountId, err := strconv.Atoi(ctx.Param(appconstant.AccountIdString))
+	if err != nil || accountId < 1 {
+		ctx.Error(apperror.AccountNotFoundError())
+		return
+	}
+
+	var request dto.UpdateAccountRoleRequest
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	err = h.roleUsecase.AssignAccountRole(ctx.Request.Context(), adminAccountId.(int64), int64(accountId), request.RoleId)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
+
+func (h *RoleHandler) CreateOperator(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	var request dto.CreateOperatorRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	err = h.roleUsecase.CreateOperator(ctx.Request.Context(), dto.CreateOperatorRequestToAccount(request))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseCreated(ctx, nil)
+}
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SickLeaveHandler struct {
+	sickLeaveUsecase usecase.SickLeaveUsecase
+}
+
+func NewSickLeaveHandler(sickLeaveUsecase usecase.SickLeaveUsecase) SickLeaveHandler {
+	return SickLeaveHandler{
+		sickLeaveUsecase: sickLeaveUsecase,
+	}
+}
+
+func (h *SickLeaveHandler) IssueSickLeaveCertificate(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	roomId, err := strconv.Atoi(ctx.Param(appconstant.RoomIdString))
+	if err != nil || roomId < 1 {
+		ctx.Error(apperror.ChatRoomNotFoundError())
+		return
+	}
+
+	var request dto.IssueSickLeaveRequest
+
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	certificate, err := h.sickLeaveUsecase.IssueSickLeaveCertificate(ctx.Request.Context(), accountId.(int64), int64(roomId), request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToSickLeaveCertificateResponse(*certificate))
+}
+
+func (h *SickLeaveHandler) GetSickLeaveDocument(ctx *gin.Context) {
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	sickLeaveId, err := strconv.Atoi(ctx.Param(appconstant.SickLeaveIdString))
+	if err != nil || sickLeaveId < 1 {
+		ctx.Error(apperror.SickLeaveNotFoundError())
+		return
+	}
+
+	document, err := h.sickLeaveUsecase.GetSickLeaveDocument(ctx.Request.Context(), accountId.(int64), int64(sickLeaveId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fmt.Sprintf(appconstant.SickLeaveDocumentFileName, sickLeaveId)))
+	ctx.Data(http.StatusOK, "application/pdf", document)
+}
+
+func (h *SickLeaveHandler) VerifySickLeaveCertificate(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	verificationCode := ctx.Param(appconstant.VerificationCodeString)
+
+	verification, err := h.sickLeaveUsecase.VerifySickLeaveCertificate(ctx.Request.Context(), verificationCode)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToSickLeaveVerificationResponse(*verification))
+}
+
+func (h *SickLeaveHandler) GetUserDocuments(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	page := ctx.Query("page")
+	limit := ctx.Query("limit")
+
+	documentList, err := h.sickLeaveUsecase.GetUserDocuments(ctx.Request.Context(), accountId.(int64), page, limit)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, documentList)
+}
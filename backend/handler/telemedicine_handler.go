@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"max-health/appconstant"
@@ -140,3 +142,42 @@ func (h *TelemedicineHandler) CheckoutFromPrescription(ctx *gin.Context) {
 
 	util.ResponseOK(ctx, dto.OrderCheckoutResponse{OrderId: *orderId})
 }
+
+func (h *TelemedicineHandler) GetPrescriptionDocument(ctx *gin.Context) {
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	prescriptionIdString := ctx.Param(appconstant.PrescriptionIdString)
+
+	prescriptionId, err := strconv.Atoi(prescriptionIdString)
+	if err != nil {
+		ctx.Error(apperror.PrescriptionIdNotANumberError())
+		return
+	}
+
+	document, err := h.telemedicineUsecase.GetPrescriptionDocument(ctx.Request.Context(), accountId.(int64), int64(prescriptionId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", fmt.Sprintf(appconstant.PrescriptionDocumentFileName, prescriptionId)))
+	ctx.Data(http.StatusOK, "application/pdf", document)
+}
+
+func (h *TelemedicineHandler) VerifyPrescription(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	verificationCode := ctx.Param(appconstant.VerificationCodeString)
+
+	verification, err := h.telemedicineUsecase.VerifyPrescription(ctx.Request.Context(), verificationCode)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToPrescriptionVerificationResponse(*verification))
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"strconv"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *AuthenticationHandler) VerifyTwoFactorLogin(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	var request dto.TwoFactorLoginRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	session := entity.AccountSession{
+		UserAgent: ctx.Request.UserAgent(),
+		IpAddress: ctx.ClientIP(),
+	}
+
+	tokens, err := h.authenticationUsecase.VerifyTwoFactorLogin(ctx.Request.Context(), request.ChallengeToken, request.Code, session)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertTokensToResponse(*tokens))
+}
+
+func (h *AuthenticationHandler) GetTwoFactorStatus(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	tokenData, err := getTokenData(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	status, err := h.authenticationUsecase.GetTwoFactorStatus(ctx.Request.Context(), *tokenData)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToTwoFactorStatusResponse(*status))
+}
+
+func (h *AuthenticationHandler) StartTwoFactorEnrolment(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	enrolment, err := h.authenticationUsecase.StartTwoFactorEnrolment(ctx.Request.Context(), accountId.(int64))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToTwoFactorEnrolmentResponse(*enrolment))
+}
+
+func (h *AuthenticationHandler) ConfirmTwoFactorEnrolment(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	tokenData, err := getTokenData(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var request dto.TwoFactorCodeRequest
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	activation, err := h.authenticationUsecase.ConfirmTwoFactorEnrolment(ctx.Request.Context(), *tokenData, request.Code)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.ConvertToTwoFactorActivationResponse(*activation))
+}
+
+func (h *AuthenticationHandler) RegenerateRecoveryCodes(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		ctx.Error(apperror.UnauthorizedError())
+		return
+	}
+
+	var request dto.TwoFactorCodeRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	recoveryCodes, err := h.authenticationUsecase.RegenerateRecoveryCodes(ctx.Request.Context(), accountId.(int64), request.Code)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, dto.RecoveryCodesResponse{RecoveryCodes: recoveryCodes})
+}
+
+func (h *AuthenticationHandler) DisableTwoFactor(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	tokenData, err := getTokenData(ctx)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	var request dto.TwoFactorCodeRequest
+	err = ctx.ShouldBindJSON(&request)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	err = h.authenticationUsecase.DisableTwoFactor(ctx.Request.Context(), *tokenData, request.Code)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
+
+func (h *AuthenticationHandler) ResetTwoFactor(ctx *gin.Context) {
+	ctx.Header("Content-Type", "application/json")
+
+	accountId, err := strconv.Atoi(ctx.Param(appconstant.AccountIdString))
+	if err != nil || accountId < 1 {
+		ctx.Error(apperror.AccountNotFoundError())
+		return
+	}
+
+	err = h.authenticationUsecase.ResetTwoFactor(ctx.Request.Context(), int64(accountId))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	util.ResponseOK(ctx, nil)
+}
+
+func getTokenData(ctx *gin.Context) (*entity.TokenData, error) {
+	accountId, exists := ctx.Get(appconstant.AccountId)
+	if !exists {
+		return nil, apperror.UnauthorizedError()
+	}
+
+	return &entity.TokenData{
+		AccountId: accountId.(int64),
+		Role:      ctx.GetString(appconstant.Role),
+		SessionId: ctx.GetString(appconstant.SessionId),
+		TwoFactor: ctx.GetBool(appconstant.TwoFactorVerified),
+	}, nil
+}
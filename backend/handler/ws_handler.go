@@ -17,19 +17,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type WsHandler struct {
 	wsUsecase usecase.WsUsecase
 	upgrader  websocket.Upgrader
-	logger    *logrus.Logger
 }
 
-func NewWsHandler(wsUsecase usecase.WsUsecase, upgrader websocket.Upgrader, logger *logrus.Logger) *WsHandler {
+func NewWsHandler(wsUsecase usecase.WsUsecase, upgrader websocket.Upgrader) *WsHandler {
 	return &WsHandler{
 		wsUsecase: wsUsecase,
 		upgrader:  upgrader,
-		logger:    logger,
 	}
 }
 
@@ -71,11 +70,12 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 	}
 	defer conn.Close()
 
-	requestId, exist := ctx.Get(appconstant.RequestId)
-	if !exist {
-		requestId = ""
-	}
-	path := ctx.Request.URL.Path
+	sessionCtx, span := util.StartSpan(ctx.Request.Context(), appconstant.SpanWsSession)
+	defer span.End()
+
+	logger := util.LoggerFromContext(sessionCtx).WithFields(logrus.Fields{
+		"path": ctx.Request.URL.Path,
+	})
 
 	chAuth := make(chan entity.WsToken)
 	fromClient := make(chan []byte, 10)
@@ -94,17 +94,14 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 		isAuthenticated = true
 		mutex.Unlock()
 
-		h.logger.WithFields(logrus.Fields{
-			"path":       path,
-			"request-id": requestId,
-		}).Infof("open connection to centrifugo")
+		span.SetAttributes(attribute.String("ws.channel", wsToken.Channel))
+		logger.Info("open connection to centrifugo")
 
-		err = h.wsUsecase.HandleCentrifugo(ctx.Request.Context(), wsToken, toClient, fromClient, chClose)
+		err = h.wsUsecase.HandleCentrifugo(sessionCtx, wsToken, toClient, fromClient, chClose)
 		if err != nil {
-			h.logger.WithFields(logrus.Fields{
-				"error":      fmt.Sprintf("error handling centrifugo: %s", err.Error()),
-				"path":       path,
-				"request-id": requestId,
+			span.RecordError(err)
+			logger.WithFields(logrus.Fields{
+				"error": fmt.Sprintf("error handling centrifugo: %s", err.Error()),
 			}).Error()
 		}
 	}()
@@ -119,10 +116,8 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 					break
 				}
 
-				h.logger.WithFields(logrus.Fields{
-					"error":      fmt.Sprintf("error writing message: %s", err.Error()),
-					"path":       path,
-					"request-id": requestId,
+				logger.WithFields(logrus.Fields{
+					"error": fmt.Sprintf("error writing message: %s", err.Error()),
 				}).Error()
 				continue
 			}
@@ -138,10 +133,8 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 					break
 				}
 
-				h.logger.WithFields(logrus.Fields{
-					"error":      fmt.Sprintf("error reading message: %s", err.Error()),
-					"path":       path,
-					"request-id": requestId,
+				logger.WithFields(logrus.Fields{
+					"error": fmt.Sprintf("error reading message: %s", err.Error()),
 				}).Warn()
 				continue
 			}
@@ -151,24 +144,20 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 
 				err := json.Unmarshal(message, &wsMsg)
 				if err != nil {
-					h.logger.WithFields(logrus.Fields{
-						"error":      fmt.Sprintf("error unmarshal message: %s", err.Error()),
-						"path":       path,
-						"request-id": requestId,
+					logger.WithFields(logrus.Fields{
+						"error": fmt.Sprintf("error unmarshal message: %s", err.Error()),
 					}).Warn()
 				}
 
-				if wsMsg.Type == "auth" {
+				if wsMsg.Type == appconstant.WsMessageTypeAuth {
 					var authData dto.AuthWsData
 
 					marshaled, _ := json.Marshal(wsMsg.Data)
 
 					err := json.Unmarshal(marshaled, &authData)
 					if err != nil {
-						h.logger.WithFields(logrus.Fields{
-							"error":      fmt.Sprintf("error unmarshal auth data: %s", err.Error()),
-							"path":       path,
-							"request-id": requestId,
+						logger.WithFields(logrus.Fields{
+							"error": fmt.Sprintf("error unmarshal auth data: %s", err.Error()),
 						}).Warn()
 					}
 
@@ -179,20 +168,17 @@ func (h *WsHandler) ConnectToRoom(ctx *gin.Context) {
 
 				mutex.Lock()
 				if !isAuthenticated {
-					h.logger.WithFields(logrus.Fields{
-						"error":      "request unauthenticated",
-						"path":       path,
-						"request-id": requestId,
+					logger.WithFields(logrus.Fields{
+						"error": "request unauthenticated",
 					}).Warn()
 					chClose <- true
 					break
 				}
 				mutex.Unlock()
 
-				if wsMsg.Type == "chat" {
-					wsChatData, _ := json.Marshal(wsMsg.Data)
-
-					fromClient <- wsChatData
+				switch wsMsg.Type {
+				case appconstant.WsMessageTypeChat, appconstant.WsMessageTypeTyping, appconstant.WsMessageTypeDelivered, appconstant.WsMessageTypeRead:
+					fromClient <- message
 				}
 
 			}
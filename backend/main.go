@@ -1,9 +1,17 @@
 package main
 
 import (
+	"os"
+
+	"max-health/appconstant"
 	"max-health/server"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == appconstant.MigrateCommand {
+		server.Migrate(os.Args[2:])
+		return
+	}
+
 	server.Init()
 }
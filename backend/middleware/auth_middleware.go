@@ -1,18 +1,30 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
 	"max-health/appconstant"
-	"max-health/config"
 	"max-health/dto"
+	"max-health/entity"
 	"max-health/util"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func AuthMiddleware(tokenAuth util.TokenAuthentication, config *config.Config) gin.HandlerFunc {
+type SessionValidator interface {
+	IsSessionActive(ctx context.Context, sessionId string) (bool, error)
+}
+
+type PermissionChecker interface {
+	GetRoleByName(ctx context.Context, roleName string) (*entity.Role, error)
+}
+
+func AuthMiddleware(tokenAuth util.TokenAuthentication, sessionValidator SessionValidator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.Request.Header.Get(appconstant.AuthorizationHeader)
 		t := strings.Split(authHeader, " ")
@@ -24,73 +36,72 @@ func AuthMiddleware(tokenAuth util.TokenAuthentication, config *config.Config) g
 
 		authToken := t[1]
 
-		claims, err := tokenAuth.ParseAndVerify(authToken, config.AccessSecret)
+		claims, err := tokenAuth.ParseAndVerify(authToken, appconstant.AccessTokenAudience)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
+			return
+		}
+
+		isActive, err := sessionValidator.IsSessionActive(c.Request.Context(), claims.SessionId)
 		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		if !isActive {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
 			return
 		}
 
 		c.Set(appconstant.AccountId, claims.AccountId)
 		c.Set(appconstant.Role, claims.Role)
+		c.Set(appconstant.SessionId, claims.SessionId)
+		c.Set(appconstant.TwoFactorVerified, claims.TwoFactor)
+		c.Request = c.Request.WithContext(util.ContextWithAuditActor(c.Request.Context(), entity.AuditActor{
+			AccountId: claims.AccountId,
+			Role:      claims.Role,
+			RequestId: c.GetString(appconstant.RequestId),
+			IpAddress: c.ClientIP(),
+		}))
+		c.Request = c.Request.WithContext(util.ContextWithLogFields(c.Request.Context(), logrus.Fields{
+			appconstant.LogFieldAccountId: claims.AccountId,
+			appconstant.LogFieldRole:      claims.Role,
+		}))
+		trace.SpanFromContext(c.Request.Context()).SetAttributes(
+			attribute.Int64(appconstant.TracingAttributeAccountId, claims.AccountId),
+			attribute.String(appconstant.TracingAttributeRole, claims.Role),
+		)
 		c.Next()
 	}
 }
 
-func UserAuthorizationMiddleware(c *gin.Context) {
-	role, exists := c.Get(appconstant.Role)
-	if exists && role == appconstant.UserRoleName {
-		c.Next()
-		return
-	}
-	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
-}
-
-func DoctorAuthorizationMiddleware(c *gin.Context) {
-	role, exists := c.Get(appconstant.Role)
-	if exists && role == appconstant.DoctorRoleName {
-		c.Next()
-		return
-	}
-	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
-}
-
-func PharmacyManagerAuthorizationMiddleware(c *gin.Context) {
-	role, exists := c.Get(appconstant.Role)
-	if exists && role == appconstant.PharmacyManagerRoleName {
-		c.Next()
-		return
-	}
-	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
-}
-
-func AdminAuthorizationMiddleware(c *gin.Context) {
-	role, exists := c.Get(appconstant.Role)
-	if exists && role == appconstant.AdminRoleName {
-		c.Next()
-		return
-	}
-	c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
-}
+func RequirePermission(permissionChecker PermissionChecker, permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		roleName := c.GetString(appconstant.Role)
 
-func PersonalAuthMiddleware(config *config.Config) func(ctx *gin.Context) {
-	return func(ctx *gin.Context) {
-		secret := ctx.Request.Header.Get("secret")
-		if secret == "" {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"message": "unauthorized",
-				"detail": "personal password is not present",
-			})
+		role, err := permissionChecker.GetRoleByName(c.Request.Context(), roleName)
+		if err != nil {
+			c.Error(err)
+			c.Abort()
 			return
 		}
-	
-		if secret != config.PersonalPassword {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"message": "unauthorized",
-				"detail": "wrong password",
-			})
+		if role == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{Message: appconstant.MsgUnauthorized})
 			return
 		}
 
-		ctx.Next()
+		for _, permission := range permissions {
+			if !role.HasPermission(permission) {
+				c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{Message: appconstant.MsgForbiddenAction})
+				return
+			}
+		}
+
+		if role.IsTwoFactorRequired && !c.GetBool(appconstant.TwoFactorVerified) {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{Message: appconstant.MsgTwoFactorRequired})
+			return
+		}
+
+		c.Next()
 	}
-}
\ No newline at end of file
+}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 
 	"max-health/appconstant"
 	"max-health/apperror"
@@ -17,6 +18,10 @@ func ErrorHandlerMiddleware(c *gin.Context) {
 	if len(c.Errors) > 0 {
 		firstError := c.Errors[0].Err
 		if firstError != nil {
+			if appErr, ok := firstError.(*apperror.AppError); ok && appErr.RetryAfter > 0 {
+				c.Header(appconstant.RetryAfterHeader, strconv.Itoa(appErr.RetryAfter))
+			}
+
 			statusCode, errorResponse := checkError(firstError)
 			c.AbortWithStatusJSON(statusCode, errorResponse)
 		}
@@ -0,0 +1,15 @@
+package middleware
+
+import (
+	"max-health/appconstant"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+func LocaleMiddleware(c *gin.Context) {
+	locale := util.NormalizeEmailLocale(c.GetHeader(appconstant.AcceptLanguageHeader))
+	c.Request = c.Request.WithContext(util.ContextWithLocale(c.Request.Context(), locale))
+
+	c.Next()
+}
@@ -5,8 +5,8 @@ import (
 	"runtime/debug"
 	"time"
 
-	"max-health/appconstant"
 	"max-health/apperror"
+	"max-health/util"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -18,6 +18,8 @@ func Logger(log *logrus.Logger) func(c *gin.Context) {
 		path := c.Request.URL.Path
 		raw := c.Request.URL.RawQuery
 
+		c.Request = c.Request.WithContext(util.ContextWithLogger(c.Request.Context(), logrus.NewEntry(log)))
+
 		c.Next()
 
 		if raw != "" {
@@ -26,16 +28,10 @@ func Logger(log *logrus.Logger) func(c *gin.Context) {
 
 		statusCode := c.Writer.Status()
 
-		requestId, exist := c.Get(appconstant.RequestId)
-		if !exist {
-			requestId = ""
-		}
-
-		entry := log.WithFields(logrus.Fields{
+		entry := util.LoggerFromContext(c.Request.Context()).WithFields(logrus.Fields{
 			"latency":     time.Since(start),
 			"method":      c.Request.Method,
 			"path":        path,
-			"request_id":  requestId,
 			"status_code": statusCode,
 		})
 
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"max-health/appconstant"
+	"max-health/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MaintenanceChecker interface {
+	IsUnderMaintenance() (bool, string)
+}
+
+func MaintenanceMiddleware(maintenanceChecker MaintenanceChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		isUnderMaintenance, message := maintenanceChecker.IsUnderMaintenance()
+		if !isUnderMaintenance || strings.HasPrefix(c.Request.URL.Path, appconstant.MaintenanceExemptPath) {
+			c.Next()
+			return
+		}
+
+		if message == "" {
+			message = appconstant.MsgUnderMaintenance
+		}
+
+		c.Header(appconstant.RetryAfterHeader, appconstant.MaintenanceRetryAfterSeconds)
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, dto.ErrorResponse{Message: message})
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"time"
+
+	"max-health/appconstant"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+func MetricsMiddleware(metrics *util.HttpMetrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = appconstant.MetricsUnmatchedRoute
+		}
+
+		metrics.Observe(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
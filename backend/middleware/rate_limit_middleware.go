@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/util"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RateLimitMiddleware(store util.RateLimitStore, rule util.RateLimitRule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ipKey := fmt.Sprintf("%s:ip:%s", rule.Name, c.ClientIP())
+		if !checkRateLimit(c, store, ipKey, rule.IpLimit, rule.Window) {
+			return
+		}
+
+		identity, ok := getRateLimitIdentity(c, rule)
+		if !ok {
+			return
+		}
+		if identity != "" {
+			identityKey := fmt.Sprintf("%s:identity:%s", rule.Name, identity)
+			if !checkRateLimit(c, store, identityKey, rule.IdentityLimit, rule.Window) {
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+func checkRateLimit(c *gin.Context, store util.RateLimitStore, key string, limit int, window time.Duration) bool {
+	count, resetAt, err := store.Increment(c.Request.Context(), key, window)
+	if err != nil {
+		c.Error(apperror.InternalServerError(err))
+		c.Abort()
+		return false
+	}
+
+	if count > limit {
+		retryAfter := int(math.Ceil(time.Until(resetAt).Seconds()))
+		c.Error(apperror.TooManyRequestsError(retryAfter))
+		c.Abort()
+		return false
+	}
+
+	return true
+}
+
+func getRateLimitIdentity(c *gin.Context, rule util.RateLimitRule) (string, bool) {
+	if c.Request.Body == nil {
+		return "", true
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, appconstant.RateLimitMaxBodyBytes))
+	c.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.Error(apperror.RequestBodyTooLargeError())
+			c.Abort()
+			return "", false
+		}
+
+		return "", true
+	}
+
+	if rule.Identity != nil {
+		return rule.Identity(body), true
+	}
+
+	return credentialIdentity(body), true
+}
+
+func credentialIdentity(body []byte) string {
+	request := struct {
+		Email     string      `json:"email"`
+		AccountId json.Number `json:"account_id"`
+	}{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return ""
+	}
+
+	if request.Email != "" {
+		return strings.ToLower(strings.TrimSpace(request.Email))
+	}
+	if request.AccountId != "" {
+		return request.AccountId.String()
+	}
+
+	return ""
+}
+
+func TwoFactorChallengeIdentity(tokenAuth util.TokenAuthentication) func(body []byte) string {
+	return func(body []byte) string {
+		request := struct {
+			ChallengeToken string `json:"challenge_token"`
+		}{}
+		if err := json.Unmarshal(body, &request); err != nil || request.ChallengeToken == "" {
+			return ""
+		}
+
+		claims, err := tokenAuth.ParseAndVerify(request.ChallengeToken, appconstant.TwoFactorChallengeAudience)
+		if err != nil {
+			return ""
+		}
+
+		return strconv.FormatInt(claims.AccountId, 10)
+	}
+}
@@ -2,15 +2,23 @@ package middleware
 
 import (
 	"max-health/appconstant"
+	"max-health/util"
 
 	"github.com/gin-gonic/gin"
-
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func RequestIdHandlerMiddleware(c *gin.Context) {
 	uuid := uuid.NewString()
 	c.Set(appconstant.RequestId, uuid)
 
+	trace.SpanFromContext(c.Request.Context()).SetAttributes(attribute.String(appconstant.TracingAttributeRequestId, uuid))
+	c.Request = c.Request.WithContext(util.ContextWithLogFields(c.Request.Context(), logrus.Fields{
+		appconstant.LogFieldRequestId: uuid,
+	}))
+
 	c.Next()
 }
@@ -18,6 +18,10 @@ type AccountRepository interface {
 	UpdateDataOne(ctx context.Context, account *entity.Account) error
 	UpdateNameAndProfilePictureOne(ctx context.Context, account *entity.Account) error
 	DeleteOneById(ctx context.Context, accountId int64) error
+	FindLockRemainingSecondsById(ctx context.Context, accountId int64) (int, error)
+	RecordFailedLogin(ctx context.Context, accountId int64, maxAttempts int, baseLockSeconds int, maxLockSeconds int) (*entity.LoginAttempt, error)
+	ResetFailedLogins(ctx context.Context, accountId int64) error
+	VerifyOne(ctx context.Context, accountId int64) error
 }
 
 type accountRepositoryPostgres struct {
@@ -133,3 +137,47 @@ func (r *accountRepositoryPostgres) DeleteOneById(ctx context.Context, accountId
 	}
 	return nil
 }
+
+func (r *accountRepositoryPostgres) FindLockRemainingSecondsById(ctx context.Context, accountId int64) (int, error) {
+	var remainingSeconds int
+
+	err := r.db.QueryRowContext(ctx, database.FindAccountLockRemainingSecondsQuery, accountId).Scan(&remainingSeconds)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return remainingSeconds, nil
+}
+
+func (r *accountRepositoryPostgres) RecordFailedLogin(ctx context.Context, accountId int64, maxAttempts int, baseLockSeconds int, maxLockSeconds int) (*entity.LoginAttempt, error) {
+	var loginAttempt entity.LoginAttempt
+
+	err := r.db.QueryRowContext(ctx, database.RecordFailedLoginQuery, accountId, maxAttempts, baseLockSeconds, maxLockSeconds).Scan(&loginAttempt.FailedAttempts, &loginAttempt.LockedFor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &loginAttempt, nil
+}
+
+func (r *accountRepositoryPostgres) ResetFailedLogins(ctx context.Context, accountId int64) error {
+	_, err := r.db.ExecContext(ctx, database.ResetFailedLoginsQuery, accountId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountRepositoryPostgres) VerifyOne(ctx context.Context, accountId int64) error {
+	_, err := r.db.ExecContext(ctx, database.VerifyAccountQuery, accountId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type AccountSessionRepository interface {
+	CreateSession(ctx context.Context, session entity.AccountSession) error
+	FindActiveSession(ctx context.Context, sessionId string) (*entity.AccountSession, error)
+	GetActiveSessionsByAccountId(ctx context.Context, accountId int64) ([]entity.AccountSession, error)
+	RefreshSession(ctx context.Context, sessionId string) error
+	RevokeSession(ctx context.Context, sessionId string, reason string) error
+	RevokeSessionByAccountId(ctx context.Context, sessionId string, accountId int64, reason string) (bool, error)
+	RevokeAllSessions(ctx context.Context, accountId int64, reason string) error
+}
+
+type accountSessionRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewAccountSessionRepositoryPostgres(db *sql.DB) accountSessionRepositoryPostgres {
+	return accountSessionRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *accountSessionRepositoryPostgres) CreateSession(ctx context.Context, session entity.AccountSession) error {
+	_, err := r.db.ExecContext(ctx, database.CreateAccountSessionQuery, session.SessionId, session.AccountId, session.UserAgent, session.IpAddress)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountSessionRepositoryPostgres) FindActiveSession(ctx context.Context, sessionId string) (*entity.AccountSession, error) {
+	session, err := scanAccountSession(r.db.QueryRowContext(ctx, database.FindActiveAccountSessionQuery, sessionId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (r *accountSessionRepositoryPostgres) GetActiveSessionsByAccountId(ctx context.Context, accountId int64) ([]entity.AccountSession, error) {
+	sessions := []entity.AccountSession{}
+
+	rows, err := r.db.QueryContext(ctx, database.GetActiveAccountSessionsByAccountIdQuery, accountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		session, err := scanAccountSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, *session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *accountSessionRepositoryPostgres) RefreshSession(ctx context.Context, sessionId string) error {
+	_, err := r.db.ExecContext(ctx, database.RefreshAccountSessionQuery, sessionId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountSessionRepositoryPostgres) RevokeSession(ctx context.Context, sessionId string, reason string) error {
+	_, err := r.db.ExecContext(ctx, database.RevokeAccountSessionQuery, sessionId, reason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *accountSessionRepositoryPostgres) RevokeSessionByAccountId(ctx context.Context, sessionId string, accountId int64, reason string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, database.RevokeAccountSessionByAccountIdQuery, sessionId, accountId, reason)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *accountSessionRepositoryPostgres) RevokeAllSessions(ctx context.Context, accountId int64, reason string) error {
+	_, err := r.db.ExecContext(ctx, database.RevokeAllAccountSessionsQuery, accountId, reason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanAccountSession(row interface{ Scan(dest ...any) error }) (*entity.AccountSession, error) {
+	session := entity.AccountSession{}
+	err := row.Scan(
+		&session.SessionId,
+		&session.AccountId,
+		&session.UserAgent,
+		&session.IpAddress,
+		&session.LastUsedAt,
+		&session.ExpiredAt,
+		&session.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
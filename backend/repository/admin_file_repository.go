@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type AdminFileRepository interface {
+	CreateOne(ctx context.Context, adminFile *entity.AdminFile) error
+	FindAll(ctx context.Context, limit, offset int) ([]entity.AdminFile, error)
+	FindAllTotalItem(ctx context.Context) (int, error)
+	FindOneById(ctx context.Context, adminFileId int64) (*entity.AdminFile, error)
+	DeleteOneById(ctx context.Context, adminFileId int64) error
+}
+
+type adminFileRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewAdminFileRepositoryPostgres(db *sql.DB) adminFileRepositoryPostgres {
+	return adminFileRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *adminFileRepositoryPostgres) CreateOne(ctx context.Context, adminFile *entity.AdminFile) error {
+	err := r.db.QueryRowContext(ctx, database.CreateAdminFileQuery,
+		adminFile.PublicId,
+		adminFile.Url,
+		adminFile.Name,
+		adminFile.ContentType,
+		adminFile.Size,
+		adminFile.UploadedBy,
+	).Scan(&adminFile.Id, &adminFile.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminFileRepositoryPostgres) FindAll(ctx context.Context, limit, offset int) ([]entity.AdminFile, error) {
+	rows, err := r.db.QueryContext(ctx, database.FindAllAdminFilesQuery, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	adminFiles := []entity.AdminFile{}
+
+	for rows.Next() {
+		var adminFile entity.AdminFile
+
+		err := rows.Scan(&adminFile.Id, &adminFile.PublicId, &adminFile.Url, &adminFile.Name, &adminFile.ContentType, &adminFile.Size, &adminFile.UploadedBy, &adminFile.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		adminFiles = append(adminFiles, adminFile)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return adminFiles, nil
+}
+
+func (r *adminFileRepositoryPostgres) FindAllTotalItem(ctx context.Context) (int, error) {
+	var totalItem int
+
+	if err := r.db.QueryRowContext(ctx, database.FindAllAdminFilesTotalItemQuery).Scan(&totalItem); err != nil {
+		return 0, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *adminFileRepositoryPostgres) FindOneById(ctx context.Context, adminFileId int64) (*entity.AdminFile, error) {
+	var adminFile entity.AdminFile
+
+	err := r.db.QueryRowContext(ctx, database.FindOneAdminFileByIdQuery, adminFileId).
+		Scan(&adminFile.Id, &adminFile.PublicId, &adminFile.Url, &adminFile.Name, &adminFile.ContentType, &adminFile.Size, &adminFile.UploadedBy, &adminFile.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &adminFile, nil
+}
+
+func (r *adminFileRepositoryPostgres) DeleteOneById(ctx context.Context, adminFileId int64) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteOneAdminFileByIdQuery, adminFileId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
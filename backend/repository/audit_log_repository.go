@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+
+	"max-health/database"
+	"max-health/entity"
+	"max-health/util"
+)
+
+type AuditLogRepository interface {
+	CreateOne(ctx context.Context, auditLog entity.AuditLog) error
+	FindAll(ctx context.Context, query util.ValidatedGetAuditLogQuery) ([]entity.AuditLog, *entity.PageInfo, error)
+}
+
+type auditLogRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewAuditLogRepositoryPostgres(db *sql.DB) auditLogRepositoryPostgres {
+	return auditLogRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *auditLogRepositoryPostgres) CreateOne(ctx context.Context, auditLog entity.AuditLog) error {
+	_, err := r.db.ExecContext(ctx, database.CreateAuditLogQuery,
+		auditLog.ActorAccountId,
+		auditLog.ActorRole,
+		auditLog.Action,
+		auditLog.EntityType,
+		auditLog.EntityId,
+		auditLog.Before,
+		auditLog.After,
+		auditLog.RequestId,
+		auditLog.IpAddress,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *auditLogRepositoryPostgres) FindAll(ctx context.Context, validatedQuery util.ValidatedGetAuditLogQuery) ([]entity.AuditLog, *entity.PageInfo, error) {
+	query := database.FindAllAuditLogsQuery
+	args := []interface{}{}
+
+	if validatedQuery.ActorAccountId != nil {
+		args = append(args, *validatedQuery.ActorAccountId)
+		query += ` AND actor_account_id = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.ActorRole != nil {
+		args = append(args, *validatedQuery.ActorRole)
+		query += ` AND actor_role = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.Action != nil {
+		args = append(args, *validatedQuery.Action)
+		query += ` AND action = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.EntityType != nil {
+		args = append(args, *validatedQuery.EntityType)
+		query += ` AND entity_type = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.EntityId != nil {
+		args = append(args, *validatedQuery.EntityId)
+		query += ` AND entity_id = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.RequestId != nil {
+		args = append(args, *validatedQuery.RequestId)
+		query += ` AND request_id = $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.MinDate != nil {
+		args = append(args, *validatedQuery.MinDate)
+		query += ` AND created_at >= $` + strconv.Itoa(len(args))
+	}
+	if validatedQuery.MaxDate != nil {
+		args = append(args, *validatedQuery.MaxDate)
+		query += ` AND created_at <= $` + strconv.Itoa(len(args))
+	}
+
+	query += ` ORDER BY created_at DESC, audit_log_id DESC`
+
+	args = append(args, validatedQuery.Limit)
+	query += ` LIMIT $` + strconv.Itoa(len(args))
+	args = append(args, validatedQuery.Limit*(validatedQuery.Page-1))
+	query += ` OFFSET $` + strconv.Itoa(len(args))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	auditLogs := []entity.AuditLog{}
+	pageInfo := entity.PageInfo{Page: validatedQuery.Page}
+
+	for rows.Next() {
+		var auditLog entity.AuditLog
+
+		err := rows.Scan(
+			&auditLog.Id,
+			&auditLog.ActorAccountId,
+			&auditLog.ActorRole,
+			&auditLog.Action,
+			&auditLog.EntityType,
+			&auditLog.EntityId,
+			&auditLog.Before,
+			&auditLog.After,
+			&auditLog.RequestId,
+			&auditLog.IpAddress,
+			&auditLog.CreatedAt,
+			&pageInfo.ItemCount,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	pageInfo.PageCount = pageInfo.ItemCount / validatedQuery.Limit
+	if pageInfo.ItemCount%validatedQuery.Limit != 0 {
+		pageInfo.PageCount += 1
+	}
+
+	return auditLogs, &pageInfo, nil
+}
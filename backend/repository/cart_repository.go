@@ -12,13 +12,14 @@ import (
 )
 
 type CartRepository interface {
-	PostOneCart(ctx context.Context, accountID int64, pharmacyDrugId int64, quantity int) (*int64, error)
+	PostOneCart(ctx context.Context, accountID int64, pharmacyDrugId int64, quantity int, prescriptionId *int64) (*int64, error)
 	UpdateOneCart(ctx context.Context, accountID int64, cartItemID int64, quantity int) error
 	DeleteOneCart(ctx context.Context, accountID int64, cartItemID int64) error
 	GetAllCart(ctx context.Context, accountID int64, Limit string, offset int) ([]entity.CartItemData, *entity.PageInfo, error)
 	GetPharmacyDeliveryFeeForCart(ctx context.Context, cartItemsId []int64, userAddressId int64) ([]entity.PharmacyDeliveryFee, error)
 	GetCartsByIds(ctx context.Context, cartItemsIds []int64) ([]entity.CartItem, error)
 	GetStockByCartId(ctx context.Context, cartItemId int64) (*int, error)
+	GetCartPrescription(ctx context.Context, cartItemId int64) (*entity.CartItemPrescription, error)
 	GetAllCartDetailByIds(ctx context.Context, cartItemIds []int64) ([]entity.CartItemForCheckout, error)
 	GetAllCartsForChangesByCartIds(ctx context.Context, cartItems []entity.CartItemForCheckout) ([]entity.CartItemChanges, error)
 	DeleteCarts(ctx context.Context, cartItems []entity.CartItemForCheckout) error
@@ -34,7 +35,7 @@ func NewCartRepositoryPostgres(db *sql.DB) cartRepositoryPostgres {
 	}
 }
 
-func (r *cartRepositoryPostgres) PostOneCart(ctx context.Context, accountID int64, pharmacyDrugId int64, quantity int) (*int64, error) {
+func (r *cartRepositoryPostgres) PostOneCart(ctx context.Context, accountID int64, pharmacyDrugId int64, quantity int, prescriptionId *int64) (*int64, error) {
 	var userID string
 	err := r.db.QueryRowContext(ctx, database.CheckUserQuery, accountID).Scan(&userID)
 	if err != nil {
@@ -45,7 +46,7 @@ func (r *cartRepositoryPostgres) PostOneCart(ctx context.Context, accountID int6
 	}
 
 	var cartItemId *int64
-	err = r.db.QueryRowContext(ctx, database.PostOneCartQuery, userID, pharmacyDrugId, quantity).Scan(&cartItemId)
+	err = r.db.QueryRowContext(ctx, database.PostOneCartQuery, userID, pharmacyDrugId, quantity, prescriptionId).Scan(&cartItemId)
 	if err != nil {
 		return nil, err
 	}
@@ -219,7 +220,7 @@ func (r *cartRepositoryPostgres) GetPharmacyDeliveryFeeForCart(ctx context.Conte
 			courier.CourierOptions = append(courier.CourierOptions, courierOption)
 		} else {
 			if origin != nil && destination != nil {
-				options, err := util.GetUnofficialDelivery(int64(*origin), int64(*destination), int64(weight), courier.CourierName)
+				options, err := util.GetUnofficialDelivery(ctx, int64(*origin), int64(*destination), int64(weight), courier.CourierName)
 				if err != nil {
 					return nil, err
 				}
@@ -287,6 +288,23 @@ func (r *cartRepositoryPostgres) GetCartsByIds(ctx context.Context, cartItemsIds
 	return carts, nil
 }
 
+func (r *cartRepositoryPostgres) GetCartPrescription(ctx context.Context, cartItemId int64) (*entity.CartItemPrescription, error) {
+	var cartPrescription entity.CartItemPrescription
+
+	err := r.db.QueryRowContext(ctx, database.GetCartPrescriptionQuery, cartItemId).Scan(&cartPrescription.PharmacyDrugId, &cartPrescription.PrescriptionId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	cartPrescription.CartItemId = cartItemId
+
+	return &cartPrescription, nil
+}
+
 func (r *cartRepositoryPostgres) GetAllCartDetailByIds(ctx context.Context, cartItemIds []int64) ([]entity.CartItemForCheckout, error) {
 	query := database.GetAllDetailedCartItems
 	cartItems := []entity.CartItemForCheckout{}
@@ -311,7 +329,7 @@ func (r *cartRepositoryPostgres) GetAllCartDetailByIds(ctx context.Context, cart
 
 	for rows.Next() {
 		cartItem := entity.CartItemForCheckout{}
-		err := rows.Scan(&cartItem.Id, &cartItem.DrugId, &cartItem.DrugName, &cartItem.PharmacyDrugId, &cartItem.Price, &cartItem.Unit, &cartItem.Quantity)
+		err := rows.Scan(&cartItem.Id, &cartItem.DrugId, &cartItem.DrugName, &cartItem.PharmacyDrugId, &cartItem.Price, &cartItem.Unit, &cartItem.Quantity, &cartItem.PrescriptionId, &cartItem.IsPrescriptionRequired)
 		if err != nil {
 			return []entity.CartItemForCheckout{}, err
 		}
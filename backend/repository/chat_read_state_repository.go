@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+)
+
+type ChatReadStateRepository interface {
+	UpdateLastDeliveredChat(ctx context.Context, roomId, accountId, chatId int64) error
+	UpdateLastReadChat(ctx context.Context, roomId, accountId, chatId int64) error
+}
+
+type chatReadStateRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewChatReadStateRepositoryPostgres(db *sql.DB) chatReadStateRepositoryPostgres {
+	return chatReadStateRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *chatReadStateRepositoryPostgres) UpdateLastDeliveredChat(ctx context.Context, roomId, accountId, chatId int64) error {
+	_, err := r.db.ExecContext(ctx, database.UpsertLastDeliveredChatQuery, roomId, accountId, chatId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *chatReadStateRepositoryPostgres) UpdateLastReadChat(ctx context.Context, roomId, accountId, chatId int64) error {
+	_, err := r.db.ExecContext(ctx, database.UpsertLastReadChatQuery, roomId, accountId, chatId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"max-health/database"
 	"max-health/entity"
@@ -10,7 +11,8 @@ import (
 
 type ChatRepository interface {
 	PostOneChat(ctx context.Context, chatRequest entity.Chat) (*int64, string, error)
-	GetAllChat(ctx context.Context, roomId int64) ([]entity.Chat, error)
+	GetChatsAfterId(ctx context.Context, roomId, lastChatId int64, limit int) ([]entity.Chat, error)
+	GetChatHistory(ctx context.Context, roomId int64, beforeChatId *int64, limit int) ([]entity.Chat, error)
 }
 
 type chatRepositoryPostgres struct {
@@ -26,8 +28,13 @@ func NewChatRepositoryPostgres(db *sql.DB) chatRepositoryPostgres {
 func (r *chatRepositoryPostgres) PostOneChat(ctx context.Context, chatRequest entity.Chat) (*int64, string, error) {
 	var chatId int64
 	var createdAt string
+	var sickLeaveId *int64
 
-	err := r.db.QueryRowContext(ctx, database.PostOneChatQuery, chatRequest.RoomId, chatRequest.SenderAccountId, chatRequest.Message, chatRequest.Attachment.Format, chatRequest.Attachment.Url, chatRequest.Prescription.Id).Scan(&chatId, &createdAt)
+	if chatRequest.SickLeave != nil {
+		sickLeaveId = &chatRequest.SickLeave.Id
+	}
+
+	err := r.db.QueryRowContext(ctx, database.PostOneChatQuery, chatRequest.RoomId, chatRequest.SenderAccountId, chatRequest.Message, chatRequest.Attachment.Format, chatRequest.Attachment.Url, chatRequest.Prescription.Id, sickLeaveId).Scan(&chatId, &createdAt)
 	if err != nil {
 		return nil, "", err
 	}
@@ -35,17 +42,44 @@ func (r *chatRepositoryPostgres) PostOneChat(ctx context.Context, chatRequest en
 	return &chatId, createdAt, err
 }
 
-func (r *chatRepositoryPostgres) GetAllChat(ctx context.Context, roomId int64) ([]entity.Chat, error) {
-	rows, err := r.db.QueryContext(ctx, database.GetAllChatQuery, roomId)
+func (r *chatRepositoryPostgres) GetChatsAfterId(ctx context.Context, roomId, lastChatId int64, limit int) ([]entity.Chat, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetChatsAfterIdQuery, roomId, lastChatId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanChats(rows, roomId)
+}
+
+func (r *chatRepositoryPostgres) GetChatHistory(ctx context.Context, roomId int64, beforeChatId *int64, limit int) ([]entity.Chat, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetChatHistoryQuery, roomId, beforeChatId, limit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
+	chatList, err := scanChats(rows, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, j := 0, len(chatList)-1; i < j; i, j = i+1, j-1 {
+		chatList[i], chatList[j] = chatList[j], chatList[i]
+	}
+
+	return chatList, nil
+}
+
+func scanChats(rows *sql.Rows, roomId int64) ([]entity.Chat, error) {
 	var chatList []entity.Chat
 
 	for rows.Next() {
 		var chat entity.Chat
+		var sickLeaveId *int64
+		var sickLeaveStartDate, sickLeaveIssuedAt *time.Time
+		var sickLeaveDays *int
+		var sickLeaveDiagnosisSummary, sickLeaveVerificationCode *string
 
 		err := rows.Scan(
 			&chat.Id,
@@ -55,6 +89,12 @@ func (r *chatRepositoryPostgres) GetAllChat(ctx context.Context, roomId int64) (
 			&chat.Attachment.Url,
 			&chat.Prescription.Id,
 			&chat.Prescription.RedeemedAt,
+			&sickLeaveId,
+			&sickLeaveStartDate,
+			&sickLeaveDays,
+			&sickLeaveDiagnosisSummary,
+			&sickLeaveVerificationCode,
+			&sickLeaveIssuedAt,
 			&chat.CreatedAt,
 		)
 		if err != nil {
@@ -63,10 +103,22 @@ func (r *chatRepositoryPostgres) GetAllChat(ctx context.Context, roomId int64) (
 
 		chat.RoomId = roomId
 
+		if sickLeaveId != nil && sickLeaveStartDate != nil && sickLeaveDays != nil && sickLeaveDiagnosisSummary != nil && sickLeaveVerificationCode != nil && sickLeaveIssuedAt != nil {
+			chat.SickLeave = &entity.SickLeaveCertificate{
+				Id:               *sickLeaveId,
+				WsChatRoomId:     roomId,
+				StartDate:        *sickLeaveStartDate,
+				Days:             *sickLeaveDays,
+				DiagnosisSummary: *sickLeaveDiagnosisSummary,
+				VerificationCode: *sickLeaveVerificationCode,
+				IssuedAt:         *sickLeaveIssuedAt,
+			}
+		}
+
 		chatList = append(chatList, chat)
 	}
 
-	err = rows.Err()
+	err := rows.Err()
 	if err != nil {
 		return nil, err
 	}
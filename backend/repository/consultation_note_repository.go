@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type ConsultationNoteRepository interface {
+	UpsertConsultationNote(ctx context.Context, consultationNote entity.ConsultationNote) (int64, error)
+	FindConsultationNoteById(ctx context.Context, consultationNoteId int64) (*entity.ConsultationNote, error)
+	FindConsultationNoteByRoomId(ctx context.Context, roomId int64) (*entity.ConsultationNote, error)
+	GetConsultationNotesByUserAccountId(ctx context.Context, userAccountId int64) ([]entity.ConsultationNote, error)
+	GetConsultationDiagnosesByNoteId(ctx context.Context, consultationNoteId int64) ([]entity.ConsultationDiagnosis, error)
+	ReplaceConsultationDiagnoses(ctx context.Context, consultationNoteId int64, diagnoses []entity.ConsultationDiagnosis) error
+	GetMedicalHistoryByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.MedicalHistoryEntry, error)
+	GetMedicalHistoryByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error)
+}
+
+type consultationNoteRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewConsultationNoteRepositoryPostgres(db *sql.DB) consultationNoteRepositoryPostgres {
+	return consultationNoteRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *consultationNoteRepositoryPostgres) UpsertConsultationNote(ctx context.Context, consultationNote entity.ConsultationNote) (int64, error) {
+	var consultationNoteId int64
+
+	err := r.db.QueryRowContext(ctx, database.UpsertConsultationNoteQuery,
+		consultationNote.WsChatRoomId,
+		consultationNote.UserAccountId,
+		consultationNote.DoctorAccountId,
+		consultationNote.Complaint,
+		consultationNote.Assessment,
+		consultationNote.Plan,
+	).Scan(&consultationNoteId)
+	if err != nil {
+		return 0, err
+	}
+
+	return consultationNoteId, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) FindConsultationNoteById(ctx context.Context, consultationNoteId int64) (*entity.ConsultationNote, error) {
+	consultationNote, err := scanConsultationNote(r.db.QueryRowContext(ctx, database.FindConsultationNoteByIdQuery, consultationNoteId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return consultationNote, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) FindConsultationNoteByRoomId(ctx context.Context, roomId int64) (*entity.ConsultationNote, error) {
+	consultationNote, err := scanConsultationNote(r.db.QueryRowContext(ctx, database.FindConsultationNoteByRoomIdQuery, roomId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return consultationNote, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) GetConsultationNotesByUserAccountId(ctx context.Context, userAccountId int64) ([]entity.ConsultationNote, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetConsultationNotesByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var consultationNoteList []entity.ConsultationNote
+
+	for rows.Next() {
+		consultationNote, err := scanConsultationNote(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		consultationNoteList = append(consultationNoteList, *consultationNote)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return consultationNoteList, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) GetConsultationDiagnosesByNoteId(ctx context.Context, consultationNoteId int64) ([]entity.ConsultationDiagnosis, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetConsultationDiagnosesByNoteIdQuery, consultationNoteId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var diagnosisList []entity.ConsultationDiagnosis
+
+	for rows.Next() {
+		var diagnosis entity.ConsultationDiagnosis
+
+		err := rows.Scan(&diagnosis.Code, &diagnosis.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		diagnosisList = append(diagnosisList, diagnosis)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return diagnosisList, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) ReplaceConsultationDiagnoses(ctx context.Context, consultationNoteId int64, diagnoses []entity.ConsultationDiagnosis) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteConsultationDiagnosesByNoteIdQuery, consultationNoteId)
+	if err != nil {
+		return err
+	}
+
+	for _, diagnosis := range diagnoses {
+		_, err = r.db.ExecContext(ctx, database.CreateConsultationDiagnosisQuery, consultationNoteId, diagnosis.Code, diagnosis.Description)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *consultationNoteRepositoryPostgres) GetMedicalHistoryByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.MedicalHistoryEntry, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetMedicalHistoryByUserAccountIdQuery, userAccountId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entryList []entity.MedicalHistoryEntry
+
+	for rows.Next() {
+		var entry entity.MedicalHistoryEntry
+
+		err := rows.Scan(&entry.Type, &entry.Id, &entry.OccurredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		entryList = append(entryList, entry)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return entryList, nil
+}
+
+func (r *consultationNoteRepositoryPostgres) GetMedicalHistoryByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetMedicalHistoryByUserAccountIdTotalItemQuery, userAccountId).Scan(&totalItem)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalItem, nil
+}
+
+func scanConsultationNote(row interface{ Scan(dest ...any) error }) (*entity.ConsultationNote, error) {
+	var consultationNote entity.ConsultationNote
+
+	err := row.Scan(
+		&consultationNote.Id,
+		&consultationNote.WsChatRoomId,
+		&consultationNote.UserAccountId,
+		&consultationNote.DoctorAccountId,
+		&consultationNote.DoctorName,
+		&consultationNote.Complaint,
+		&consultationNote.Assessment,
+		&consultationNote.Plan,
+		&consultationNote.CreatedAt,
+		&consultationNote.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &consultationNote, nil
+}
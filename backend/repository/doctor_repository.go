@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"math"
 
+	"max-health/appconstant"
 	"max-health/database"
 	"max-health/entity"
 )
@@ -13,11 +14,15 @@ type DoctorRepository interface {
 	PostOneDoctor(ctx context.Context, accountId int, specializationId int64, certificateName string) error
 	UpdateDataOne(ctx context.Context, doctor *entity.DetailedDoctor) error
 	FindSpecializationById(ctx context.Context, specializationId int64) (*string, error)
-	GetAllDoctor(ctx context.Context, sort []string, sortBy []string, limit int, offset int, specialization_id string) ([]entity.Doctor, *entity.PageInfo, error)
+	GetAllDoctor(ctx context.Context, sort []string, sortBy []string, limit int, offset int, specialization_id string, minRating float64) ([]entity.Doctor, *entity.PageInfo, error)
 	FindDoctorByAccountId(ctx context.Context, accountId int64) (*entity.Doctor, error)
 	FindDoctorByDoctorId(ctx context.Context, doctorId int64) (*entity.DetailedDoctor, error)
 	UpdateDoctorStatus(ctx context.Context, doctorAccountId int64, isOnline bool) error
 	GetDoctorIsOnline(ctx context.Context, doctorAccountId int64) (*bool, error)
+	GetAllDoctorVerificationByStatus(ctx context.Context, status string, limit, offset int) ([]entity.DoctorVerification, error)
+	GetAllDoctorVerificationByStatusTotalItem(ctx context.Context, status string) (int, error)
+	FindDoctorVerificationByDoctorId(ctx context.Context, doctorId int64) (*entity.DoctorVerification, error)
+	UpdateDoctorVerification(ctx context.Context, doctorId int64, status string, rejectionReason *string) error
 }
 
 type doctorRepositoryPostgres struct {
@@ -31,7 +36,7 @@ func NewDoctorRepositoryPostgres(db *sql.DB) doctorRepositoryPostgres {
 }
 
 func (r *doctorRepositoryPostgres) PostOneDoctor(ctx context.Context, accountId int, specializationId int64, certificateName string) error {
-	_, err := r.db.ExecContext(ctx, database.PostOneDoctorQuery, accountId, specializationId, certificateName)
+	_, err := r.db.ExecContext(ctx, database.PostOneDoctorQuery, accountId, specializationId, certificateName, appconstant.DoctorVerificationPending)
 	if err != nil {
 		return err
 	}
@@ -62,44 +67,42 @@ func (r *doctorRepositoryPostgres) FindSpecializationById(ctx context.Context, s
 	return &specializationName, nil
 }
 
-func (r *doctorRepositoryPostgres) GetAllDoctor(ctx context.Context, sort []string, sortBy []string, limit int, offset int, specialization_id string) ([]entity.Doctor, *entity.PageInfo, error) {
+func (r *doctorRepositoryPostgres) GetAllDoctor(ctx context.Context, sort []string, sortBy []string, limit int, offset int, specialization_id string, minRating float64) ([]entity.Doctor, *entity.PageInfo, error) {
 	doctors := []entity.Doctor{}
 	pageInfo := entity.PageInfo{}
 
 	var whereClause string
 	if specialization_id == "" {
-		whereClause = "WHERE d.deleted_at IS NULL AND a.verified_at IS NOT NULL"
+		whereClause = "WHERE d.deleted_at IS NULL AND a.verified_at IS NOT NULL AND d.verification_status = 'approved'"
 	} else {
-		whereClause = "where d.deleted_at IS NULL AND a.verified_at IS NOT NULL AND d.specialization_id = " + specialization_id
+		whereClause = "where d.deleted_at IS NULL AND a.verified_at IS NOT NULL AND d.verification_status = 'approved' AND d.specialization_id = " + specialization_id
 	}
 
-	orderByClause := "ORDER BY d.doctor_id ASC, d.is_online DESC"
+	whereClause += " AND COALESCE(dr.average_rating, 0) >= $1"
+
+	orderByClause := "ORDER BY"
 
 	if sort[0] != "" {
 		for i := 0; i < len(sort); i++ {
-			if i == 0 {
-				orderByClause += ","
-			}
-
-			orderByClause += " " + sortBy[i] + " " + sort[i]
-
-			if i < len(sort)-1 {
-				orderByClause += ","
-			}
+			orderByClause += " " + sortBy[i] + " " + sort[i] + ","
 		}
 	}
 
+	orderByClause += " d.doctor_id ASC, d.is_online DESC"
+
 	queries := `
-		SELECT d.doctor_id, d.account_id, d.certificate, d.fee_per_patient, d.is_online, d.experience, d.specialization_id 
+		SELECT d.doctor_id, d.account_id, d.certificate, d.fee_per_patient, d.is_online, d.experience, d.specialization_id,
+		COALESCE(dr.average_rating, 0)::FLOAT8 AS rating, COALESCE(dr.review_count, 0) AS review_count
 		FROM doctors d
 		JOIN accounts a ON a.account_id = d.account_id
+		` + database.DoctorRatingJoin + `
 		` + whereClause + `
 		` + orderByClause + `
-		LIMIT $1
-		OFFSET $2
+		LIMIT $2
+		OFFSET $3
 	`
 
-	rows, err := r.db.QueryContext(ctx, queries, limit, offset)
+	rows, err := r.db.QueryContext(ctx, queries, minRating, limit, offset)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil, err
@@ -112,7 +115,8 @@ func (r *doctorRepositoryPostgres) GetAllDoctor(ctx context.Context, sort []stri
 	for rows.Next() {
 		doctor := entity.Doctor{}
 
-		err := rows.Scan(&doctor.Id, &doctor.AccountId, &doctor.Certificate, &doctor.FeePerPatient, &doctor.IsOnline, &doctor.Experience, &doctor.SpecializationId)
+		err := rows.Scan(&doctor.Id, &doctor.AccountId, &doctor.Certificate, &doctor.FeePerPatient, &doctor.IsOnline, &doctor.Experience, &doctor.SpecializationId,
+			&doctor.AverageRating, &doctor.ReviewCount)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -123,8 +127,9 @@ func (r *doctorRepositoryPostgres) GetAllDoctor(ctx context.Context, sort []stri
 		SELECT COUNT(*) 
 		FROM doctors d
 		JOIN accounts a ON a.account_id = d.account_id
+		` + database.DoctorRatingJoin + `
 	` + whereClause
-	countRow := r.db.QueryRowContext(ctx, countQuery)
+	countRow := r.db.QueryRowContext(ctx, countQuery, minRating)
 	if err := countRow.Scan(&pageInfo.ItemCount); err != nil {
 		return nil, nil, err
 	}
@@ -144,7 +149,8 @@ func (r *doctorRepositoryPostgres) FindDoctorByAccountId(ctx context.Context, ac
 	var doctor entity.Doctor
 
 	if err := r.db.QueryRowContext(ctx, database.FindDoctorByAccountIdQuery, accountId).Scan(&doctor.Id, &doctor.Experience, &doctor.SpecializationId,
-		&doctor.SpecializationName, &doctor.FeePerPatient, &doctor.Certificate); err != nil {
+		&doctor.SpecializationName, &doctor.FeePerPatient, &doctor.Certificate, &doctor.VerificationStatus, &doctor.RejectionReason,
+		&doctor.AverageRating, &doctor.ReviewCount); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -161,7 +167,7 @@ func (r *doctorRepositoryPostgres) FindDoctorByDoctorId(ctx context.Context, doc
 
 	if err := r.db.QueryRowContext(ctx, database.FindDoctorByDoctorIdQuery, doctorId).Scan(&doctor.Id, &doctor.Email,
 		&doctor.Name, &doctor.ProfilePicture, &doctor.Experience, &doctor.SpecializationId,
-		&doctor.SpecializationName, &doctor.FeePerPatient); err != nil {
+		&doctor.SpecializationName, &doctor.FeePerPatient, &doctor.AverageRating, &doctor.ReviewCount); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -189,3 +195,89 @@ func (r *doctorRepositoryPostgres) GetDoctorIsOnline(ctx context.Context, doctor
 
 	return &isOnline, nil
 }
+
+func (r *doctorRepositoryPostgres) GetAllDoctorVerificationByStatus(ctx context.Context, status string, limit, offset int) ([]entity.DoctorVerification, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllDoctorVerificationByStatusQuery, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doctorVerificationList := []entity.DoctorVerification{}
+
+	for rows.Next() {
+		doctorVerification, err := scanDoctorVerification(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		doctorVerificationList = append(doctorVerificationList, *doctorVerification)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return doctorVerificationList, nil
+}
+
+func (r *doctorRepositoryPostgres) GetAllDoctorVerificationByStatusTotalItem(ctx context.Context, status string) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetAllDoctorVerificationByStatusTotalItemQuery, status).Scan(&totalItem)
+	if err != nil {
+		return totalItem, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *doctorRepositoryPostgres) FindDoctorVerificationByDoctorId(ctx context.Context, doctorId int64) (*entity.DoctorVerification, error) {
+	doctorVerification, err := scanDoctorVerification(r.db.QueryRowContext(ctx, database.FindDoctorVerificationByDoctorIdQuery, doctorId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return doctorVerification, nil
+}
+
+func (r *doctorRepositoryPostgres) UpdateDoctorVerification(ctx context.Context, doctorId int64, status string, rejectionReason *string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateDoctorVerificationQuery, doctorId, status, rejectionReason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanDoctorVerification(row interface{ Scan(dest ...any) error }) (*entity.DoctorVerification, error) {
+	var doctorVerification entity.DoctorVerification
+	var specializationName *string
+
+	err := row.Scan(
+		&doctorVerification.DoctorId,
+		&doctorVerification.AccountId,
+		&doctorVerification.Name,
+		&doctorVerification.Email,
+		&specializationName,
+		&doctorVerification.Certificate,
+		&doctorVerification.Status,
+		&doctorVerification.RejectionReason,
+		&doctorVerification.ReviewedAt,
+		&doctorVerification.RegisteredAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if specializationName != nil {
+		doctorVerification.SpecializationName = *specializationName
+	}
+
+	return &doctorVerification, nil
+}
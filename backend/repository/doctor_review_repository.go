@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type DoctorReviewRepository interface {
+	CreateDoctorReview(ctx context.Context, doctorReview entity.DoctorReview) (*int64, error)
+	FindDoctorReviewById(ctx context.Context, doctorReviewId int64) (*entity.DoctorReview, error)
+	FindDoctorReviewByRoomId(ctx context.Context, roomId int64) (*entity.DoctorReview, error)
+	GetAllVisibleDoctorReviewByDoctorId(ctx context.Context, doctorId int64, limit, offset int) ([]entity.DoctorReview, error)
+	GetAllVisibleDoctorReviewByDoctorIdTotalItem(ctx context.Context, doctorId int64) (int, error)
+	GetAllDoctorReview(ctx context.Context, visibility string, limit, offset int) ([]entity.DoctorReview, error)
+	GetAllDoctorReviewTotalItem(ctx context.Context, visibility string) (int, error)
+	UpdateDoctorReviewVisibility(ctx context.Context, doctorReviewId int64, isHidden bool, hiddenReason *string) error
+}
+
+type doctorReviewRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewDoctorReviewRepositoryPostgres(db *sql.DB) doctorReviewRepositoryPostgres {
+	return doctorReviewRepositoryPostgres{
+		db: db,
+	}
+}
+
+func scanDoctorReview(row interface{ Scan(dest ...any) error }) (*entity.DoctorReview, error) {
+	var doctorReview entity.DoctorReview
+
+	err := row.Scan(&doctorReview.Id, &doctorReview.WsChatRoomId, &doctorReview.UserAccountId, &doctorReview.UserName,
+		&doctorReview.UserProfilePicture, &doctorReview.DoctorAccountId, &doctorReview.Rating, &doctorReview.Review,
+		&doctorReview.IsHidden, &doctorReview.HiddenReason, &doctorReview.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doctorReview, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) CreateDoctorReview(ctx context.Context, doctorReview entity.DoctorReview) (*int64, error) {
+	var doctorReviewId int64
+
+	err := r.db.QueryRowContext(ctx, database.CreateDoctorReviewQuery, doctorReview.WsChatRoomId, doctorReview.UserAccountId,
+		doctorReview.DoctorAccountId, doctorReview.Rating, doctorReview.Review).Scan(&doctorReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &doctorReviewId, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) FindDoctorReviewById(ctx context.Context, doctorReviewId int64) (*entity.DoctorReview, error) {
+	doctorReview, err := scanDoctorReview(r.db.QueryRowContext(ctx, database.FindDoctorReviewByIdQuery, doctorReviewId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doctorReview, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) FindDoctorReviewByRoomId(ctx context.Context, roomId int64) (*entity.DoctorReview, error) {
+	doctorReview, err := scanDoctorReview(r.db.QueryRowContext(ctx, database.FindDoctorReviewByRoomIdQuery, roomId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return doctorReview, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) GetAllVisibleDoctorReviewByDoctorId(ctx context.Context, doctorId int64, limit, offset int) ([]entity.DoctorReview, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllVisibleDoctorReviewByDoctorIdQuery, doctorId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDoctorReviewList(rows)
+}
+
+func (r *doctorReviewRepositoryPostgres) GetAllVisibleDoctorReviewByDoctorIdTotalItem(ctx context.Context, doctorId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetAllVisibleDoctorReviewByDoctorIdTotalItemQuery, doctorId).Scan(&totalItem)
+	if err != nil {
+		return totalItem, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) GetAllDoctorReview(ctx context.Context, visibility string, limit, offset int) ([]entity.DoctorReview, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllDoctorReviewQuery, visibility, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanDoctorReviewList(rows)
+}
+
+func (r *doctorReviewRepositoryPostgres) GetAllDoctorReviewTotalItem(ctx context.Context, visibility string) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetAllDoctorReviewTotalItemQuery, visibility).Scan(&totalItem)
+	if err != nil {
+		return totalItem, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *doctorReviewRepositoryPostgres) UpdateDoctorReviewVisibility(ctx context.Context, doctorReviewId int64, isHidden bool, hiddenReason *string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateDoctorReviewVisibilityQuery, isHidden, hiddenReason, doctorReviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanDoctorReviewList(rows *sql.Rows) ([]entity.DoctorReview, error) {
+	doctorReviewList := []entity.DoctorReview{}
+
+	for rows.Next() {
+		doctorReview, err := scanDoctorReview(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		doctorReviewList = append(doctorReviewList, *doctorReview)
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return doctorReviewList, nil
+}
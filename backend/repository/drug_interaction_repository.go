@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type DrugInteractionRepository interface {
+	FindDrugInteractions(ctx context.Context, genericNames []string) ([]entity.DrugInteraction, error)
+}
+
+type drugInteractionRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewDrugInteractionRepositoryPostgres(db *sql.DB) drugInteractionRepositoryPostgres {
+	return drugInteractionRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *drugInteractionRepositoryPostgres) FindDrugInteractions(ctx context.Context, genericNames []string) ([]entity.DrugInteraction, error) {
+	rows, err := r.db.QueryContext(ctx, database.FindDrugInteractionsQuery, genericNames)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interactionList []entity.DrugInteraction
+
+	for rows.Next() {
+		var interaction entity.DrugInteraction
+
+		err := rows.Scan(&interaction.Id, &interaction.GenericNameA, &interaction.GenericNameB, &interaction.Severity, &interaction.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		interactionList = append(interactionList, interaction)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return interactionList, nil
+}
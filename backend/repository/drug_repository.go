@@ -19,9 +19,10 @@ type DrugRepository interface {
 	GetDrugById(ctx context.Context, drugId int64) (*entity.DrugDetail, error)
 	GetAllDrugs(ctx context.Context, validatedGetProductAdminQuery util.ValidatedGetDrugAdminQuery) ([]entity.Drug, *entity.PageInfo, error)
 	UpdateOneDrug(ctx context.Context, drug entity.Drug) error
-	CreateOneDrug(ctx context.Context, drug entity.Drug) error
+	CreateOneDrug(ctx context.Context, drug entity.Drug) (int64, error)
 	DeleteOneDrug(ctx context.Context, drugId int64) error
 	GetDrugsByPharmacyId(ctx context.Context, pharmacyId int64, Limit string, offset int, search string) ([]entity.PharmacyDrugByPharmacyId, *entity.PageInfo, error)
+	GetPrescribableDrugsByIds(ctx context.Context, drugIds []int64) ([]entity.PrescribableDrug, error)
 }
 
 type drugRepositoryPostgres struct {
@@ -253,8 +254,10 @@ func (r *drugRepositoryPostgres) GetDrugIdByName(ctx context.Context, drugName s
 	return &drugId, nil
 }
 
-func (r *drugRepositoryPostgres) CreateOneDrug(ctx context.Context, drug entity.Drug) error {
-	_, err := r.db.ExecContext(ctx, database.CreateOneDrugQuery,
+func (r *drugRepositoryPostgres) CreateOneDrug(ctx context.Context, drug entity.Drug) (int64, error) {
+	var drugId int64
+
+	err := r.db.QueryRowContext(ctx, database.CreateOneDrugQuery,
 		drug.Name,
 		drug.GenericName,
 		drug.Content,
@@ -272,12 +275,12 @@ func (r *drugRepositoryPostgres) CreateOneDrug(ctx context.Context, drug entity.
 		drug.Image,
 		drug.IsPrescriptionRequired,
 		drug.IsActive,
-	)
+	).Scan(&drugId)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return drugId, nil
 }
 
 func (r *drugRepositoryPostgres) DeleteOneDrug(ctx context.Context, drugId int64) error {
@@ -361,3 +364,31 @@ func (r *drugRepositoryPostgres) GetDrugsByPharmacyId(ctx context.Context, pharm
 
 	return drugs, pageInfo, nil
 }
+
+func (r *drugRepositoryPostgres) GetPrescribableDrugsByIds(ctx context.Context, drugIds []int64) ([]entity.PrescribableDrug, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetPrescribableDrugsByIdsQuery, drugIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var drugList []entity.PrescribableDrug
+
+	for rows.Next() {
+		var drug entity.PrescribableDrug
+
+		err := rows.Scan(&drug.Id, &drug.Name, &drug.GenericName, &drug.IsActive, &drug.MaxPrescriptionQuantity)
+		if err != nil {
+			return nil, err
+		}
+
+		drugList = append(drugList, drug)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return drugList, nil
+}
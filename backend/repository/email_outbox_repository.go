@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type EmailOutboxRepository interface {
+	CreateOne(ctx context.Context, emailOutbox *entity.EmailOutbox) error
+	ClaimDue(ctx context.Context, leaseSeconds, limit int) ([]entity.EmailOutbox, error)
+	MarkSent(ctx context.Context, emailOutboxId int64) error
+	MarkFailed(ctx context.Context, emailOutboxId int64, lastError string, maxAttempts, baseBackoffSeconds, maxBackoffSeconds int, secretEmailTypes []string) (string, error)
+	FindAllByStatus(ctx context.Context, status string, limit, offset int) ([]entity.EmailOutbox, error)
+	FindAllByStatusTotalItem(ctx context.Context, status string) (int, error)
+	RequeueDead(ctx context.Context, emailOutboxId int64) (bool, error)
+}
+
+type emailOutboxRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewEmailOutboxRepositoryPostgres(db *sql.DB) emailOutboxRepositoryPostgres {
+	return emailOutboxRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *emailOutboxRepositoryPostgres) CreateOne(ctx context.Context, emailOutbox *entity.EmailOutbox) error {
+	err := r.db.QueryRowContext(ctx, database.CreateEmailOutboxQuery,
+		emailOutbox.EmailType,
+		emailOutbox.Locale,
+		emailOutbox.Recipient,
+		emailOutbox.TemplateData,
+	).Scan(&emailOutbox.Id, &emailOutbox.Status, &emailOutbox.NextAttemptAt, &emailOutbox.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailOutboxRepositoryPostgres) ClaimDue(ctx context.Context, leaseSeconds, limit int) ([]entity.EmailOutbox, error) {
+	rows, err := r.db.QueryContext(ctx, database.ClaimDueEmailOutboxQuery, leaseSeconds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := []entity.EmailOutbox{}
+
+	for rows.Next() {
+		var email entity.EmailOutbox
+
+		err := rows.Scan(&email.Id, &email.EmailType, &email.Locale, &email.Recipient, &email.TemplateData, &email.Status, &email.Attempts, &email.NextAttemptAt, &email.LastError, &email.SentAt, &email.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+func (r *emailOutboxRepositoryPostgres) MarkSent(ctx context.Context, emailOutboxId int64) error {
+	_, err := r.db.ExecContext(ctx, database.MarkEmailOutboxSentQuery, emailOutboxId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *emailOutboxRepositoryPostgres) MarkFailed(ctx context.Context, emailOutboxId int64, lastError string, maxAttempts, baseBackoffSeconds, maxBackoffSeconds int, secretEmailTypes []string) (string, error) {
+	var status string
+
+	err := r.db.QueryRowContext(ctx, database.MarkEmailOutboxFailedQuery, emailOutboxId, lastError, maxAttempts, baseBackoffSeconds, maxBackoffSeconds, secretEmailTypes).Scan(&status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return status, nil
+}
+
+func (r *emailOutboxRepositoryPostgres) FindAllByStatus(ctx context.Context, status string, limit, offset int) ([]entity.EmailOutbox, error) {
+	rows, err := r.db.QueryContext(ctx, database.FindAllEmailOutboxByStatusQuery, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emails := []entity.EmailOutbox{}
+
+	for rows.Next() {
+		var email entity.EmailOutbox
+
+		err := rows.Scan(&email.Id, &email.EmailType, &email.Locale, &email.Recipient, &email.Status, &email.Attempts, &email.NextAttemptAt, &email.LastError, &email.SentAt, &email.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		emails = append(emails, email)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+func (r *emailOutboxRepositoryPostgres) FindAllByStatusTotalItem(ctx context.Context, status string) (int, error) {
+	var totalItem int
+
+	if err := r.db.QueryRowContext(ctx, database.FindAllEmailOutboxByStatusTotalItemQuery, status).Scan(&totalItem); err != nil {
+		return 0, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *emailOutboxRepositoryPostgres) RequeueDead(ctx context.Context, emailOutboxId int64) (bool, error) {
+	result, err := r.db.ExecContext(ctx, database.RequeueDeadEmailOutboxQuery, emailOutboxId)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+)
+
+type MaintenanceRepository interface {
+	Ping(ctx context.Context) error
+	Stats() sql.DBStats
+	PurgeExpired(ctx context.Context, retentionHours int) (map[string]int64, error)
+}
+
+type maintenanceRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func NewMaintenanceRepositoryPostgres(db *sql.DB) maintenanceRepositoryPostgres {
+	return maintenanceRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *maintenanceRepositoryPostgres) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+func (r *maintenanceRepositoryPostgres) Stats() sql.DBStats {
+	return r.db.Stats()
+}
+
+func (r *maintenanceRepositoryPostgres) PurgeExpired(ctx context.Context, retentionHours int) (map[string]int64, error) {
+	queries := []struct {
+		table string
+		query string
+	}{
+		{table: "verification_codes", query: database.PurgeExpiredVerificationCodesQuery},
+		{table: "reset_password_tokens", query: database.PurgeExpiredResetPasswordTokensQuery},
+		{table: "refresh_tokens", query: database.PurgeExpiredRefreshTokensQuery},
+		{table: "oidc_login_states", query: database.PurgeExpiredOidcLoginStatesQuery},
+		{table: "email_outbox", query: database.PurgeSentEmailOutboxQuery},
+	}
+
+	purged := map[string]int64{}
+
+	for _, q := range queries {
+		result, err := r.db.ExecContext(ctx, q.query, retentionHours)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+
+		purged[q.table] = rowsAffected
+	}
+
+	return purged, nil
+}
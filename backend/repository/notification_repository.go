@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type NotificationRepository interface {
+	CreateOne(ctx context.Context, notification entity.Notification) error
+	CreateForOrderUser(ctx context.Context, orderId int64, notification entity.Notification) error
+	CreateForOrderManagers(ctx context.Context, orderId int64, notification entity.Notification) error
+	CreateForOrderPharmacyUser(ctx context.Context, orderPharmacyId int64, notification entity.Notification) error
+	CreateForOrderPharmacyManager(ctx context.Context, orderPharmacyId int64, notification entity.Notification) error
+	CreateForPharmacyManager(ctx context.Context, pharmacyId int64, notification entity.Notification) error
+	FindAllByAccountId(ctx context.Context, accountId int64, unreadOnly bool, limit, offset int) ([]entity.Notification, error)
+	CountByAccountId(ctx context.Context, accountId int64, unreadOnly bool) (*entity.NotificationCount, error)
+	MarkReadByIds(ctx context.Context, accountId int64, notificationIds []int64) (int64, error)
+	MarkAllRead(ctx context.Context, accountId int64) (int64, error)
+	ClaimUnpushed(ctx context.Context, maxAgeSeconds, limit int) ([]entity.Notification, error)
+}
+
+type notificationRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewNotificationRepositoryPostgres(db *sql.DB) notificationRepositoryPostgres {
+	return notificationRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *notificationRepositoryPostgres) CreateOne(ctx context.Context, notification entity.Notification) error {
+	_, err := r.db.ExecContext(ctx, database.CreateNotificationQuery, notification.AccountId, notification.Type, notification.Title, notification.Message, notification.Data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *notificationRepositoryPostgres) CreateForOrderUser(ctx context.Context, orderId int64, notification entity.Notification) error {
+	return r.createForRecipient(ctx, database.CreateNotificationForOrderUserQuery, orderId, notification)
+}
+
+func (r *notificationRepositoryPostgres) CreateForOrderManagers(ctx context.Context, orderId int64, notification entity.Notification) error {
+	return r.createForRecipient(ctx, database.CreateNotificationForOrderManagersQuery, orderId, notification)
+}
+
+func (r *notificationRepositoryPostgres) CreateForOrderPharmacyUser(ctx context.Context, orderPharmacyId int64, notification entity.Notification) error {
+	return r.createForRecipient(ctx, database.CreateNotificationForOrderPharmacyUserQuery, orderPharmacyId, notification)
+}
+
+func (r *notificationRepositoryPostgres) CreateForOrderPharmacyManager(ctx context.Context, orderPharmacyId int64, notification entity.Notification) error {
+	return r.createForRecipient(ctx, database.CreateNotificationForOrderPharmacyManagerQuery, orderPharmacyId, notification)
+}
+
+func (r *notificationRepositoryPostgres) CreateForPharmacyManager(ctx context.Context, pharmacyId int64, notification entity.Notification) error {
+	return r.createForRecipient(ctx, database.CreateNotificationForPharmacyManagerQuery, pharmacyId, notification)
+}
+
+func (r *notificationRepositoryPostgres) createForRecipient(ctx context.Context, query string, recipientId int64, notification entity.Notification) error {
+	_, err := r.db.ExecContext(ctx, query, recipientId, notification.Type, notification.Title, notification.Message, notification.Data)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *notificationRepositoryPostgres) FindAllByAccountId(ctx context.Context, accountId int64, unreadOnly bool, limit, offset int) ([]entity.Notification, error) {
+	rows, err := r.db.QueryContext(ctx, database.FindAllNotificationsByAccountIdQuery, accountId, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanNotifications(rows)
+}
+
+func (r *notificationRepositoryPostgres) CountByAccountId(ctx context.Context, accountId int64, unreadOnly bool) (*entity.NotificationCount, error) {
+	var count entity.NotificationCount
+
+	err := r.db.QueryRowContext(ctx, database.CountNotificationsByAccountIdQuery, accountId, unreadOnly).Scan(&count.TotalItem, &count.UnreadCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+func (r *notificationRepositoryPostgres) MarkReadByIds(ctx context.Context, accountId int64, notificationIds []int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx, database.MarkNotificationsReadByIdsQuery, accountId, notificationIds)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *notificationRepositoryPostgres) MarkAllRead(ctx context.Context, accountId int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx, database.MarkAllNotificationsReadQuery, accountId)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+func (r *notificationRepositoryPostgres) ClaimUnpushed(ctx context.Context, maxAgeSeconds, limit int) ([]entity.Notification, error) {
+	rows, err := r.db.QueryContext(ctx, database.ClaimUnpushedNotificationsQuery, maxAgeSeconds, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanNotifications(rows)
+}
+
+func scanNotifications(rows *sql.Rows) ([]entity.Notification, error) {
+	notifications := []entity.Notification{}
+
+	for rows.Next() {
+		var notification entity.Notification
+
+		err := rows.Scan(&notification.Id, &notification.AccountId, &notification.Type, &notification.Title, &notification.Message, &notification.Data, &notification.ReadAt, &notification.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type OidcRepository interface {
+	CreateLoginState(ctx context.Context, loginState entity.OidcLoginState, durationMinutes int) error
+	ConsumeLoginState(ctx context.Context, provider string, state string) (*entity.OidcLoginState, error)
+	FindAccountByIdentity(ctx context.Context, provider string, subject string) (*entity.Account, error)
+	CreateIdentity(ctx context.Context, accountId int64, identity entity.OidcIdentity) error
+	UpdateIdentityLastLogin(ctx context.Context, identity entity.OidcIdentity) error
+}
+
+type oidcRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewOidcRepositoryPostgres(db *sql.DB) oidcRepositoryPostgres {
+	return oidcRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *oidcRepositoryPostgres) CreateLoginState(ctx context.Context, loginState entity.OidcLoginState, durationMinutes int) error {
+	_, err := r.db.ExecContext(ctx, database.CreateOidcLoginStateQuery, loginState.State, loginState.Provider, loginState.Nonce, loginState.CodeVerifier, loginState.LinkAccountId, durationMinutes)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *oidcRepositoryPostgres) ConsumeLoginState(ctx context.Context, provider string, state string) (*entity.OidcLoginState, error) {
+	var loginState entity.OidcLoginState
+
+	err := r.db.QueryRowContext(ctx, database.ConsumeOidcLoginStateQuery, state, provider).Scan(&loginState.State, &loginState.Provider, &loginState.Nonce, &loginState.CodeVerifier, &loginState.LinkAccountId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &loginState, nil
+}
+
+func (r *oidcRepositoryPostgres) FindAccountByIdentity(ctx context.Context, provider string, subject string) (*entity.Account, error) {
+	var account entity.Account
+
+	err := r.db.QueryRowContext(ctx, database.FindAccountByIdentityQuery, provider, subject).Scan(&account.Id, &account.Email, &account.Password, &account.RoleId, &account.RoleName, &account.Name, &account.ProfilePicture, &account.VerifiedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (r *oidcRepositoryPostgres) CreateIdentity(ctx context.Context, accountId int64, identity entity.OidcIdentity) error {
+	_, err := r.db.ExecContext(ctx, database.CreateAccountIdentityQuery, accountId, identity.Provider, identity.Subject, identity.Email)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *oidcRepositoryPostgres) UpdateIdentityLastLogin(ctx context.Context, identity entity.OidcIdentity) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateAccountIdentityLastLoginQuery, identity.Provider, identity.Subject, identity.Email)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
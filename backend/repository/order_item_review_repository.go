@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type OrderItemReviewRepository interface {
+	FindReviewableOrderItem(ctx context.Context, orderItemId int64) (*entity.ReviewableOrderItem, error)
+	CreateOrderItemReview(ctx context.Context, orderItemReview entity.OrderItemReview) (*int64, error)
+	UpdateOrderItemReview(ctx context.Context, orderItemReviewId int64, rating int, review *string) error
+	UpdateOrderItemReviewReply(ctx context.Context, orderItemReviewId int64, reply string) error
+	FindOrderItemReviewById(ctx context.Context, orderItemReviewId int64) (*entity.OrderItemReview, error)
+	FindOrderItemReviewByOrderItemId(ctx context.Context, orderItemId int64) (*entity.OrderItemReview, error)
+	GetAllOrderItemReviewByDrugId(ctx context.Context, drugId int64, limit, offset int) ([]entity.OrderItemReview, error)
+	GetAllOrderItemReviewByDrugIdTotalItem(ctx context.Context, drugId int64) (int, error)
+}
+
+type orderItemReviewRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewOrderItemReviewRepositoryPostgres(db *sql.DB) orderItemReviewRepositoryPostgres {
+	return orderItemReviewRepositoryPostgres{
+		db: db,
+	}
+}
+
+func scanOrderItemReview(row interface{ Scan(dest ...any) error }) (*entity.OrderItemReview, error) {
+	var orderItemReview entity.OrderItemReview
+
+	err := row.Scan(&orderItemReview.Id, &orderItemReview.OrderItemId, &orderItemReview.PharmacyId, &orderItemReview.PharmacyName,
+		&orderItemReview.PharmacyManagerId, &orderItemReview.PharmacyDrugId, &orderItemReview.DrugId, &orderItemReview.UserId,
+		&orderItemReview.UserName, &orderItemReview.UserProfilePicture, &orderItemReview.Rating, &orderItemReview.Review,
+		&orderItemReview.ManagerReply, &orderItemReview.RepliedAt, &orderItemReview.CreatedAt, &orderItemReview.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderItemReview, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) FindReviewableOrderItem(ctx context.Context, orderItemId int64) (*entity.ReviewableOrderItem, error) {
+	var orderItem entity.ReviewableOrderItem
+
+	err := r.db.QueryRowContext(ctx, database.FindReviewableOrderItemQuery, orderItemId).Scan(&orderItem.OrderItemId, &orderItem.OrderPharmacyId,
+		&orderItem.PharmacyDrugId, &orderItem.DrugId, &orderItem.UserId, &orderItem.OrderStatusId, &orderItem.PharmacyId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &orderItem, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) CreateOrderItemReview(ctx context.Context, orderItemReview entity.OrderItemReview) (*int64, error) {
+	var orderItemReviewId int64
+
+	err := r.db.QueryRowContext(ctx, database.CreateOrderItemReviewQuery, orderItemReview.OrderItemId, orderItemReview.PharmacyId,
+		orderItemReview.PharmacyDrugId, orderItemReview.DrugId, orderItemReview.UserId, orderItemReview.Rating, orderItemReview.Review).Scan(&orderItemReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderItemReviewId, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) UpdateOrderItemReview(ctx context.Context, orderItemReviewId int64, rating int, review *string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateOrderItemReviewQuery, rating, review, orderItemReviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) UpdateOrderItemReviewReply(ctx context.Context, orderItemReviewId int64, reply string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateOrderItemReviewReplyQuery, reply, orderItemReviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) FindOrderItemReviewById(ctx context.Context, orderItemReviewId int64) (*entity.OrderItemReview, error) {
+	orderItemReview, err := scanOrderItemReview(r.db.QueryRowContext(ctx, database.FindOrderItemReviewByIdQuery, orderItemReviewId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return orderItemReview, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) FindOrderItemReviewByOrderItemId(ctx context.Context, orderItemId int64) (*entity.OrderItemReview, error) {
+	orderItemReview, err := scanOrderItemReview(r.db.QueryRowContext(ctx, database.FindOrderItemReviewByOrderItemIdQuery, orderItemId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return orderItemReview, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) GetAllOrderItemReviewByDrugId(ctx context.Context, drugId int64, limit, offset int) ([]entity.OrderItemReview, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllOrderItemReviewByDrugIdQuery, drugId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orderItemReviewList := []entity.OrderItemReview{}
+
+	for rows.Next() {
+		orderItemReview, err := scanOrderItemReview(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		orderItemReviewList = append(orderItemReviewList, *orderItemReview)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return orderItemReviewList, nil
+}
+
+func (r *orderItemReviewRepositoryPostgres) GetAllOrderItemReviewByDrugIdTotalItem(ctx context.Context, drugId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetAllOrderItemReviewByDrugIdTotalItemQuery, drugId).Scan(&totalItem)
+	if err != nil {
+		return totalItem, err
+	}
+
+	return totalItem, nil
+}
@@ -46,6 +46,7 @@ func (r *orderItemRepositoryPostgres) FindAllByOrderPharmacyId(ctx context.Conte
 			&orderItem.DrugUnit,
 			&orderItem.Quantity,
 			&orderItem.DrugImage,
+			&orderItem.PrescriptionId,
 		)
 		if err != nil {
 			return nil, err
@@ -148,13 +149,14 @@ func (r *orderItemRepositoryPostgres) PostOrderItems(ctx context.Context, orderP
 		for j, cartItem := range orderPharmacy.CartItems {
 			query += `($` + strconv.Itoa(index) + `, $` + strconv.Itoa(len(args)+1) + `, $` + strconv.Itoa(len(args)+2) +
 				`, $` + strconv.Itoa(len(args)+3) + `, $` + strconv.Itoa(len(args)+4) + `, $` + strconv.Itoa(len(args)+5) +
-				`, $` + strconv.Itoa(len(args)+6) + `)`
+				`, $` + strconv.Itoa(len(args)+6) + `, $` + strconv.Itoa(len(args)+7) + `)`
 			args = append(args, cartItem.DrugId)
 			args = append(args, cartItem.DrugName)
 			args = append(args, cartItem.PharmacyDrugId)
 			args = append(args, cartItem.Price)
 			args = append(args, cartItem.Unit)
 			args = append(args, cartItem.Quantity)
+			args = append(args, cartItem.PrescriptionId)
 			if !(i == len(orderPharmacies)-1 && j == len(orderPharmacy.CartItems)-1) {
 				query += `,`
 			}
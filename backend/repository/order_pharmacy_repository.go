@@ -91,6 +91,7 @@ func (r *orderPharmacyRepositoryPostgres) FindAllByOrderId(ctx context.Context,
 			&orderPharmacy.Id,
 			&orderPharmacy.UserId,
 			&orderPharmacy.OrderStatusId,
+			&orderPharmacy.PharmacyManagerId,
 		)
 		if err != nil {
 			return []entity.OrderPharmacy{}, err
@@ -407,7 +408,7 @@ func (r *orderPharmacyRepositoryPostgres) FindAllIds(ctx context.Context, valida
 func (r *orderPharmacyRepositoryPostgres) FindOneByOrderPharmacyId(ctx context.Context, orderPharmacyId int64) (*entity.OrderPharmacy, error) {
 	var orderPharmacy entity.OrderPharmacy
 	err := r.db.QueryRowContext(ctx, database.FindOrderPharmacyByOrderPharmacyId, orderPharmacyId).Scan(&orderPharmacy.Id, &orderPharmacy.UserId, &orderPharmacy.OrderId,
-		&orderPharmacy.OrderStatusId, &orderPharmacy.PharmacyCourierId, &orderPharmacy.SubtotalAmount, &orderPharmacy.DeliveryFee)
+		&orderPharmacy.OrderStatusId, &orderPharmacy.PharmacyCourierId, &orderPharmacy.SubtotalAmount, &orderPharmacy.DeliveryFee, &orderPharmacy.PharmacyManagerId)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+)
+
+type PatientAllergyRepository interface {
+	GetAllergensByUserAccountId(ctx context.Context, userAccountId int64) ([]string, error)
+	ReplaceAllergens(ctx context.Context, userAccountId int64, allergens []string) error
+}
+
+type patientAllergyRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewPatientAllergyRepositoryPostgres(db *sql.DB) patientAllergyRepositoryPostgres {
+	return patientAllergyRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *patientAllergyRepositoryPostgres) GetAllergensByUserAccountId(ctx context.Context, userAccountId int64) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllergensByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var allergenList []string
+
+	for rows.Next() {
+		var allergen string
+
+		err := rows.Scan(&allergen)
+		if err != nil {
+			return nil, err
+		}
+
+		allergenList = append(allergenList, allergen)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return allergenList, nil
+}
+
+func (r *patientAllergyRepositoryPostgres) ReplaceAllergens(ctx context.Context, userAccountId int64, allergens []string) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteAllergensByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return err
+	}
+
+	if len(allergens) == 0 {
+		return nil
+	}
+
+	_, err = r.db.ExecContext(ctx, database.CreateAllergensQuery, userAccountId, allergens)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
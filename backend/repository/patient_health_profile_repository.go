@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type PatientHealthProfileRepository interface {
+	FindHealthProfileByUserAccountId(ctx context.Context, userAccountId int64) (*entity.PatientHealthProfile, error)
+	UpsertHealthProfile(ctx context.Context, profile entity.PatientHealthProfile) error
+	GetChronicConditionsByUserAccountId(ctx context.Context, userAccountId int64) ([]string, error)
+	ReplaceChronicConditions(ctx context.Context, userAccountId int64, conditions []string) error
+	GetMedicationsByUserAccountId(ctx context.Context, userAccountId int64) ([]entity.PatientMedication, error)
+	ReplaceMedications(ctx context.Context, userAccountId int64, medications []entity.PatientMedication) error
+	CreateAccessLog(ctx context.Context, accessLog entity.HealthProfileAccessLog) error
+	GetAccessLogsByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.HealthProfileAccessLog, error)
+	GetAccessLogsByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error)
+}
+
+type patientHealthProfileRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewPatientHealthProfileRepositoryPostgres(db *sql.DB) patientHealthProfileRepositoryPostgres {
+	return patientHealthProfileRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *patientHealthProfileRepositoryPostgres) FindHealthProfileByUserAccountId(ctx context.Context, userAccountId int64) (*entity.PatientHealthProfile, error) {
+	profile := entity.PatientHealthProfile{UserAccountId: userAccountId}
+
+	err := r.db.QueryRowContext(ctx, database.FindHealthProfileByUserAccountIdQuery, userAccountId).Scan(&profile.HeightCm, &profile.WeightKg, &profile.PregnancyStatus, &profile.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) UpsertHealthProfile(ctx context.Context, profile entity.PatientHealthProfile) error {
+	_, err := r.db.ExecContext(ctx, database.UpsertHealthProfileQuery, profile.UserAccountId, profile.HeightCm, profile.WeightKg, profile.PregnancyStatus)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) GetChronicConditionsByUserAccountId(ctx context.Context, userAccountId int64) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetChronicConditionsByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var conditionList []string
+
+	for rows.Next() {
+		var condition string
+
+		err := rows.Scan(&condition)
+		if err != nil {
+			return nil, err
+		}
+
+		conditionList = append(conditionList, condition)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return conditionList, nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) ReplaceChronicConditions(ctx context.Context, userAccountId int64, conditions []string) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteChronicConditionsByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return err
+	}
+
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	_, err = r.db.ExecContext(ctx, database.CreateChronicConditionsQuery, userAccountId, conditions)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) GetMedicationsByUserAccountId(ctx context.Context, userAccountId int64) ([]entity.PatientMedication, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetMedicationsByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var medicationList []entity.PatientMedication
+
+	for rows.Next() {
+		var medication entity.PatientMedication
+
+		err := rows.Scan(&medication.Name, &medication.Dosage)
+		if err != nil {
+			return nil, err
+		}
+
+		medicationList = append(medicationList, medication)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return medicationList, nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) ReplaceMedications(ctx context.Context, userAccountId int64, medications []entity.PatientMedication) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteMedicationsByUserAccountIdQuery, userAccountId)
+	if err != nil {
+		return err
+	}
+
+	for _, medication := range medications {
+		_, err = r.db.ExecContext(ctx, database.CreateMedicationQuery, userAccountId, medication.Name, medication.Dosage)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) CreateAccessLog(ctx context.Context, accessLog entity.HealthProfileAccessLog) error {
+	_, err := r.db.ExecContext(ctx, database.CreateHealthProfileAccessLogQuery, accessLog.UserAccountId, accessLog.DoctorAccountId, accessLog.WsChatRoomId, accessLog.AccessSource)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) GetAccessLogsByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.HealthProfileAccessLog, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetHealthProfileAccessLogsByUserAccountIdQuery, userAccountId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accessLogList []entity.HealthProfileAccessLog
+
+	for rows.Next() {
+		var accessLog entity.HealthProfileAccessLog
+
+		err := rows.Scan(&accessLog.Id, &accessLog.UserAccountId, &accessLog.DoctorAccountId, &accessLog.DoctorName, &accessLog.WsChatRoomId, &accessLog.AccessSource, &accessLog.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		accessLogList = append(accessLogList, accessLog)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return accessLogList, nil
+}
+
+func (r *patientHealthProfileRepositoryPostgres) GetAccessLogsByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetHealthProfileAccessLogsByUserAccountIdTotalItemQuery, userAccountId).Scan(&totalItem)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalItem, nil
+}
@@ -27,7 +27,7 @@ type PharmacyDrugRepository interface {
 	UpdatePharmacyDrugsByOrderId(ctx context.Context, orderId int64) ([]entity.StockChange, error)
 	UpdatePharmacyDrugStockPrice(ctx context.Context, pharmacyDrugId int64, stock int, Price decimal.Decimal) error
 	DeletePharmacyDrug(ctx context.Context, pharmacyDrugId int64) error
-	AddPharmacyDrug(ctx context.Context, pharmacyId int64, drugId int64, stock int, price decimal.Decimal) error
+	AddPharmacyDrug(ctx context.Context, pharmacyId int64, drugId int64, stock int, price decimal.Decimal) (int64, error)
 	GetPossibleStockMutation(ctx context.Context, pharmacyDrugId int64) ([]entity.PharmacyDrugDetail, error)
 	GetPharmacyDrugByIdForUpdate(ctx context.Context, pharmacyDrugId int64) (*entity.PharmacyDrugDetail, error)
 }
@@ -65,6 +65,10 @@ func (r *pharmacyDrugRepositoryPostgres) GetPharmacyDrugsByDrugId(ctx context.Co
 			&pharmacyDrug.DrugId,
 			&pharmacyDrug.Price,
 			&pharmacyDrug.Stock,
+			&pharmacyDrug.Pharmacy.AverageRating,
+			&pharmacyDrug.Pharmacy.ReviewCount,
+			&pharmacyDrug.AverageRating,
+			&pharmacyDrug.ReviewCount,
 		)
 		if err != nil {
 			return nil, err
@@ -78,7 +82,7 @@ func (r *pharmacyDrugRepositoryPostgres) GetPharmacyDrugsByDrugId(ctx context.Co
 
 func (r *pharmacyDrugRepositoryPostgres) GetPharmacyDrugById(ctx context.Context, pharmacyDrugId int64) (*entity.PharmacyDrugDetail, error) {
 	pharmacyDrug := entity.PharmacyDrugDetail{}
-	err := r.db.QueryRowContext(ctx, database.GetPharmacyDrugById, pharmacyDrugId).Scan(&pharmacyDrug.Id, &pharmacyDrug.PharmacyId, &pharmacyDrug.DrugId, &pharmacyDrug.Price, &pharmacyDrug.Stock)
+	err := r.db.QueryRowContext(ctx, database.GetPharmacyDrugById, pharmacyDrugId).Scan(&pharmacyDrug.Id, &pharmacyDrug.PharmacyId, &pharmacyDrug.DrugId, &pharmacyDrug.Price, &pharmacyDrug.Stock, &pharmacyDrug.IsPrescriptionRequired)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -159,7 +163,9 @@ func (r *pharmacyDrugRepositoryPostgres) GetProductListing(ctx context.Context,
 			&drug.MinPrice,
 			&drug.MaxPrice,
 			&drug.Image,
-			&drug.IsPrescriptionRequired)
+			&drug.IsPrescriptionRequired,
+			&drug.AverageRating,
+			&drug.ReviewCount)
 		if err != nil {
 			return nil, nil, fmt.Errorf("[pharmacy_drug_repository][GetProductListing][rows.Scan] Error: %w", err)
 		}
@@ -361,14 +367,15 @@ func (r *pharmacyDrugRepositoryPostgres) DeletePharmacyDrug(ctx context.Context,
 	return nil
 }
 
-func (r *pharmacyDrugRepositoryPostgres) AddPharmacyDrug(ctx context.Context, pharmacyId int64, drugId int64, stock int, price decimal.Decimal) error {
+func (r *pharmacyDrugRepositoryPostgres) AddPharmacyDrug(ctx context.Context, pharmacyId int64, drugId int64, stock int, price decimal.Decimal) (int64, error) {
 	query := database.AddPharmacyDrug
 
-	_, err := r.db.ExecContext(ctx, query, pharmacyId, drugId, stock, price)
+	var pharmacyDrugId int64
+	err := r.db.QueryRowContext(ctx, query, pharmacyId, drugId, stock, price).Scan(&pharmacyDrugId)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	return nil
+	return pharmacyDrugId, nil
 }
 
 func (r *pharmacyDrugRepositoryPostgres) GetPossibleStockMutation(ctx context.Context, pharmacyDrugId int64) ([]entity.PharmacyDrugDetail, error) {
@@ -392,7 +399,7 @@ func (r *pharmacyDrugRepositoryPostgres) GetPossibleStockMutation(ctx context.Co
 
 func (r *pharmacyDrugRepositoryPostgres) GetPharmacyDrugByIdForUpdate(ctx context.Context, pharmacyDrugId int64) (*entity.PharmacyDrugDetail, error) {
 	pharmacyDrug := entity.PharmacyDrugDetail{}
-	err := r.db.QueryRowContext(ctx, database.GetPharmacyDrugById, pharmacyDrugId).Scan(&pharmacyDrug.Id, &pharmacyDrug.PharmacyId, &pharmacyDrug.DrugId, &pharmacyDrug.Price, &pharmacyDrug.Stock)
+	err := r.db.QueryRowContext(ctx, database.GetPharmacyDrugById, pharmacyDrugId).Scan(&pharmacyDrug.Id, &pharmacyDrug.PharmacyId, &pharmacyDrug.DrugId, &pharmacyDrug.Price, &pharmacyDrug.Stock, &pharmacyDrug.IsPrescriptionRequired)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -9,7 +9,7 @@ import (
 )
 
 type PharmacyManagerRepository interface {
-	PostOne(ctx context.Context, accountId int64) error
+	PostOne(ctx context.Context, accountId int64) (*int64, error)
 	FindAll(ctx context.Context) ([]entity.PharmacyManager, error)
 	FindOneById(ctx context.Context, pharmacyManagerId int64) (*entity.PharmacyManager, error)
 	DeleteOneById(ctx context.Context, pharmacyManagerId int64) error
@@ -27,13 +27,14 @@ func NewpharmacyManagerRepositoryPostgres(db *sql.DB) pharmacyManagerRepositoryP
 	}
 }
 
-func (r *pharmacyManagerRepositoryPostgres) PostOne(ctx context.Context, accountId int64) error {
-	_, err := r.db.ExecContext(ctx, database.PostOnePharmacyManagerQuery, accountId)
-	if err != nil {
-		return err
+func (r *pharmacyManagerRepositoryPostgres) PostOne(ctx context.Context, accountId int64) (*int64, error) {
+	var pharmacyManagerId int64
+
+	if err := r.db.QueryRowContext(ctx, database.PostOnePharmacyManagerQuery, accountId).Scan(&pharmacyManagerId); err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &pharmacyManagerId, nil
 }
 
 func (r *pharmacyManagerRepositoryPostgres) FindAll(ctx context.Context) ([]entity.PharmacyManager, error) {
@@ -184,7 +184,7 @@ func (r *pharmacyRepositoryPostgres) GetAllCourierOptionsByPharmacyId(ctx contex
 			availableCourier.CourierOptions = append(availableCourier.CourierOptions, courierOption)
 		} else {
 			if origin != nil && destination != nil {
-				options, err := util.GetUnofficialDelivery(int64(*origin), int64(*destination), int64(weight), availableCourier.CourierName)
+				options, err := util.GetUnofficialDelivery(ctx, int64(*origin), int64(*destination), int64(weight), availableCourier.CourierName)
 				if err != nil {
 					return nil, err
 				}
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+	"max-health/util"
+)
+
+type PharmacyReviewRepository interface {
+	FindReviewableOrderPharmacy(ctx context.Context, orderPharmacyId int64) (*entity.ReviewableOrderPharmacy, error)
+	CreatePharmacyReview(ctx context.Context, pharmacyReview entity.PharmacyReview) (*int64, error)
+	UpdatePharmacyReview(ctx context.Context, pharmacyReviewId int64, rating int, review *string) error
+	UpdatePharmacyReviewReply(ctx context.Context, pharmacyReviewId int64, reply string) error
+	FindPharmacyReviewById(ctx context.Context, pharmacyReviewId int64) (*entity.PharmacyReview, error)
+	FindPharmacyReviewByOrderPharmacyId(ctx context.Context, orderPharmacyId int64) (*entity.PharmacyReview, error)
+	GetAllPharmacyReviewByPharmacyId(ctx context.Context, pharmacyId int64, limit, offset int) ([]entity.PharmacyReview, error)
+	GetAllPharmacyReviewByPharmacyIdTotalItem(ctx context.Context, pharmacyId int64) (int, error)
+	FindPharmacyRatingReportByPharmacyId(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) ([]entity.PharmacyRatingReport, error)
+}
+
+type pharmacyReviewRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewPharmacyReviewRepositoryPostgres(db *sql.DB) pharmacyReviewRepositoryPostgres {
+	return pharmacyReviewRepositoryPostgres{
+		db: db,
+	}
+}
+
+func scanPharmacyReview(row interface{ Scan(dest ...any) error }) (*entity.PharmacyReview, error) {
+	var pharmacyReview entity.PharmacyReview
+
+	err := row.Scan(&pharmacyReview.Id, &pharmacyReview.OrderPharmacyId, &pharmacyReview.PharmacyId, &pharmacyReview.PharmacyManagerId,
+		&pharmacyReview.UserId, &pharmacyReview.UserName, &pharmacyReview.UserProfilePicture, &pharmacyReview.Rating, &pharmacyReview.Review,
+		&pharmacyReview.ManagerReply, &pharmacyReview.RepliedAt, &pharmacyReview.CreatedAt, &pharmacyReview.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pharmacyReview, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) FindReviewableOrderPharmacy(ctx context.Context, orderPharmacyId int64) (*entity.ReviewableOrderPharmacy, error) {
+	var orderPharmacy entity.ReviewableOrderPharmacy
+
+	err := r.db.QueryRowContext(ctx, database.FindReviewableOrderPharmacyQuery, orderPharmacyId).Scan(&orderPharmacy.OrderPharmacyId,
+		&orderPharmacy.UserId, &orderPharmacy.OrderStatusId, &orderPharmacy.PharmacyId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &orderPharmacy, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) CreatePharmacyReview(ctx context.Context, pharmacyReview entity.PharmacyReview) (*int64, error) {
+	var pharmacyReviewId int64
+
+	err := r.db.QueryRowContext(ctx, database.CreatePharmacyReviewQuery, pharmacyReview.OrderPharmacyId, pharmacyReview.PharmacyId,
+		pharmacyReview.UserId, pharmacyReview.Rating, pharmacyReview.Review).Scan(&pharmacyReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pharmacyReviewId, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) UpdatePharmacyReview(ctx context.Context, pharmacyReviewId int64, rating int, review *string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdatePharmacyReviewQuery, rating, review, pharmacyReviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) UpdatePharmacyReviewReply(ctx context.Context, pharmacyReviewId int64, reply string) error {
+	_, err := r.db.ExecContext(ctx, database.UpdatePharmacyReviewReplyQuery, reply, pharmacyReviewId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) FindPharmacyReviewById(ctx context.Context, pharmacyReviewId int64) (*entity.PharmacyReview, error) {
+	pharmacyReview, err := scanPharmacyReview(r.db.QueryRowContext(ctx, database.FindPharmacyReviewByIdQuery, pharmacyReviewId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return pharmacyReview, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) FindPharmacyReviewByOrderPharmacyId(ctx context.Context, orderPharmacyId int64) (*entity.PharmacyReview, error) {
+	pharmacyReview, err := scanPharmacyReview(r.db.QueryRowContext(ctx, database.FindPharmacyReviewByOrderPharmacyIdQuery, orderPharmacyId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return pharmacyReview, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) GetAllPharmacyReviewByPharmacyId(ctx context.Context, pharmacyId int64, limit, offset int) ([]entity.PharmacyReview, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetAllPharmacyReviewByPharmacyIdQuery, pharmacyId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pharmacyReviewList := []entity.PharmacyReview{}
+
+	for rows.Next() {
+		pharmacyReview, err := scanPharmacyReview(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		pharmacyReviewList = append(pharmacyReviewList, *pharmacyReview)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return pharmacyReviewList, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) GetAllPharmacyReviewByPharmacyIdTotalItem(ctx context.Context, pharmacyId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetAllPharmacyReviewByPharmacyIdTotalItemQuery, pharmacyId).Scan(&totalItem)
+	if err != nil {
+		return totalItem, err
+	}
+
+	return totalItem, nil
+}
+
+func (r *pharmacyReviewRepositoryPostgres) FindPharmacyRatingReportByPharmacyId(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) ([]entity.PharmacyRatingReport, error) {
+	sql := database.FindPharmacyRatingReport
+
+	sql += ` ORDER BY period`
+	if validatedGetReportQuery.Sort != nil && *validatedGetReportQuery.Sort == "desc" {
+		sql += ` DESC`
+	} else {
+		sql += ` ASC`
+	}
+
+	rows, err := r.db.QueryContext(ctx, sql, validatedGetReportQuery.PharmacyId, validatedGetReportQuery.MaxDate, validatedGetReportQuery.MinDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	pharmacyRatingReport := []entity.PharmacyRatingReport{}
+
+	for rows.Next() {
+		var report entity.PharmacyRatingReport
+
+		err := rows.Scan(&report.Period, &report.PharmacyReviewCount, &report.PharmacyAverageRating,
+			&report.OrderItemReviewCount, &report.OrderItemAverageRating)
+		if err != nil {
+			return nil, err
+		}
+
+		pharmacyRatingReport = append(pharmacyRatingReport, report)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return pharmacyRatingReport, nil
+}
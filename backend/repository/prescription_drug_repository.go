@@ -11,6 +11,8 @@ import (
 type PrescriptionDrugRepository interface {
 	PostOnePrescriptionDrug(ctx context.Context, prescriptionId int64, prescriptionDrug entity.PrescriptionDrug) error
 	GetAllPrescriptionDrug(ctx context.Context, prescriptionId int64) ([]entity.PrescriptionDrug, error)
+	GetRemainingPrescriptionQuantity(ctx context.Context, accountId, prescriptionId, pharmacyDrugId int64) (*int, error)
+	DispensePrescriptionDrug(ctx context.Context, accountId, prescriptionId, pharmacyDrugId int64, quantity int) (bool, error)
 }
 
 type prescriptionDrugRepositoryPostgres struct {
@@ -44,7 +46,7 @@ func (r *prescriptionDrugRepositoryPostgres) GetAllPrescriptionDrug(ctx context.
 	for rows.Next() {
 		var prescriptionDrug entity.PrescriptionDrug
 
-		err := rows.Scan(&prescriptionDrug.Id, &prescriptionDrug.Drug.Id, &prescriptionDrug.Drug.Name, &prescriptionDrug.Drug.Image, &prescriptionDrug.Drug.IsActive, &prescriptionDrug.Quantity, &prescriptionDrug.Note)
+		err := rows.Scan(&prescriptionDrug.Id, &prescriptionDrug.Drug.Id, &prescriptionDrug.Drug.Name, &prescriptionDrug.Drug.Image, &prescriptionDrug.Drug.IsActive, &prescriptionDrug.Quantity, &prescriptionDrug.DispensedQuantity, &prescriptionDrug.Note)
 		if err != nil {
 			return nil, err
 		}
@@ -60,6 +62,35 @@ func (r *prescriptionDrugRepositoryPostgres) GetAllPrescriptionDrug(ctx context.
 	return prescriptionDrugList, nil
 }
 
+func (r *prescriptionDrugRepositoryPostgres) GetRemainingPrescriptionQuantity(ctx context.Context, accountId, prescriptionId, pharmacyDrugId int64) (*int, error) {
+	var remainingQuantity int
+
+	err := r.db.QueryRowContext(ctx, database.GetRemainingPrescriptionQuantityQuery, prescriptionId, accountId, pharmacyDrugId).Scan(&remainingQuantity)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return &remainingQuantity, nil
+}
+
+func (r *prescriptionDrugRepositoryPostgres) DispensePrescriptionDrug(ctx context.Context, accountId, prescriptionId, pharmacyDrugId int64, quantity int) (bool, error) {
+	res, err := r.db.ExecContext(ctx, database.DispensePrescriptionDrugQuery, prescriptionId, pharmacyDrugId, quantity, accountId)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
 func (r *prescriptionDrugRepositoryPostgres) GetPrescriptionDrugByCartItemId(ctx context.Context, cartItemId int64) error {
 	return nil
 }
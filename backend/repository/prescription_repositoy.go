@@ -9,12 +9,17 @@ import (
 )
 
 type PrescriptionRepository interface {
-	CreateOnePrescription(ctx context.Context, userAccountId, doctorAccountId int64) (*int64, error)
+	CreateOnePrescription(ctx context.Context, prescription entity.Prescription) (*int64, error)
 	GetPrescriptionById(ctx context.Context, prescriptionId int64) (*entity.Prescription, error)
 	SetPrescriptionRedeemedNow(ctx context.Context, prescriptionId int64) error
 	GetPrescriptionListByUserAccountId(ctx context.Context, accountId int64, limit, offset int) ([]entity.Prescription, error)
 	GetPrescriptionListByUserAccountIdTotalItem(ctx context.Context, accountId int64) (int, error)
 	SetPrescriptionOrderedAtNow(ctx context.Context, prescriptionId int64) error
+	GetPrescriptionDocumentById(ctx context.Context, prescriptionId int64) (*entity.PrescriptionDocument, error)
+	GetPrescriptionDocumentByVerificationCode(ctx context.Context, verificationCode string) (*entity.PrescriptionDocument, error)
+	SetPrescriptionSignature(ctx context.Context, prescriptionId int64, verificationCode, signature string) error
+	GetMedicalRecordPrescriptionById(ctx context.Context, prescriptionId int64) (*entity.Prescription, error)
+	GetMedicalRecordPrescriptionsByUserAccountId(ctx context.Context, accountId int64) ([]entity.Prescription, error)
 }
 
 type prescriptionRepositoryPostgres struct {
@@ -27,10 +32,10 @@ func NewPrescriptionRepositoryPostgres(db *sql.DB) prescriptionRepositoryPostgre
 	}
 }
 
-func (r *prescriptionRepositoryPostgres) CreateOnePrescription(ctx context.Context, userAccountId, doctorAccountId int64) (*int64, error) {
+func (r *prescriptionRepositoryPostgres) CreateOnePrescription(ctx context.Context, prescription entity.Prescription) (*int64, error) {
 	var prescriptionId int64
 
-	err := r.db.QueryRowContext(ctx, database.CreateOnePrescriptionQuery, userAccountId, doctorAccountId).Scan(&prescriptionId)
+	err := r.db.QueryRowContext(ctx, database.CreateOnePrescriptionQuery, prescription.UserAccountId, prescription.DoctorAccountId, prescription.ExpiredAt, prescription.RefillCount).Scan(&prescriptionId)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +46,7 @@ func (r *prescriptionRepositoryPostgres) CreateOnePrescription(ctx context.Conte
 func (r *prescriptionRepositoryPostgres) GetPrescriptionById(ctx context.Context, prescriptionId int64) (*entity.Prescription, error) {
 	var prescription entity.Prescription
 
-	err := r.db.QueryRowContext(ctx, database.GetPrescriptionByIdQuery, prescriptionId).Scan(&prescription.UserAccountId, &prescription.DoctorAccountId, &prescription.RedeemedAt, &prescription.OrderedAt)
+	err := r.db.QueryRowContext(ctx, database.GetPrescriptionByIdQuery, prescriptionId).Scan(&prescription.UserAccountId, &prescription.DoctorAccountId, &prescription.RedeemedAt, &prescription.OrderedAt, &prescription.ExpiredAt, &prescription.RefillCount)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -84,6 +89,8 @@ func (r *prescriptionRepositoryPostgres) GetPrescriptionListByUserAccountId(ctx
 			&prescription.DoctorName,
 			&prescription.RedeemedAt,
 			&prescription.OrderedAt,
+			&prescription.ExpiredAt,
+			&prescription.RefillCount,
 			&prescription.CreatedAt,
 		)
 		if err != nil {
@@ -120,3 +127,119 @@ func (r *prescriptionRepositoryPostgres) SetPrescriptionOrderedAtNow(ctx context
 
 	return nil
 }
+
+func (r *prescriptionRepositoryPostgres) GetPrescriptionDocumentById(ctx context.Context, prescriptionId int64) (*entity.PrescriptionDocument, error) {
+	return r.findPrescriptionDocument(ctx, database.GetPrescriptionDocumentByIdQuery, prescriptionId)
+}
+
+func (r *prescriptionRepositoryPostgres) GetPrescriptionDocumentByVerificationCode(ctx context.Context, verificationCode string) (*entity.PrescriptionDocument, error) {
+	return r.findPrescriptionDocument(ctx, database.GetPrescriptionDocumentByVerificationCodeQuery, verificationCode)
+}
+
+func (r *prescriptionRepositoryPostgres) findPrescriptionDocument(ctx context.Context, query string, arg interface{}) (*entity.PrescriptionDocument, error) {
+	var document entity.PrescriptionDocument
+	var specialization *string
+
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&document.PrescriptionId,
+		&document.PatientAccountId,
+		&document.PatientName,
+		&document.PatientGender,
+		&document.PatientDateOfBirth,
+		&document.DoctorAccountId,
+		&document.DoctorName,
+		&specialization,
+		&document.DoctorCertificate,
+		&document.VerificationCode,
+		&document.Signature,
+		&document.RedeemedAt,
+		&document.OrderedAt,
+		&document.ExpiredAt,
+		&document.RefillCount,
+		&document.IssuedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if specialization != nil {
+		document.DoctorSpecialization = *specialization
+	}
+
+	return &document, nil
+}
+
+func (r *prescriptionRepositoryPostgres) SetPrescriptionSignature(ctx context.Context, prescriptionId int64, verificationCode, signature string) error {
+	_, err := r.db.ExecContext(ctx, database.SetPrescriptionSignatureQuery, prescriptionId, verificationCode, signature)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *prescriptionRepositoryPostgres) GetMedicalRecordPrescriptionById(ctx context.Context, prescriptionId int64) (*entity.Prescription, error) {
+	prescription, err := scanMedicalRecordPrescription(r.db.QueryRowContext(ctx, database.GetMedicalRecordPrescriptionByIdQuery, prescriptionId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return prescription, nil
+}
+
+func (r *prescriptionRepositoryPostgres) GetMedicalRecordPrescriptionsByUserAccountId(ctx context.Context, accountId int64) ([]entity.Prescription, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetMedicalRecordPrescriptionsByUserAccountIdQuery, accountId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prescriptionList []entity.Prescription
+
+	for rows.Next() {
+		prescription, err := scanMedicalRecordPrescription(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		prescriptionList = append(prescriptionList, *prescription)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return prescriptionList, nil
+}
+
+func scanMedicalRecordPrescription(row interface{ Scan(dest ...any) error }) (*entity.Prescription, error) {
+	var prescription entity.Prescription
+
+	err := row.Scan(
+		&prescription.Id,
+		&prescription.UserAccountId,
+		&prescription.UserName,
+		&prescription.DoctorAccountId,
+		&prescription.DoctorName,
+		&prescription.RedeemedAt,
+		&prescription.OrderedAt,
+		&prescription.ExpiredAt,
+		&prescription.RefillCount,
+		&prescription.WsChatRoomId,
+		&prescription.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &prescription, nil
+}
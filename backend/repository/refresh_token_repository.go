@@ -5,12 +5,15 @@ import (
 	"database/sql"
 
 	"max-health/database"
+	"max-health/entity"
 )
 
 type RefreshTokenRepository interface {
-	InvalidateCodes(ctx context.Context, accountId int64) error
-	PostOneCode(ctx context.Context, accountId int64, code string) error
-	FindOneCode(ctx context.Context, refreshToken string) (string, error)
+	PostOneCode(ctx context.Context, accountId int64, sessionId string, code string) error
+	FindOneCodeForUpdate(ctx context.Context, refreshToken string) (*entity.RefreshToken, error)
+	MarkCodeUsed(ctx context.Context, refreshTokenId int64) error
+	RevokeCodesBySessionId(ctx context.Context, sessionId string) error
+	RevokeCodesByAccountId(ctx context.Context, accountId int64) error
 }
 
 type refreshTokenRepositoryPostgres struct {
@@ -23,8 +26,8 @@ func NewRefreshTokenRepositoryPostgres(db *sql.DB) refreshTokenRepositoryPostgre
 	}
 }
 
-func (r *refreshTokenRepositoryPostgres) InvalidateCodes(ctx context.Context, accountId int64) error {
-	_, err := r.db.ExecContext(ctx, database.InvalidateRefreshTokensQuery, accountId)
+func (r *refreshTokenRepositoryPostgres) PostOneCode(ctx context.Context, accountId int64, sessionId string, code string) error {
+	_, err := r.db.ExecContext(ctx, database.PostOneRefreshTokenQuery, accountId, sessionId, code)
 	if err != nil {
 		return err
 	}
@@ -32,8 +35,28 @@ func (r *refreshTokenRepositoryPostgres) InvalidateCodes(ctx context.Context, ac
 	return nil
 }
 
-func (r *refreshTokenRepositoryPostgres) PostOneCode(ctx context.Context, accountId int64, code string) error {
-	_, err := r.db.ExecContext(ctx, database.PostOneRefreshTokenQuery, accountId, code)
+func (r *refreshTokenRepositoryPostgres) FindOneCodeForUpdate(ctx context.Context, refreshToken string) (*entity.RefreshToken, error) {
+	token := entity.RefreshToken{}
+	err := r.db.QueryRowContext(ctx, database.FindOneRefreshTokenForUpdateQuery, refreshToken).Scan(
+		&token.Id,
+		&token.AccountId,
+		&token.SessionId,
+		&token.ExpiredAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *refreshTokenRepositoryPostgres) MarkCodeUsed(ctx context.Context, refreshTokenId int64) error {
+	_, err := r.db.ExecContext(ctx, database.MarkRefreshTokenUsedQuery, refreshTokenId)
 	if err != nil {
 		return err
 	}
@@ -41,14 +64,20 @@ func (r *refreshTokenRepositoryPostgres) PostOneCode(ctx context.Context, accoun
 	return nil
 }
 
-func (r *refreshTokenRepositoryPostgres) FindOneCode(ctx context.Context, refreshToken string) (string, error) {
-	err := r.db.QueryRowContext(ctx, database.FindOneRefreshTokenQuery, refreshToken).Scan(&refreshToken)
+func (r *refreshTokenRepositoryPostgres) RevokeCodesBySessionId(ctx context.Context, sessionId string) error {
+	_, err := r.db.ExecContext(ctx, database.RevokeRefreshTokensBySessionIdQuery, sessionId)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return "", nil
-		}
-		return "", err
+		return err
 	}
 
-	return refreshToken, nil
+	return nil
+}
+
+func (r *refreshTokenRepositoryPostgres) RevokeCodesByAccountId(ctx context.Context, accountId int64) error {
+	_, err := r.db.ExecContext(ctx, database.RevokeRefreshTokensByAccountIdQuery, accountId)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type RoleRepository interface {
+	GetRoles(ctx context.Context) ([]entity.Role, error)
+	FindRoleById(ctx context.Context, roleId int64) (*entity.Role, error)
+	FindRoleByName(ctx context.Context, roleName string) (*entity.Role, error)
+	FindRoleByAccountId(ctx context.Context, accountId int64) (*entity.Role, error)
+	GetPermissions(ctx context.Context) ([]entity.Permission, error)
+	CountPermissionsByNames(ctx context.Context, permissionNames []string) (int, error)
+	ReplaceRolePermissions(ctx context.Context, roleId int64, permissionNames []string) error
+	UpdateAccountRole(ctx context.Context, accountId int64, roleId int64) error
+}
+
+type roleRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewRoleRepositoryPostgres(db *sql.DB) roleRepositoryPostgres {
+	return roleRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *roleRepositoryPostgres) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	roles := []entity.Role{}
+
+	rows, err := r.db.QueryContext(ctx, database.GetRolesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, *role)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	permissions, err := r.getRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range roles {
+		roles[i].Permissions = permissions[roles[i].Id]
+	}
+
+	return roles, nil
+}
+
+func (r *roleRepositoryPostgres) FindRoleById(ctx context.Context, roleId int64) (*entity.Role, error) {
+	return r.findOne(ctx, database.FindRoleByIdQuery, roleId)
+}
+
+func (r *roleRepositoryPostgres) FindRoleByName(ctx context.Context, roleName string) (*entity.Role, error) {
+	return r.findOne(ctx, database.FindRoleByNameQuery, roleName)
+}
+
+func (r *roleRepositoryPostgres) FindRoleByAccountId(ctx context.Context, accountId int64) (*entity.Role, error) {
+	return r.findOne(ctx, database.FindRoleByAccountIdQuery, accountId)
+}
+
+func (r *roleRepositoryPostgres) findOne(ctx context.Context, query string, arg any) (*entity.Role, error) {
+	role, err := scanRole(r.db.QueryRowContext(ctx, query, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	permissions, err := r.getRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role.Permissions = permissions[role.Id]
+
+	return role, nil
+}
+
+func (r *roleRepositoryPostgres) getRolePermissions(ctx context.Context) (map[int64][]string, error) {
+	permissions := map[int64][]string{}
+
+	rows, err := r.db.QueryContext(ctx, database.GetRolePermissionsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var roleId int64
+		var permission string
+
+		err := rows.Scan(&roleId, &permission)
+		if err != nil {
+			return nil, err
+		}
+		permissions[roleId] = append(permissions[roleId], permission)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (r *roleRepositoryPostgres) GetPermissions(ctx context.Context) ([]entity.Permission, error) {
+	permissions := []entity.Permission{}
+
+	rows, err := r.db.QueryContext(ctx, database.GetPermissionsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		permission := entity.Permission{}
+		err := rows.Scan(&permission.Id, &permission.Name, &permission.Description)
+		if err != nil {
+			return nil, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (r *roleRepositoryPostgres) CountPermissionsByNames(ctx context.Context, permissionNames []string) (int, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, database.CountPermissionsByNamesQuery, permissionNames).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *roleRepositoryPostgres) ReplaceRolePermissions(ctx context.Context, roleId int64, permissionNames []string) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteRolePermissionsQuery, roleId)
+	if err != nil {
+		return err
+	}
+
+	if len(permissionNames) == 0 {
+		return nil
+	}
+
+	_, err = r.db.ExecContext(ctx, database.CreateRolePermissionsQuery, roleId, permissionNames)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *roleRepositoryPostgres) UpdateAccountRole(ctx context.Context, accountId int64, roleId int64) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateAccountRoleQuery, accountId, roleId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func scanRole(row interface{ Scan(dest ...any) error }) (*entity.Role, error) {
+	role := entity.Role{}
+	err := row.Scan(&role.Id, &role.Name, &role.IsOperator, &role.IsTwoFactorRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type SickLeaveRepository interface {
+	CreateSickLeaveCertificate(ctx context.Context, certificate entity.SickLeaveCertificate) (int64, error)
+	FindSickLeaveCertificateById(ctx context.Context, sickLeaveId int64) (*entity.SickLeaveCertificate, error)
+	FindSickLeaveCertificateByVerificationCode(ctx context.Context, verificationCode string) (*entity.SickLeaveCertificate, error)
+	GetUserDocumentsByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.UserDocument, error)
+	GetUserDocumentsByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error)
+}
+
+type sickLeaveRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewSickLeaveRepositoryPostgres(db *sql.DB) sickLeaveRepositoryPostgres {
+	return sickLeaveRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *sickLeaveRepositoryPostgres) CreateSickLeaveCertificate(ctx context.Context, certificate entity.SickLeaveCertificate) (int64, error) {
+	var sickLeaveId int64
+
+	err := r.db.QueryRowContext(ctx, database.CreateSickLeaveCertificateQuery,
+		certificate.WsChatRoomId,
+		certificate.UserAccountId,
+		certificate.DoctorAccountId,
+		certificate.StartDate,
+		certificate.Days,
+		certificate.DiagnosisSummary,
+		certificate.VerificationCode,
+		certificate.Signature,
+	).Scan(&sickLeaveId)
+	if err != nil {
+		return 0, err
+	}
+
+	return sickLeaveId, nil
+}
+
+func (r *sickLeaveRepositoryPostgres) FindSickLeaveCertificateById(ctx context.Context, sickLeaveId int64) (*entity.SickLeaveCertificate, error) {
+	return r.findSickLeaveCertificate(ctx, database.FindSickLeaveCertificateByIdQuery, sickLeaveId)
+}
+
+func (r *sickLeaveRepositoryPostgres) FindSickLeaveCertificateByVerificationCode(ctx context.Context, verificationCode string) (*entity.SickLeaveCertificate, error) {
+	return r.findSickLeaveCertificate(ctx, database.FindSickLeaveCertificateByVerificationCodeQuery, verificationCode)
+}
+
+func (r *sickLeaveRepositoryPostgres) findSickLeaveCertificate(ctx context.Context, query string, arg interface{}) (*entity.SickLeaveCertificate, error) {
+	var certificate entity.SickLeaveCertificate
+	var specialization *string
+
+	err := r.db.QueryRowContext(ctx, query, arg).Scan(
+		&certificate.Id,
+		&certificate.WsChatRoomId,
+		&certificate.UserAccountId,
+		&certificate.PatientName,
+		&certificate.PatientGender,
+		&certificate.PatientDateOfBirth,
+		&certificate.DoctorAccountId,
+		&certificate.DoctorName,
+		&specialization,
+		&certificate.DoctorCertificate,
+		&certificate.StartDate,
+		&certificate.Days,
+		&certificate.DiagnosisSummary,
+		&certificate.VerificationCode,
+		&certificate.Signature,
+		&certificate.IssuedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if specialization != nil {
+		certificate.DoctorSpecialization = *specialization
+	}
+
+	return &certificate, nil
+}
+
+func (r *sickLeaveRepositoryPostgres) GetUserDocumentsByUserAccountId(ctx context.Context, userAccountId int64, limit, offset int) ([]entity.UserDocument, error) {
+	rows, err := r.db.QueryContext(ctx, database.GetUserDocumentsByUserAccountIdQuery, userAccountId, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var documentList []entity.UserDocument
+
+	for rows.Next() {
+		var document entity.UserDocument
+
+		err := rows.Scan(&document.Type, &document.Id, &document.DoctorName, &document.IssuedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		documentList = append(documentList, document)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return documentList, nil
+}
+
+func (r *sickLeaveRepositoryPostgres) GetUserDocumentsByUserAccountIdTotalItem(ctx context.Context, userAccountId int64) (int, error) {
+	var totalItem int
+
+	err := r.db.QueryRowContext(ctx, database.GetUserDocumentsByUserAccountIdTotalItemQuery, userAccountId).Scan(&totalItem)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalItem, nil
+}
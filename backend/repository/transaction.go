@@ -38,6 +38,19 @@ type Transaction interface {
 	PharmacyRepository() PharmacyRepository
 	PharmacyOperationalRepository() PharmacyOperationalRepository
 	PharmacyCourierRepository() PharmacyCourierRepository
+	PatientAllergyRepository() PatientAllergyRepository
+	PatientHealthProfileRepository() PatientHealthProfileRepository
+	ConsultationNoteRepository() ConsultationNoteRepository
+	AccountSessionRepository() AccountSessionRepository
+	TwoFactorRepository() TwoFactorRepository
+	RoleRepository() RoleRepository
+	OidcRepository() OidcRepository
+	DrugRepository() DrugRepository
+	AuditLogRepository() AuditLogRepository
+	AdminFileRepository() AdminFileRepository
+	EmailOutboxRepository() EmailOutboxRepository
+	NotificationRepository() NotificationRepository
+	DoctorReviewRepository() DoctorReviewRepository
 }
 
 type SqlTransaction struct {
@@ -195,3 +208,81 @@ func (s *SqlTransaction) PharmacyCourierRepository() PharmacyCourierRepository {
 		db: s.tx,
 	}
 }
+
+func (s *SqlTransaction) PatientAllergyRepository() PatientAllergyRepository {
+	return &patientAllergyRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) PatientHealthProfileRepository() PatientHealthProfileRepository {
+	return &patientHealthProfileRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) ConsultationNoteRepository() ConsultationNoteRepository {
+	return &consultationNoteRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) AccountSessionRepository() AccountSessionRepository {
+	return &accountSessionRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) TwoFactorRepository() TwoFactorRepository {
+	return &twoFactorRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) RoleRepository() RoleRepository {
+	return &roleRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) OidcRepository() OidcRepository {
+	return &oidcRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) DrugRepository() DrugRepository {
+	return &drugRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) AuditLogRepository() AuditLogRepository {
+	return &auditLogRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) AdminFileRepository() AdminFileRepository {
+	return &adminFileRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) EmailOutboxRepository() EmailOutboxRepository {
+	return &emailOutboxRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) NotificationRepository() NotificationRepository {
+	return &notificationRepositoryPostgres{
+		db: s.tx,
+	}
+}
+
+func (s *SqlTransaction) DoctorReviewRepository() DoctorReviewRepository {
+	return &doctorReviewRepositoryPostgres{
+		db: s.tx,
+	}
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"max-health/database"
+	"max-health/entity"
+)
+
+type TwoFactorRepository interface {
+	FindByAccountId(ctx context.Context, accountId int64) (*entity.TwoFactor, error)
+	FindByAccountIdForUpdate(ctx context.Context, accountId int64) (*entity.TwoFactor, error)
+	UpsertPending(ctx context.Context, accountId int64, secret string) error
+	Enable(ctx context.Context, accountId int64, step int64) error
+	UpdateLastUsedStep(ctx context.Context, accountId int64, step int64) error
+	Delete(ctx context.Context, accountId int64) error
+	ReplaceRecoveryCodes(ctx context.Context, accountId int64, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, accountId int64, codeHash string) (bool, error)
+	CountRemainingRecoveryCodes(ctx context.Context, accountId int64) (int, error)
+	DeleteRecoveryCodes(ctx context.Context, accountId int64) error
+}
+
+type twoFactorRepositoryPostgres struct {
+	db DBTX
+}
+
+func NewTwoFactorRepositoryPostgres(db *sql.DB) twoFactorRepositoryPostgres {
+	return twoFactorRepositoryPostgres{
+		db: db,
+	}
+}
+
+func (r *twoFactorRepositoryPostgres) FindByAccountId(ctx context.Context, accountId int64) (*entity.TwoFactor, error) {
+	return r.findOne(ctx, database.FindTwoFactorByAccountIdQuery, accountId)
+}
+
+func (r *twoFactorRepositoryPostgres) FindByAccountIdForUpdate(ctx context.Context, accountId int64) (*entity.TwoFactor, error) {
+	return r.findOne(ctx, database.FindTwoFactorByAccountIdForUpdateQuery, accountId)
+}
+
+func (r *twoFactorRepositoryPostgres) findOne(ctx context.Context, query string, accountId int64) (*entity.TwoFactor, error) {
+	twoFactor := entity.TwoFactor{}
+	err := r.db.QueryRowContext(ctx, query, accountId).Scan(
+		&twoFactor.AccountId,
+		&twoFactor.Secret,
+		&twoFactor.LastUsedStep,
+		&twoFactor.EnabledAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &twoFactor, nil
+}
+
+func (r *twoFactorRepositoryPostgres) UpsertPending(ctx context.Context, accountId int64, secret string) error {
+	_, err := r.db.ExecContext(ctx, database.UpsertPendingTwoFactorQuery, accountId, secret)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepositoryPostgres) Enable(ctx context.Context, accountId int64, step int64) error {
+	_, err := r.db.ExecContext(ctx, database.EnableTwoFactorQuery, accountId, step)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepositoryPostgres) UpdateLastUsedStep(ctx context.Context, accountId int64, step int64) error {
+	_, err := r.db.ExecContext(ctx, database.UpdateTwoFactorLastUsedStepQuery, accountId, step)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepositoryPostgres) Delete(ctx context.Context, accountId int64) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteTwoFactorQuery, accountId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepositoryPostgres) ReplaceRecoveryCodes(ctx context.Context, accountId int64, codeHashes []string) error {
+	err := r.DeleteRecoveryCodes(ctx, accountId)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, database.CreateRecoveryCodesQuery, accountId, codeHashes)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepositoryPostgres) UseRecoveryCode(ctx context.Context, accountId int64, codeHash string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, database.UseRecoveryCodeQuery, accountId, codeHash)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *twoFactorRepositoryPostgres) CountRemainingRecoveryCodes(ctx context.Context, accountId int64) (int, error) {
+	var count int
+
+	err := r.db.QueryRowContext(ctx, database.CountRemainingRecoveryCodesQuery, accountId).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *twoFactorRepositoryPostgres) DeleteRecoveryCodes(ctx context.Context, accountId int64) error {
+	_, err := r.db.ExecContext(ctx, database.DeleteRecoveryCodesQuery, accountId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
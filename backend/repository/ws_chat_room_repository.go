@@ -98,6 +98,7 @@ func (r *wsChatRoomRepositoryPostgres) GetAllRooms(ctx context.Context, accountI
 			&room.LastChat.Attachment.Url,
 			&room.ExpiredAt,
 			&room.LastChat.CreatedAt,
+			&room.UnreadCount,
 		)
 		if err != nil {
 			return nil, err
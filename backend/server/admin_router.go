@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http/pprof"
+
+	"max-health/appconstant"
+	"max-health/config"
+	"max-health/handler"
+	"max-health/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+type adminRouterOpts struct {
+	Maintenance *handler.MaintenanceHandler
+	EmailOutbox *handler.EmailOutboxHandler
+}
+
+func newAdminRouter(h adminRouterOpts, u utilOpts, config *config.Config, log *logrus.Logger) *gin.Engine {
+	router := gin.New()
+
+	err := router.SetTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	router.ContextWithFallback = true
+
+	router.Use(
+		middleware.Logger(log),
+		middleware.RequestIdHandlerMiddleware,
+		middleware.ErrorHandlerMiddleware,
+		gin.Recovery(),
+		middleware.AuthMiddleware(u.JwtHelper, u.SessionValidator),
+		middleware.RequirePermission(u.PermissionChecker, appconstant.PermissionMaintenanceManage),
+	)
+
+	router.NoRoute(handler.NotFoundHandler)
+	pprofRouting(router)
+	maintenanceRouting(router, h.Maintenance)
+	emailOutboxRouting(router, h.EmailOutbox)
+
+	return router
+}
+
+func pprofRouting(router *gin.Engine) {
+	pprofRouter := router.Group("/debug/pprof")
+
+	pprofRouter.GET("/", gin.WrapF(pprof.Index))
+	pprofRouter.GET("/cmdline", gin.WrapF(pprof.Cmdline))
+	pprofRouter.GET("/profile", gin.WrapF(pprof.Profile))
+	pprofRouter.GET("/symbol", gin.WrapF(pprof.Symbol))
+	pprofRouter.POST("/symbol", gin.WrapF(pprof.Symbol))
+	pprofRouter.GET("/trace", gin.WrapF(pprof.Trace))
+	pprofRouter.GET("/:profile", gin.WrapF(pprof.Index))
+}
+
+func maintenanceRouting(router *gin.Engine, handler *handler.MaintenanceHandler) {
+	router.GET("/metrics", handler.GetMetrics)
+
+	maintenanceRouter := router.Group("/maintenance")
+
+	maintenanceRouter.GET("/status", handler.GetStatus)
+	maintenanceRouter.PUT("/mode", handler.UpdateMaintenanceMode)
+	maintenanceRouter.POST("/purge-expired", handler.PurgeExpiredData)
+}
+
+func emailOutboxRouting(router *gin.Engine, handler *handler.EmailOutboxHandler) {
+	emailOutboxRouter := router.Group("/email-outbox")
+
+	emailOutboxRouter.GET("/dead-letters", handler.GetDeadLetters)
+	emailOutboxRouter.POST("/dead-letters/:email_outbox_id/requeue", handler.RequeueDeadLetter)
+}
@@ -0,0 +1,30 @@
+package server
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/usecase"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newEmailOutboxWorker(emailOutboxUsecase usecase.EmailOutboxUsecase, log *logrus.Logger) *pollingWorker {
+	return newPollingWorker("email_outbox", appconstant.EmailOutboxPollInterval, func(ctx context.Context) (bool, error) {
+		result, err := emailOutboxUsecase.SendDueEmails(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if result.Claimed > 0 {
+			log.WithFields(logrus.Fields{
+				"claimed":       result.Claimed,
+				"sent":          result.Sent,
+				"retrying":      result.Retrying,
+				"dead_lettered": result.DeadLettered,
+			}).Info("email outbox batch processed")
+		}
+
+		return result.Claimed == appconstant.EmailOutboxBatchSize, nil
+	}, log)
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/config"
+	"max-health/database"
+	"max-health/util"
+
+	"github.com/sirupsen/logrus"
+)
+
+func Migrate(args []string) {
+	log := util.NewLogger()
+
+	if len(args) < 1 {
+		log.Fatalf("usage: %s %s <%s|%s [steps]|%s|%s <version>>", os.Args[0], appconstant.MigrateCommand, appconstant.MigrateUpCommand, appconstant.MigrateDownCommand, appconstant.MigrateStatusCommand, appconstant.MigrateBaselineCommand)
+	}
+
+	config := config.Init(log)
+
+	db := database.ConnectDB(config, log)
+	defer db.Close()
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Fatal("error loading migrations")
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case appconstant.MigrateUpCommand:
+		migrations, err := migrator.Up(ctx)
+		for _, migration := range migrations {
+			log.Infof("applied migration %d_%s", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Fatal("error applying migrations")
+		}
+		if len(migrations) == 0 {
+			log.Info("database is up to date")
+		}
+	case appconstant.MigrateDownCommand:
+		steps := appconstant.MigrationDefaultDownStep
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				log.Fatalf("steps must be a positive integer")
+			}
+		}
+
+		migrations, err := migrator.Down(ctx, steps)
+		for _, migration := range migrations {
+			log.Infof("reverted migration %d_%s", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Fatal("error reverting migrations")
+		}
+		if len(migrations) == 0 {
+			log.Info("no migration to revert")
+		}
+	case appconstant.MigrateBaselineCommand:
+		if len(args) < 2 {
+			log.Fatalf("usage: %s %s %s <version>", os.Args[0], appconstant.MigrateCommand, appconstant.MigrateBaselineCommand)
+		}
+
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || version < 1 {
+			log.Fatalf("version must be a positive integer")
+		}
+
+		migrations, err := migrator.Baseline(ctx, version)
+		for _, migration := range migrations {
+			log.Infof("marked migration %d_%s as applied", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Fatal("error baselining migrations")
+		}
+		if len(migrations) == 0 {
+			log.Info("no migration to baseline")
+		}
+	case appconstant.MigrateStatusCommand:
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+				"error": err.Error(),
+			}).Fatal("error reading migration status")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		w.Flush()
+	default:
+		log.Fatalf("unknown migrate command %q", args[0])
+	}
+}
@@ -0,0 +1,29 @@
+package server
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/usecase"
+
+	"github.com/sirupsen/logrus"
+)
+
+func newNotificationPushWorker(notificationUsecase usecase.NotificationUsecase, log *logrus.Logger) *pollingWorker {
+	return newPollingWorker("notification_push", appconstant.NotificationPushPollInterval, func(ctx context.Context) (bool, error) {
+		result, err := notificationUsecase.PushPendingNotifications(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if result.Failed > 0 {
+			log.WithFields(logrus.Fields{
+				"claimed": result.Claimed,
+				"pushed":  result.Pushed,
+				"failed":  result.Failed,
+			}).Warn("some notifications could not be pushed")
+		}
+
+		return result.Claimed == appconstant.NotificationPushBatchSize, nil
+	}, log)
+}
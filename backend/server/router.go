@@ -1,6 +1,7 @@
 package server
 
 import (
+	"max-health/appconstant"
 	"max-health/appvalidator"
 	"max-health/config"
 	"max-health/database"
@@ -10,18 +11,21 @@ import (
 	"max-health/usecase"
 	"max-health/util"
 	"net/http"
-	"net/http/pprof"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 type routerOpts struct {
 	Ping               *handler.PingHandler
 	Authentication     *handler.AuthenticationHandler
+	Role               *handler.RoleHandler
+	AuditLog           *handler.AuditLogHandler
+	AdminFile          *handler.AdminFileHandler
+	Jwks               *handler.JwksHandler
 	User               *handler.UserHandler
 	Doctor             *handler.DoctorHandler
 	UserAddress        *handler.UserAddressHandler
@@ -41,14 +45,27 @@ type routerOpts struct {
 	Ws                 *handler.WsHandler
 	ChatRoom           *handler.ChatRoomHandler
 	Media              *handler.MediaHandler
-	Personal           *handler.PersonalHandler
+	DoctorReview       *handler.DoctorReviewHandler
+	PharmacyReview     *handler.PharmacyReviewHandler
+	HealthProfile      *handler.PatientHealthProfileHandler
+	ConsultationNote   *handler.ConsultationNoteHandler
+	SickLeave          *handler.SickLeaveHandler
+	Notification       *handler.NotificationHandler
+	OrderEvent         *handler.OrderEventHandler
 }
 
+type PermissionMiddleware func(permissions ...string) gin.HandlerFunc
+
 type utilOpts struct {
-	JwtHelper util.TokenAuthentication
+	JwtHelper         util.TokenAuthentication
+	SessionValidator  middleware.SessionValidator
+	RateLimitStore     util.RateLimitStore
+	PermissionChecker  middleware.PermissionChecker
+	MaintenanceChecker middleware.MaintenanceChecker
+	Metrics            *util.HttpMetrics
 }
 
-func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
+func createRouters(log *logrus.Logger, config *config.Config) (*gin.Engine, *gin.Engine, []*pollingWorker, *util.InMemoryOrderEventBroker) {
 	db := database.ConnectDB(config, log)
 
 	accountRepository := repository.NewAccountRepositoryPostgres(db)
@@ -58,6 +75,15 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 	verificationCodeRepository := repository.NewVerificationCodeRepositoryPostgres(db)
 	userAddressRepository := repository.NewUserAddressRepositoryPostgres(db)
 	refreshTokenRepository := repository.NewRefreshTokenRepositoryPostgres(db)
+	accountSessionRepository := repository.NewAccountSessionRepositoryPostgres(db)
+	twoFactorRepository := repository.NewTwoFactorRepositoryPostgres(db)
+	roleRepository := repository.NewRoleRepositoryPostgres(db)
+	oidcRepository := repository.NewOidcRepositoryPostgres(db)
+	auditLogRepository := repository.NewAuditLogRepositoryPostgres(db)
+	adminFileRepository := repository.NewAdminFileRepositoryPostgres(db)
+	maintenanceRepository := repository.NewMaintenanceRepositoryPostgres(db)
+	emailOutboxRepository := repository.NewEmailOutboxRepositoryPostgres(db)
+	notificationRepository := repository.NewNotificationRepositoryPostgres(db)
 	resetPasswordTokenRepository := repository.NewResetPasswordTokenRepositoryPostgres(db)
 	pharmacyManagerRepository := repository.NewpharmacyManagerRepositoryPostgres(db)
 	addressRepository := repository.NewAddressRepositoryPostgres(db)
@@ -77,13 +103,46 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 	orderItemRepository := repository.NewOrderItemRepositoryPostgres(db)
 	stockRepository := repository.NewStockChangeRepositoryPostgres(db)
 	wsChatRoomRepository := repository.NewWsChatRoomRepositoryPostgres(db)
+	chatReadStateRepository := repository.NewChatReadStateRepositoryPostgres(db)
+	drugInteractionRepository := repository.NewDrugInteractionRepositoryPostgres(db)
+	patientAllergyRepository := repository.NewPatientAllergyRepositoryPostgres(db)
+	patientHealthProfileRepository := repository.NewPatientHealthProfileRepositoryPostgres(db)
+	consultationNoteRepository := repository.NewConsultationNoteRepositoryPostgres(db)
+	sickLeaveRepository := repository.NewSickLeaveRepositoryPostgres(db)
+	doctorReviewRepository := repository.NewDoctorReviewRepositoryPostgres(db)
+	pharmacyReviewRepository := repository.NewPharmacyReviewRepositoryPostgres(db)
+	orderItemReviewRepository := repository.NewOrderItemReviewRepositoryPostgres(db)
 	transaction := repository.NewSqlTransaction(db)
-	emailHelper := util.NewEmailHelperIpl(config)
+	emailSender, err := util.NewEmailSender(config)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Fatal("error creating email sender")
+	}
+	emailTemplateRenderer, err := util.NewEmailTemplateRendererImpl(config)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Fatal("error loading email templates")
+	}
+	prescriptionDocumentHelper := util.NewPrescriptionDocumentHelperImpl(config)
+	sickLeaveDocumentHelper := util.NewSickLeaveDocumentHelperImpl(config)
+	jwtKeySet, err := util.LoadJwtKeySet(config.JwtKeysDir, config.JwtActiveKeyId)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Fatal("error loading jwt keys")
+	}
 	jwtAuthentication := util.JwtAuthentication{
 		Config: *config,
-		Method: jwt.SigningMethodHS256,
+		KeySet: jwtKeySet,
 	}
 	hashHelper := &util.HashHelperImpl{}
+	twoFactorHelper := util.NewTwoFactorHelperImpl(config)
+	oidcClient := util.NewOidcClientImpl(config)
+	centrifugoPublisher := util.NewCentrifugoPublisherImpl(config)
+	orderEventBroker := util.NewInMemoryOrderEventBroker()
+	metrics := util.NewHttpMetrics()
 	upgrader := websocket.Upgrader{
 		ReadBufferSize:  1024,
 		WriteBufferSize: 1024,
@@ -92,6 +151,12 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 		},
 	}
 
+	roleUsecase := usecase.NewRoleUsecaseImpl(transaction, &accountRepository, &roleRepository, hashHelper)
+	auditLogUsecase := usecase.NewAuditLogUsecaseImpl(&auditLogRepository)
+	adminFileUsecase := usecase.NewAdminFileUsecaseImpl(transaction, &adminFileRepository)
+	maintenanceUsecase := usecase.NewMaintenanceUsecaseImpl(transaction, &maintenanceRepository)
+	emailOutboxUsecase := usecase.NewEmailOutboxUsecaseImpl(&emailOutboxRepository, emailSender, &emailTemplateRenderer, config.EmailFrom)
+	notificationUsecase := usecase.NewNotificationUsecaseImpl(&notificationRepository, &centrifugoPublisher, jwtAuthentication)
 	authenticationUsecase := usecase.NewAuthenticationUsecaseImpl(usecase.AuthenticationUsecaseImplOpts{
 		DrugRepository:               &drugRepository,
 		AccountRepository:            &accountRepository,
@@ -101,10 +166,15 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 		VerificationCodeRepository:   &verificationCodeRepository,
 		RefreshTokenRepositoy:        &refreshTokenRepository,
 		ResetPasswordTokenRepository: &resetPasswordTokenRepository,
+		AccountSessionRepository:     &accountSessionRepository,
+		TwoFactorRepository:          &twoFactorRepository,
+		RoleRepository:               &roleRepository,
+		OidcRepository:               &oidcRepository,
 		Transaction:                  transaction,
 		HashHelper:                   hashHelper,
 		JwtHelper:                    jwtAuthentication,
-		EmailHelper:                  &emailHelper,
+		TwoFactorHelper:              &twoFactorHelper,
+		OidcClient:                   &oidcClient,
 	})
 	userUsecase := usecase.NewUserUsecaseImpl(&accountRepository, transaction, &userRepository, &userAddressRepository, &util.HashHelperImpl{})
 	doctorUsecase := usecase.NewDoctorUsecaseImpl(&accountRepository, &doctorRepository, &doctorSpecializationRepository, transaction, &util.HashHelperImpl{})
@@ -114,7 +184,7 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 		PharmacyManagerRepository: &pharmacyManagerRepository,
 		Transaction:               transaction,
 		HashHelper:                hashHelper,
-		EmailHelper:               &emailHelper,
+		JwtHelper:                 jwtAuthentication,
 	})
 	addressUsecase := usecase.NewAddressUsecaseImpl(&addressRepository)
 	categoryUsecase := usecase.NewCategoryUsecaseImpl(&categoryRepository)
@@ -132,20 +202,34 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 		&userAddressRepository,
 		&pharmacyRepository,
 		transaction,
+		&prescriptionDocumentHelper,
 	)
 	pharmacyUsecase := usecase.NewPharmacyUsecaseImpl(&pharmacyManagerRepository, &pharmacyRepository, &drugPharmacyRepository, &addressRepository, &courierRepository, &orderPharmacyRepository, transaction)
-	cartUsecase := usecase.NewCartUsecaseImpl(&drugPharmacyRepository, &userRepository, &userAddressRepository, &cartRepository)
-	orderUsecase := usecase.NewOrderUsecaseImpl(transaction, &userRepository, &orderRepository, &orderPharmacyRepository)
-	orderPharmacyUsecase := usecase.NewOrderPharmacyUsecaseImpl(transaction, &orderPharmacyRepository, &orderItemRepository, &userRepository, &pharmacyManagerRepository)
-	reportUsecase := usecase.NewreportUsecaseImpl(&orderItemRepository, &pharmacyRepository, &pharmacyManagerRepository)
+	cartUsecase := usecase.NewCartUsecaseImpl(&drugPharmacyRepository, &userRepository, &userAddressRepository, &cartRepository, &prescriptionDrugRepository)
+	orderUsecase := usecase.NewOrderUsecaseImpl(transaction, &userRepository, &orderRepository, &orderPharmacyRepository, orderEventBroker)
+	orderPharmacyUsecase := usecase.NewOrderPharmacyUsecaseImpl(transaction, &orderPharmacyRepository, &orderItemRepository, &userRepository, &pharmacyManagerRepository, orderEventBroker)
+	orderEventUsecase := usecase.NewOrderEventUsecaseImpl(&userRepository, &pharmacyManagerRepository, orderEventBroker)
+	reportUsecase := usecase.NewreportUsecaseImpl(&orderItemRepository, &pharmacyRepository, &pharmacyManagerRepository, &pharmacyReviewRepository)
 	stockUsecase := usecase.NewStockUsecaseImpl(&stockRepository, &pharmacyManagerRepository)
-	wsUsecase := usecase.NewWsUsecaseImpl(wsChatRoomRepository, &prescriptionRepository, &prescriptionDrugRepository, &chatRepository, jwtAuthentication, transaction)
-	chatRoomUsecase := usecase.NewChatRoomUsecaseImpl(&userRepository, &doctorRepository, wsChatRoomRepository, &accountRepository, &chatRepository, &prescriptionDrugRepository)
+	patientHealthProfileUsecase := usecase.NewPatientHealthProfileUsecaseImpl(transaction, &userRepository, wsChatRoomRepository, &patientAllergyRepository, &patientHealthProfileRepository)
+	prescriptionValidationUsecase := usecase.NewPrescriptionValidationUsecaseImpl(&drugRepository, &drugInteractionRepository, &patientAllergyRepository, &patientHealthProfileRepository)
+	wsUsecase := usecase.NewWsUsecaseImpl(wsChatRoomRepository, &prescriptionRepository, &prescriptionDrugRepository, &chatRepository, &chatReadStateRepository, &sickLeaveRepository, prescriptionValidationUsecase, &prescriptionDocumentHelper, jwtAuthentication, transaction)
+	chatRoomUsecase := usecase.NewChatRoomUsecaseImpl(&userRepository, &doctorRepository, wsChatRoomRepository, &accountRepository, &chatRepository, &prescriptionDrugRepository, &patientHealthProfileUsecase, &notificationRepository)
 	mediaUsecase := usecase.NewMediaUsecaseImpl()
-	personalUsecase := usecase.NewPersonalUsecaseImpl()
+	doctorReviewUsecase := usecase.NewDoctorReviewUsecaseImpl(transaction, &doctorRepository, wsChatRoomRepository, &doctorReviewRepository)
+	consultationNoteUsecase := usecase.NewConsultationNoteUsecaseImpl(transaction, &accountRepository, &userRepository, wsChatRoomRepository, &consultationNoteRepository, &prescriptionRepository, &prescriptionDrugRepository, &patientHealthProfileUsecase)
+	sickLeaveUsecase := usecase.NewSickLeaveUsecaseImpl(wsChatRoomRepository, &sickLeaveRepository, &sickLeaveDocumentHelper)
+	pharmacyReviewUsecase := usecase.NewPharmacyReviewUsecaseImpl(&userRepository, &pharmacyManagerRepository, &pharmacyRepository, &drugRepository, &pharmacyReviewRepository, &orderItemReviewRepository)
 
 	pingHandler := handler.NewPingHandler(handler.PingHandlerOpts{})
 	authenticationHandler := handler.NewAuthenticationHandler(&authenticationUsecase)
+	roleHandler := handler.NewRoleHandler(&roleUsecase)
+	auditLogHandler := handler.NewAuditLogHandler(&auditLogUsecase)
+	adminFileHandler := handler.NewAdminFileHandler(&adminFileUsecase)
+	maintenanceHandler := handler.NewMaintenanceHandler(&maintenanceUsecase, metrics)
+	emailOutboxHandler := handler.NewEmailOutboxHandler(&emailOutboxUsecase)
+	notificationHandler := handler.NewNotificationHandler(&notificationUsecase)
+	jwksHandler := handler.NewJwksHandler(jwtAuthentication)
 	userHandler := handler.NewUserHandler(&userUsecase)
 	doctorHandler := handler.NewDoctorHandler(&doctorUsecase)
 	userAddressHandler := handler.NewUserAddressHandler(&userAddressUsecase)
@@ -160,17 +244,35 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 	orderHandler := handler.NewOrderHandler(&orderUsecase)
 	pharmacyHandler := handler.NewPharmacyHandler(&pharmacyUsecase)
 	orderPharmacyHandler := handler.NewOrderPharmacyHandler(&orderPharmacyUsecase)
+	orderEventHandler := handler.NewOrderEventHandler(&orderEventUsecase)
 	reportHandler := handler.NewReportHandler(&reportUsecase)
 	stockHandler := handler.NewStockHandler(&stockUsecase)
-	wsHandler := handler.NewWsHandler(wsUsecase, upgrader, log)
+	wsHandler := handler.NewWsHandler(wsUsecase, upgrader)
 	mediaHandler := handler.NewMediaHandler(mediaUsecase)
 	chatRoomHandler := handler.NewChatRoomHandler(chatRoomUsecase)
-	personalHandler := handler.NewPersonalHandler(personalUsecase)
+	doctorReviewHandler := handler.NewDoctorReviewHandler(&doctorReviewUsecase)
+	pharmacyReviewHandler := handler.NewPharmacyReviewHandler(&pharmacyReviewUsecase)
+	healthProfileHandler := handler.NewPatientHealthProfileHandler(&patientHealthProfileUsecase)
+	consultationNoteHandler := handler.NewConsultationNoteHandler(&consultationNoteUsecase)
+	sickLeaveHandler := handler.NewSickLeaveHandler(&sickLeaveUsecase)
+
+	routerUtilOpts := utilOpts{
+		JwtHelper:          jwtAuthentication,
+		SessionValidator:   &authenticationUsecase,
+		RateLimitStore:     util.NewInMemoryRateLimitStore(),
+		PermissionChecker:  &roleUsecase,
+		MaintenanceChecker: &maintenanceUsecase,
+		Metrics:            metrics,
+	}
 
-	return newRouter(
+	router := newRouter(
 		routerOpts{
 			Ping:               pingHandler,
 			Authentication:     &authenticationHandler,
+			Role:               &roleHandler,
+			AuditLog:           &auditLogHandler,
+			AdminFile:          &adminFileHandler,
+			Jwks:               &jwksHandler,
 			User:               &userHandler,
 			UserAddress:        &userAddressHandler,
 			Doctor:             &doctorHandler,
@@ -190,19 +292,45 @@ func createRouter(log *logrus.Logger, config *config.Config) *gin.Engine {
 			Ws:                 wsHandler,
 			ChatRoom:           chatRoomHandler,
 			Media:              mediaHandler,
-			Personal:           personalHandler,
+			DoctorReview:       &doctorReviewHandler,
+			PharmacyReview:     &pharmacyReviewHandler,
+			HealthProfile:      &healthProfileHandler,
+			ConsultationNote:   &consultationNoteHandler,
+			SickLeave:          &sickLeaveHandler,
+			Notification:       &notificationHandler,
+			OrderEvent:         &orderEventHandler,
 		},
-		utilOpts{
-			JwtHelper: jwtAuthentication,
+		routerUtilOpts,
+		config,
+		log,
+	)
+
+	adminRouter := newAdminRouter(
+		adminRouterOpts{
+			Maintenance: &maintenanceHandler,
+			EmailOutbox: &emailOutboxHandler,
 		},
+		routerUtilOpts,
 		config,
 		log,
 	)
+
+	workers := []*pollingWorker{
+		newEmailOutboxWorker(&emailOutboxUsecase, log),
+		newNotificationPushWorker(&notificationUsecase, log),
+	}
+
+	return router, adminRouter, workers, orderEventBroker
 }
 
 func newRouter(h routerOpts, u utilOpts, config *config.Config, log *logrus.Logger) *gin.Engine {
 	router := gin.New()
 
+	err := router.SetTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	corsConfig := cors.DefaultConfig()
 
 	router.ContextWithFallback = true
@@ -210,74 +338,111 @@ func newRouter(h routerOpts, u utilOpts, config *config.Config, log *logrus.Logg
 	appvalidator.AppValidator()
 
 	router.Use(
+		otelgin.Middleware(config.OtelServiceName),
 		middleware.Logger(log),
 		middleware.RequestIdHandlerMiddleware,
+		middleware.LocaleMiddleware,
+		middleware.MetricsMiddleware(u.Metrics),
 		middleware.ErrorHandlerMiddleware,
 		gin.Recovery(),
+		middleware.MaintenanceMiddleware(u.MaintenanceChecker),
 	)
 
-	authMiddleware := middleware.AuthMiddleware(u.JwtHelper, config)
+	authMiddleware := middleware.AuthMiddleware(u.JwtHelper, u.SessionValidator)
 
-	userAuthorizationMiddleware := middleware.UserAuthorizationMiddleware
-	doctorAuthorizationMiddleware := middleware.DoctorAuthorizationMiddleware
-	pharmacyManagerAuthorizationMiddleware := middleware.PharmacyManagerAuthorizationMiddleware
-	adminAuthorizationMiddleware := middleware.AdminAuthorizationMiddleware
+	requirePermission := func(permissions ...string) gin.HandlerFunc {
+		return middleware.RequirePermission(u.PermissionChecker, permissions...)
+	}
 
-	personalAuthMiddleware := middleware.PersonalAuthMiddleware(config)
+	loginRateLimitMiddleware := middleware.RateLimitMiddleware(u.RateLimitStore, util.RateLimitRule{
+		Name:          appconstant.LoginRateLimitName,
+		IpLimit:       appconstant.LoginIpRateLimit,
+		IdentityLimit: appconstant.LoginIdentityRateLimit,
+		Window:        appconstant.LoginRateLimitWindow,
+	})
+	verificationRateLimitMiddleware := middleware.RateLimitMiddleware(u.RateLimitStore, util.RateLimitRule{
+		Name:          appconstant.VerificationRateLimitName,
+		IpLimit:       appconstant.VerificationIpRateLimit,
+		IdentityLimit: appconstant.VerificationIdentityRateLimit,
+		Window:        appconstant.VerificationRateLimitWindow,
+	})
+	resetPasswordRateLimitMiddleware := middleware.RateLimitMiddleware(u.RateLimitStore, util.RateLimitRule{
+		Name:          appconstant.ResetPasswordRateLimitName,
+		IpLimit:       appconstant.ResetPasswordIpRateLimit,
+		IdentityLimit: appconstant.ResetPasswordIdentityRateLimit,
+		Window:        appconstant.ResetPasswordRateLimitWindow,
+	})
 
 	corsRouting(router, corsConfig, config)
 	router.NoRoute(handler.NotFoundHandler)
-	authenticationRouting(router, h.Authentication)
+	twoFactorRateLimitMiddleware := middleware.RateLimitMiddleware(u.RateLimitStore, util.RateLimitRule{
+		Name:          appconstant.TwoFactorRateLimitName,
+		IpLimit:       appconstant.TwoFactorIpRateLimit,
+		IdentityLimit: appconstant.TwoFactorAccountRateLimit,
+		Window:        appconstant.TwoFactorRateLimitWindow,
+		Identity:      middleware.TwoFactorChallengeIdentity(u.JwtHelper),
+	})
+
+	authenticationRouting(router, h.Authentication, authMiddleware, requirePermission, loginRateLimitMiddleware, verificationRateLimitMiddleware, resetPasswordRateLimitMiddleware, twoFactorRateLimitMiddleware)
+	roleRouting(router, h.Role, authMiddleware, requirePermission)
+	auditLogRouting(router, h.AuditLog, authMiddleware, requirePermission)
+	adminFileRouting(router, h.AdminFile, authMiddleware, requirePermission)
+	jwksRouting(router, h.Jwks)
 	addressRouting(router, h.Address)
-	doctorRouting(router, h.Doctor, authMiddleware, doctorAuthorizationMiddleware)
-	userRouting(router, h.User, authMiddleware, userAuthorizationMiddleware)
-	userAddressRouting(router, h.UserAddress, authMiddleware, userAuthorizationMiddleware)
-	partnerRouting(router, h.Partner, authMiddleware, adminAuthorizationMiddleware)
-	drugRouting(router, h.Drug, authMiddleware, adminAuthorizationMiddleware, pharmacyManagerAuthorizationMiddleware)
+	doctorRouting(router, h.Doctor, authMiddleware, requirePermission)
+	userRouting(router, h.User, authMiddleware, requirePermission)
+	userAddressRouting(router, h.UserAddress, authMiddleware, requirePermission)
+	partnerRouting(router, h.Partner, authMiddleware, requirePermission)
+	drugRouting(router, h.Drug, authMiddleware, requirePermission)
 	drugFormRouting(router, h.DrugForm)
 	drugClassificationRouting(router, h.DrugClassification)
-	pharmacyRouting(router, h.Pharmacy, authMiddleware, pharmacyManagerAuthorizationMiddleware, adminAuthorizationMiddleware)
-	categoryRouting(router, h.Category, authMiddleware, adminAuthorizationMiddleware)
-	cartRouting(router, h.Cart, authMiddleware, userAuthorizationMiddleware)
-	telemedicineRouting(router, h.Telemedicine, authMiddleware, userAuthorizationMiddleware)
-	orderRouting(router, h.Order, authMiddleware, userAuthorizationMiddleware, adminAuthorizationMiddleware)
-	orderPharmacyRouting(router, h.OrderPharmacy, authMiddleware, pharmacyManagerAuthorizationMiddleware, userAuthorizationMiddleware, adminAuthorizationMiddleware)
-	reportRouting(router, h.Report, authMiddleware, pharmacyManagerAuthorizationMiddleware, adminAuthorizationMiddleware)
-	stockRouting(router, h.Stock, authMiddleware, pharmacyManagerAuthorizationMiddleware)
+	pharmacyRouting(router, h.Pharmacy, authMiddleware, requirePermission)
+	categoryRouting(router, h.Category, authMiddleware, requirePermission)
+	cartRouting(router, h.Cart, authMiddleware, requirePermission)
+	telemedicineRouting(router, h.Telemedicine, authMiddleware, requirePermission)
+	orderRouting(router, h.Order, authMiddleware, requirePermission)
+	orderPharmacyRouting(router, h.OrderPharmacy, authMiddleware, requirePermission)
+	orderEventRouting(router, h.OrderEvent, authMiddleware)
+	reportRouting(router, h.Report, authMiddleware, requirePermission)
+	stockRouting(router, h.Stock, authMiddleware, requirePermission)
 	wsRouting(router, h.Ws, authMiddleware)
-	chatRoomRouting(router, h.ChatRoom, authMiddleware, userAuthorizationMiddleware, doctorAuthorizationMiddleware)
+	notificationRouting(router, h.Notification, authMiddleware)
+	chatRoomRouting(router, h.ChatRoom, authMiddleware, requirePermission)
 	mediaRouting(router, h.Media, authMiddleware)
-	personalRouting(router, h.Personal, personalAuthMiddleware)
-	pingRouting(router, h.Ping, authMiddleware, userAuthorizationMiddleware, doctorAuthorizationMiddleware, pharmacyManagerAuthorizationMiddleware, adminAuthorizationMiddleware)
-	pprofRouting(router)
+	doctorReviewRouting(router, h.DoctorReview, authMiddleware, requirePermission)
+	pharmacyReviewRouting(router, h.PharmacyReview, authMiddleware, requirePermission)
+	healthProfileRouting(router, h.HealthProfile, authMiddleware, requirePermission)
+	consultationNoteRouting(router, h.ConsultationNote, authMiddleware, requirePermission)
+	sickLeaveRouting(router, h.SickLeave, authMiddleware, requirePermission)
+	pingRouting(router, h.Ping, authMiddleware, requirePermission)
 
 	return router
 }
 
-func userAddressRouting(router *gin.Engine, handler *handler.UserAddressHandler, authMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc) {
-	router.POST("/address", authMiddleware, userAuthorizationMiddleware, handler.AddUserAddress)
-	router.POST("/address/autofill", authMiddleware, userAuthorizationMiddleware, handler.AddUserAddressAutofill)
-	router.PUT("/address/:address_id", authMiddleware, userAuthorizationMiddleware, handler.UpdateUserAddress)
-	router.GET("/address", authMiddleware, userAuthorizationMiddleware, handler.GetAllUserAddress)
-	router.DELETE("/address/:address_id", authMiddleware, userAuthorizationMiddleware, handler.DeleteUserAddress)
+func userAddressRouting(router *gin.Engine, handler *handler.UserAddressHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.POST("/address", authMiddleware, requirePermission(appconstant.PermissionAddressesManage), handler.AddUserAddress)
+	router.POST("/address/autofill", authMiddleware, requirePermission(appconstant.PermissionAddressesManage), handler.AddUserAddressAutofill)
+	router.PUT("/address/:address_id", authMiddleware, requirePermission(appconstant.PermissionAddressesManage), handler.UpdateUserAddress)
+	router.GET("/address", authMiddleware, requirePermission(appconstant.PermissionAddressesManage), handler.GetAllUserAddress)
+	router.DELETE("/address/:address_id", authMiddleware, requirePermission(appconstant.PermissionAddressesManage), handler.DeleteUserAddress)
 }
 
-func drugRouting(router *gin.Engine, handler *handler.DrugHandler, authMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc, pharmacyManagerAuthorizationMiddleware gin.HandlerFunc) {
+func drugRouting(router *gin.Engine, handler *handler.DrugHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	router.GET("/drugs/:drug_id", handler.GetPharmacyDrugByDrugId)
 	router.GET("/drugs", handler.GetAllDrugsForListing)
 
-	router.GET("/admin/drugs", authMiddleware, handler.GetAllDrugs)
-	router.GET("/admin/drugs/:drug_id", authMiddleware, adminAuthorizationMiddleware, handler.GetDrugByDrugId)
-	router.PUT("/admin/drugs/:drug_id", authMiddleware, adminAuthorizationMiddleware, handler.UpdateOneDrug)
-	router.POST("/admin/drugs", authMiddleware, adminAuthorizationMiddleware, handler.CreateOneDrug)
-	router.DELETE("/admin/drugs/:drug_id", authMiddleware, adminAuthorizationMiddleware, handler.DeleteOneDrug)
-
-	router.GET("/managers/pharmacies/:pharmacy_id/drugs", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetDrugsByPharmacyId)
-	router.PATCH("/managers/pharmacies/drugs/:pharmacy_drug_id", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.UpdateDrugsByPharmacyDrugId)
-	router.DELETE("/managers/pharmacies/drugs/:pharmacy_drug_id", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.DeleteDrugsByPharmacyDrugId)
-	router.POST("/managers/pharmacies/drugs", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.AddDrugsByPharmacyManager)
-	router.GET("/managers/pharmacies/drugs/:pharmacy_drug_id/mutation", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetPossibleStockMutation)
-	router.POST("/managers/pharmacies/drugs/:pharmacy_drug_id/mutation", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.PostStockMutation)
+	router.GET("/admin/drugs", authMiddleware, requirePermission(appconstant.PermissionDrugsRead), handler.GetAllDrugs)
+	router.GET("/admin/drugs/:drug_id", authMiddleware, requirePermission(appconstant.PermissionDrugsRead), handler.GetDrugByDrugId)
+	router.PUT("/admin/drugs/:drug_id", authMiddleware, requirePermission(appconstant.PermissionDrugsWrite), handler.UpdateOneDrug)
+	router.POST("/admin/drugs", authMiddleware, requirePermission(appconstant.PermissionDrugsWrite), handler.CreateOneDrug)
+	router.DELETE("/admin/drugs/:drug_id", authMiddleware, requirePermission(appconstant.PermissionDrugsWrite), handler.DeleteOneDrug)
+
+	router.GET("/managers/pharmacies/:pharmacy_id/drugs", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.GetDrugsByPharmacyId)
+	router.PATCH("/managers/pharmacies/drugs/:pharmacy_drug_id", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.UpdateDrugsByPharmacyDrugId)
+	router.DELETE("/managers/pharmacies/drugs/:pharmacy_drug_id", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.DeleteDrugsByPharmacyDrugId)
+	router.POST("/managers/pharmacies/drugs", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.AddDrugsByPharmacyManager)
+	router.GET("/managers/pharmacies/drugs/:pharmacy_drug_id/mutation", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.GetPossibleStockMutation)
+	router.POST("/managers/pharmacies/drugs/:pharmacy_drug_id/mutation", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.PostStockMutation)
 }
 
 func drugFormRouting(router *gin.Engine, handler *handler.DrugFormHandler) {
@@ -288,43 +453,47 @@ func drugClassificationRouting(router *gin.Engine, handler *handler.DrugClassifi
 	router.GET("/drugs/classifications", handler.GetAllDrugClassification)
 }
 
-func userRouting(router *gin.Engine, handler *handler.UserHandler, authMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc) {
+func userRouting(router *gin.Engine, handler *handler.UserHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	userRouter := router.Group("/users")
-	userRouter.PATCH("/profile", authMiddleware, userAuthorizationMiddleware, handler.UpdateData)
-	userRouter.GET("/profile", authMiddleware, userAuthorizationMiddleware, handler.GetProfile)
+	userRouter.PATCH("/profile", authMiddleware, requirePermission(appconstant.PermissionUsersProfile), handler.UpdateData)
+	userRouter.GET("/profile", authMiddleware, requirePermission(appconstant.PermissionUsersProfile), handler.GetProfile)
 }
 
-func doctorRouting(router *gin.Engine, handler *handler.DoctorHandler, authMiddleware gin.HandlerFunc, doctorAuthorizationMiddleware gin.HandlerFunc) {
+func doctorRouting(router *gin.Engine, handler *handler.DoctorHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	doctorRouter := router.Group("/doctors")
-	doctorRouter.PATCH("/profile", authMiddleware, doctorAuthorizationMiddleware, handler.UpdateData)
+	doctorRouter.PATCH("/profile", authMiddleware, requirePermission(appconstant.PermissionDoctorsProfile), handler.UpdateData)
 	doctorRouter.GET("", handler.GetAllDoctors)
 	doctorRouter.GET("/specializations", handler.GetAllDoctorSpecialization)
-	doctorRouter.GET("/profile", authMiddleware, doctorAuthorizationMiddleware, handler.GetProfile)
+	doctorRouter.GET("/profile", authMiddleware, requirePermission(appconstant.PermissionDoctorsProfile), handler.GetProfile)
 	doctorRouter.GET(":doctor_id", handler.GetProfileForPublic)
-	doctorRouter.PATCH("/availability", authMiddleware, doctorAuthorizationMiddleware, handler.UpdateDoctorStatus)
-	doctorRouter.GET("/availability", authMiddleware, doctorAuthorizationMiddleware, handler.GetDoctorIsOnline)
+	doctorRouter.PATCH("/availability", authMiddleware, requirePermission(appconstant.PermissionDoctorsProfile), handler.UpdateDoctorStatus)
+	doctorRouter.GET("/availability", authMiddleware, requirePermission(appconstant.PermissionDoctorsProfile), handler.GetDoctorIsOnline)
+
+	router.GET("/admin/doctors", authMiddleware, requirePermission(appconstant.PermissionDoctorsVerify), handler.GetAllDoctorVerifications)
+	router.GET("/admin/doctors/:doctor_id", authMiddleware, requirePermission(appconstant.PermissionDoctorsVerify), handler.GetDoctorVerification)
+	router.PATCH("/admin/doctors/:doctor_id/verification", authMiddleware, requirePermission(appconstant.PermissionDoctorsVerify), handler.UpdateDoctorVerification)
 }
 
-func partnerRouting(router *gin.Engine, handler *handler.PartnerHandler, authMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
+func partnerRouting(router *gin.Engine, handler *handler.PartnerHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	partnerRouter := router.Group("/partners")
 
-	partnerRouter.POST("", authMiddleware, adminAuthorizationMiddleware, handler.AddPartner)
-	partnerRouter.POST("/access-details", authMiddleware, adminAuthorizationMiddleware, handler.SendCredentialsEmail)
-	partnerRouter.GET("", authMiddleware, adminAuthorizationMiddleware, handler.GetAllPartners)
-	partnerRouter.PATCH("/:pharmacy_manager_id", authMiddleware, adminAuthorizationMiddleware, handler.UpdatePartner)
-	partnerRouter.DELETE("/:pharmacy_manager_id", authMiddleware, adminAuthorizationMiddleware, handler.DeletePartner)
+	partnerRouter.POST("", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.AddPartner)
+	partnerRouter.POST("/access-details", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.SendCredentialsEmail)
+	partnerRouter.GET("", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.GetAllPartners)
+	partnerRouter.PATCH("/:pharmacy_manager_id", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.UpdatePartner)
+	partnerRouter.DELETE("/:pharmacy_manager_id", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.DeletePartner)
 }
 
-func pharmacyRouting(router *gin.Engine, handler *handler.PharmacyHandler, authMiddleware gin.HandlerFunc, pharmacyManagerAuthorizationMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
-	router.GET("/managers/pharmacies", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetPharmacyByManagerId)
-	router.PUT("/pharmacies/:pharmacy_id", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.UpdateOnePharmacy)
-	router.DELETE("/pharmacies/:pharmacy_id", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.DeleteOnePharmacy)
-	router.POST("/pharmacies", authMiddleware, adminAuthorizationMiddleware, handler.CreateOnePharmacy)
-	router.GET("/admin/manager/:pharmacy_manager_id/pharmacies", authMiddleware, adminAuthorizationMiddleware, handler.AdminGetPharmacyByManagerId)
+func pharmacyRouting(router *gin.Engine, handler *handler.PharmacyHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.GET("/managers/pharmacies", authMiddleware, requirePermission(appconstant.PermissionPharmaciesManage), handler.GetPharmacyByManagerId)
+	router.PUT("/pharmacies/:pharmacy_id", authMiddleware, requirePermission(appconstant.PermissionPharmaciesManage), handler.UpdateOnePharmacy)
+	router.DELETE("/pharmacies/:pharmacy_id", authMiddleware, requirePermission(appconstant.PermissionPharmaciesManage), handler.DeleteOnePharmacy)
+	router.POST("/pharmacies", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.CreateOnePharmacy)
+	router.GET("/admin/manager/:pharmacy_manager_id/pharmacies", authMiddleware, requirePermission(appconstant.PermissionPartnersManage), handler.AdminGetPharmacyByManagerId)
 }
 
-func stockRouting(router *gin.Engine, handler *handler.StockHandler, authMiddleware gin.HandlerFunc, pharmacyManagerAuthorizationMiddleware gin.HandlerFunc) {
-	router.GET("/managers/stock-change", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetAllStockChanges)
+func stockRouting(router *gin.Engine, handler *handler.StockHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.GET("/managers/stock-change", authMiddleware, requirePermission(appconstant.PermissionStockManage), handler.GetAllStockChanges)
 }
 
 func addressRouting(router *gin.Engine, handler *handler.AddressHandler) {
@@ -334,11 +503,13 @@ func addressRouting(router *gin.Engine, handler *handler.AddressHandler) {
 	router.GET("/subdistricts", handler.GetAllSubdistrictsByDistrictCode)
 }
 
-func telemedicineRouting(router *gin.Engine, handler *handler.TelemedicineHandler, authMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc) {
-	router.PATCH("/prescriptions/:prescription_id", authMiddleware, userAuthorizationMiddleware, handler.SavePrescription)
-	router.GET("/prescriptions", authMiddleware, userAuthorizationMiddleware, handler.GetAllPrescriptions)
-	router.GET("/prescriptions/:prescription_id", authMiddleware, userAuthorizationMiddleware, handler.PreapereForCheckout)
-	router.POST("/prescriptions/checkout", authMiddleware, userAuthorizationMiddleware, handler.CheckoutFromPrescription)
+func telemedicineRouting(router *gin.Engine, handler *handler.TelemedicineHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.PATCH("/prescriptions/:prescription_id", authMiddleware, requirePermission(appconstant.PermissionPrescriptionsRedeem), handler.SavePrescription)
+	router.GET("/prescriptions", authMiddleware, requirePermission(appconstant.PermissionPrescriptionsRedeem), handler.GetAllPrescriptions)
+	router.GET("/prescriptions/:prescription_id", authMiddleware, requirePermission(appconstant.PermissionPrescriptionsRedeem), handler.PreapereForCheckout)
+	router.POST("/prescriptions/checkout", authMiddleware, requirePermission(appconstant.PermissionPrescriptionsRedeem), handler.CheckoutFromPrescription)
+	router.GET("/prescriptions/:prescription_id/document", authMiddleware, handler.GetPrescriptionDocument)
+	router.GET("/prescriptions/verify/:verification_code", handler.VerifyPrescription)
 }
 
 func wsRouting(router *gin.Engine, handler *handler.WsHandler, authMiddleware gin.HandlerFunc) {
@@ -346,20 +517,50 @@ func wsRouting(router *gin.Engine, handler *handler.WsHandler, authMiddleware gi
 	router.GET("/ws/chat-room", handler.ConnectToRoom)
 }
 
+func notificationRouting(router *gin.Engine, handler *handler.NotificationHandler, authMiddleware gin.HandlerFunc) {
+	notificationRouter := router.Group("/notifications")
+
+	notificationRouter.GET("", authMiddleware, handler.GetNotifications)
+	notificationRouter.PATCH("/read", authMiddleware, handler.MarkNotificationsRead)
+	notificationRouter.POST("/token", authMiddleware, handler.GenerateToken)
+}
+
 func mediaRouting(router *gin.Engine, handler *handler.MediaHandler, authMiddleware gin.HandlerFunc) {
 	mediaRouter := router.Group("/media")
 
 	mediaRouter.POST("/upload", authMiddleware, handler.UploadMedia)
 }
 
-func chatRoomRouting(router *gin.Engine, handler *handler.ChatRoomHandler, authMiddleware, userAuthorizationMiddleware, doctorAuthorizationMiddleware gin.HandlerFunc) {
+func chatRoomRouting(router *gin.Engine, handler *handler.ChatRoomHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	chatRoomRouter := router.Group("/v2/chat-room")
 
-	chatRoomRouter.POST("", authMiddleware, userAuthorizationMiddleware, handler.UserCreateRoom)
-	chatRoomRouter.PATCH("/:room_id/close", authMiddleware, userAuthorizationMiddleware, handler.CloseChatRoom)
-	chatRoomRouter.PATCH("/:room_id/join", authMiddleware, doctorAuthorizationMiddleware, handler.DoctorJoinRoom)
+	chatRoomRouter.POST("", authMiddleware, requirePermission(appconstant.PermissionConsultationsBook), handler.UserCreateRoom)
+	chatRoomRouter.PATCH("/:room_id/close", authMiddleware, requirePermission(appconstant.PermissionConsultationsBook), handler.CloseChatRoom)
+	chatRoomRouter.PATCH("/:room_id/join", authMiddleware, requirePermission(appconstant.PermissionConsultationsAttend), handler.DoctorJoinRoom)
 	chatRoomRouter.GET("", authMiddleware, handler.GetAllRooms)
 	chatRoomRouter.GET("/:room_id", authMiddleware, handler.GetRoomDetail)
+	chatRoomRouter.GET("/:room_id/chats", authMiddleware, handler.GetChatHistory)
+}
+
+func doctorReviewRouting(router *gin.Engine, handler *handler.DoctorReviewHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.POST("/v2/chat-room/:room_id/review", authMiddleware, requirePermission(appconstant.PermissionReviewsWrite), handler.CreateDoctorReview)
+	router.GET("/doctors/:doctor_id/reviews", handler.GetAllDoctorReviewsForPublic)
+
+	router.GET("/admin/doctor-reviews", authMiddleware, requirePermission(appconstant.PermissionReviewsModerate), handler.GetAllDoctorReviews)
+	router.PATCH("/admin/doctor-reviews/:doctor_review_id/visibility", authMiddleware, requirePermission(appconstant.PermissionReviewsModerate), handler.UpdateDoctorReviewVisibility)
+}
+
+func pharmacyReviewRouting(router *gin.Engine, handler *handler.PharmacyReviewHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.POST("/pharmacy-orders/:order_pharmacy_id/review", authMiddleware, requirePermission(appconstant.PermissionReviewsWrite), handler.CreatePharmacyReview)
+	router.PUT("/pharmacy-orders/:order_pharmacy_id/review", authMiddleware, requirePermission(appconstant.PermissionReviewsWrite), handler.UpdatePharmacyReview)
+	router.POST("/order-items/:order_item_id/review", authMiddleware, requirePermission(appconstant.PermissionReviewsWrite), handler.CreateOrderItemReview)
+	router.PUT("/order-items/:order_item_id/review", authMiddleware, requirePermission(appconstant.PermissionReviewsWrite), handler.UpdateOrderItemReview)
+
+	router.GET("/pharmacies/:pharmacy_id/reviews", handler.GetAllPharmacyReviews)
+	router.GET("/drugs/:drug_id/reviews", handler.GetAllDrugReviews)
+
+	router.PATCH("/manager/pharmacy-reviews/:pharmacy_review_id/reply", authMiddleware, requirePermission(appconstant.PermissionReviewsReply), handler.ReplyPharmacyReview)
+	router.PATCH("/manager/order-item-reviews/:order_item_review_id/reply", authMiddleware, requirePermission(appconstant.PermissionReviewsReply), handler.ReplyOrderItemReview)
 }
 
 func corsRouting(router *gin.Engine, configCors cors.Config, config *config.Config) {
@@ -371,93 +572,147 @@ func corsRouting(router *gin.Engine, configCors cors.Config, config *config.Conf
 	router.Use(cors.New(configCors))
 }
 
-func authenticationRouting(router *gin.Engine, handler *handler.AuthenticationHandler) {
+func authenticationRouting(router *gin.Engine, handler *handler.AuthenticationHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware, loginRateLimitMiddleware gin.HandlerFunc, verificationRateLimitMiddleware gin.HandlerFunc, resetPasswordRateLimitMiddleware gin.HandlerFunc, twoFactorRateLimitMiddleware gin.HandlerFunc) {
 	router.POST("/users/register", handler.RegisterUser)
 	router.POST("/doctors/register", handler.RegisterDoctor)
-	router.POST("/verification", handler.SendVerificationEmail)
-	router.POST("/verification/password", handler.VerifyOneAccount)
-	router.POST("/login", handler.Login)
+	router.POST("/verification", verificationRateLimitMiddleware, handler.SendVerificationEmail)
+	router.POST("/verification/password", verificationRateLimitMiddleware, handler.VerifyOneAccount)
+	router.POST("/login", loginRateLimitMiddleware, handler.Login)
 	router.POST("/refresh-token", handler.GetNewAccessToken)
-	router.POST("/reset-password", handler.SendResetPasswordToken)
-	router.POST("/reset-password/verification", handler.ResetPasswordOneAccount)
+	router.POST("/reset-password", resetPasswordRateLimitMiddleware, handler.SendResetPasswordToken)
+	router.POST("/reset-password/verification", verificationRateLimitMiddleware, handler.ResetPasswordOneAccount)
 	router.POST("/verify", handler.VerifyToken)
+	router.POST("/logout", authMiddleware, handler.Logout)
+	router.POST("/logout/all", authMiddleware, handler.LogoutAll)
+	router.GET("/sessions", authMiddleware, handler.GetActiveSessions)
+	router.DELETE("/sessions/:session_id", authMiddleware, handler.RevokeSession)
+	router.POST("/login/two-factor", twoFactorRateLimitMiddleware, handler.VerifyTwoFactorLogin)
+	router.GET("/oidc/providers", handler.GetOidcProviders)
+	router.GET("/oidc/:provider/authorization", handler.StartOidcLogin)
+	router.POST("/oidc/:provider/callback", loginRateLimitMiddleware, handler.CompleteOidcLogin)
+	router.POST("/oidc/:provider/link", authMiddleware, loginRateLimitMiddleware, handler.StartOidcLink)
+	router.GET("/two-factor", authMiddleware, handler.GetTwoFactorStatus)
+	router.POST("/two-factor/enrolment", authMiddleware, handler.StartTwoFactorEnrolment)
+	router.POST("/two-factor/enrolment/confirm", authMiddleware, handler.ConfirmTwoFactorEnrolment)
+	router.POST("/two-factor/recovery-codes", authMiddleware, handler.RegenerateRecoveryCodes)
+	router.POST("/two-factor/disable", authMiddleware, handler.DisableTwoFactor)
+	router.DELETE("/admin/accounts/:account_id/two-factor", authMiddleware, requirePermission(appconstant.PermissionAccountsManage), handler.ResetTwoFactor)
+}
+
+func jwksRouting(router *gin.Engine, handler *handler.JwksHandler) {
+	router.GET("/.well-known/jwks.json", handler.GetJwks)
 }
 
-func categoryRouting(router *gin.Engine, handler *handler.CategoryHandler, authMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
+func roleRouting(router *gin.Engine, handler *handler.RoleHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	adminRouter := router.Group("/admin", authMiddleware, requirePermission(appconstant.PermissionRolesManage))
+
+	adminRouter.GET("/roles", handler.GetRoles)
+	adminRouter.GET("/permissions", handler.GetPermissions)
+	adminRouter.PUT("/roles/:role_id/permissions", handler.UpdateRolePermissions)
+	adminRouter.PATCH("/accounts/:account_id/role", handler.UpdateAccountRole)
+	adminRouter.POST("/operators", handler.CreateOperator)
+}
+
+func auditLogRouting(router *gin.Engine, handler *handler.AuditLogHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	auditLogRouter := router.Group("/admin/audit-logs", authMiddleware, requirePermission(appconstant.PermissionAuditLogsRead))
+
+	auditLogRouter.GET("/", handler.GetAuditLogs)
+	auditLogRouter.GET("/export", handler.ExportAuditLogs)
+}
+
+func adminFileRouting(router *gin.Engine, handler *handler.AdminFileHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	adminFileRouter := router.Group("/admin/files", authMiddleware, requirePermission(appconstant.PermissionFilesManage))
+
+	adminFileRouter.GET("/", handler.GetFiles)
+	adminFileRouter.POST("/", handler.UploadFile)
+	adminFileRouter.DELETE("/:admin_file_id", handler.DeleteFile)
+}
+
+func categoryRouting(router *gin.Engine, handler *handler.CategoryHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	categoryRouter := router.Group("/categories")
 
 	categoryRouter.GET("/", handler.GetAllCategories)
-	categoryRouter.DELETE("/:category_id", authMiddleware, adminAuthorizationMiddleware, handler.DeleteCategory)
-	categoryRouter.POST("/", authMiddleware, adminAuthorizationMiddleware, handler.AddOneCategory)
-	categoryRouter.PUT("/:category_id", authMiddleware, adminAuthorizationMiddleware, handler.UpdateOneCategory)
+	categoryRouter.DELETE("/:category_id", authMiddleware, requirePermission(appconstant.PermissionCategoriesWrite), handler.DeleteCategory)
+	categoryRouter.POST("/", authMiddleware, requirePermission(appconstant.PermissionCategoriesWrite), handler.AddOneCategory)
+	categoryRouter.PUT("/:category_id", authMiddleware, requirePermission(appconstant.PermissionCategoriesWrite), handler.UpdateOneCategory)
 }
 
-func cartRouting(router *gin.Engine, handler *handler.CartHandler, authMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc) {
+func cartRouting(router *gin.Engine, handler *handler.CartHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	cartRouter := router.Group("/cart")
 
-	cartRouter.POST("/delivery", authMiddleware, userAuthorizationMiddleware, handler.CalculateDeliveryFee)
-	cartRouter.POST("/", authMiddleware, userAuthorizationMiddleware, handler.CreateOneCart)
-	cartRouter.PATCH("/:cart_id", authMiddleware, userAuthorizationMiddleware, handler.UpdateQtyCart)
-	cartRouter.DELETE("/:cart_id", authMiddleware, userAuthorizationMiddleware, handler.DeleteOneCart)
-	cartRouter.GET("/", authMiddleware, userAuthorizationMiddleware, handler.GetAllCart)
+	cartRouter.POST("/delivery", authMiddleware, requirePermission(appconstant.PermissionCartsManage), handler.CalculateDeliveryFee)
+	cartRouter.POST("/", authMiddleware, requirePermission(appconstant.PermissionCartsManage), handler.CreateOneCart)
+	cartRouter.PATCH("/:cart_id", authMiddleware, requirePermission(appconstant.PermissionCartsManage), handler.UpdateQtyCart)
+	cartRouter.DELETE("/:cart_id", authMiddleware, requirePermission(appconstant.PermissionCartsManage), handler.DeleteOneCart)
+	cartRouter.GET("/", authMiddleware, requirePermission(appconstant.PermissionCartsManage), handler.GetAllCart)
+}
+
+func orderRouting(router *gin.Engine, handler *handler.OrderHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.POST("/orders", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.CheckoutOrder)
+	router.PATCH("/orders/:order_id/payment-proof", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.UploadPaymentProofOrder)
+	router.PATCH("/orders/:order_id/confirm-payment", authMiddleware, requirePermission(appconstant.PermissionOrdersConfirmPayment), handler.ConfirmPayment)
+	router.PATCH("/orders/:order_id/cancel-order", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.CancelOrder)
+	router.GET("/orders/:order_id", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.GetOrderById)
+	router.GET("/orders/pending", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.GetAllUserPendingOrders)
+	router.GET("/admin/orders", authMiddleware, requirePermission(appconstant.PermissionOrdersRead), handler.GetAllOrders)
 }
 
-func orderRouting(router *gin.Engine, handler *handler.OrderHandler, authMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
-	router.POST("/orders", authMiddleware, userAuthorizationMiddleware, handler.CheckoutOrder)
-	router.PATCH("/orders/:order_id/payment-proof", authMiddleware, userAuthorizationMiddleware, handler.UploadPaymentProofOrder)
-	router.PATCH("/orders/:order_id/confirm-payment", authMiddleware, adminAuthorizationMiddleware, handler.ConfirmPayment)
-	router.PATCH("/orders/:order_id/cancel-order", authMiddleware, userAuthorizationMiddleware, handler.CancelOrder)
-	router.GET("/orders/:order_id", authMiddleware, userAuthorizationMiddleware, handler.GetOrderById)
-	router.GET("/orders/pending", authMiddleware, userAuthorizationMiddleware, handler.GetAllUserPendingOrders)
-	router.GET("/admin/orders", authMiddleware, adminAuthorizationMiddleware, handler.GetAllOrders)
+func orderPharmacyRouting(router *gin.Engine, handler *handler.OrderPharmacyHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.PATCH("/pharmacy-orders/:order_pharmacy_id/send-package", authMiddleware, requirePermission(appconstant.PermissionPharmacyOrdersFulfil), handler.UpdateStatusToSent)
+	router.PATCH("/pharmacy-orders/:order_pharmacy_id/confirm-package", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.UpdateStatusToConfirmed)
+	router.PATCH("/pharmacy-orders/:order_pharmacy_id/cancel-package", authMiddleware, requirePermission(appconstant.PermissionPharmacyOrdersFulfil), handler.UpdateStatusToCancelled)
+	router.GET("/pharmacy-orders/:order_pharmacy_id", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.GetOrderPharmacyById)
+	router.GET("/pharmacy-orders", authMiddleware, requirePermission(appconstant.PermissionOrdersCheckout), handler.GetAllUserOrderPharmacies)
+	router.GET("/manager/pharmacy-orders", authMiddleware, requirePermission(appconstant.PermissionPharmacyOrdersFulfil), handler.GetAllPartnerOrderPharmacies)
+	router.GET("/manager/pharmacy-orders/summary", authMiddleware, requirePermission(appconstant.PermissionPharmacyOrdersFulfil), handler.GetAllPartnerOrderPharmaciesSummary)
+	router.GET("/admin/pharmacy-orders", authMiddleware, requirePermission(appconstant.PermissionOrdersRead), handler.GetAllOrderPharmacies)
 }
 
-func orderPharmacyRouting(router *gin.Engine, handler *handler.OrderPharmacyHandler, authMiddleware gin.HandlerFunc,
-	pharmacyManagerAuthorizationMiddleware gin.HandlerFunc, userAuthorizationMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
-	router.PATCH("/pharmacy-orders/:order_pharmacy_id/send-package", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.UpdateStatusToSent)
-	router.PATCH("/pharmacy-orders/:order_pharmacy_id/confirm-package", authMiddleware, userAuthorizationMiddleware, handler.UpdateStatusToConfirmed)
-	router.PATCH("/pharmacy-orders/:order_pharmacy_id/cancel-package", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.UpdateStatusToCancelled)
-	router.GET("/pharmacy-orders/:order_pharmacy_id", authMiddleware, userAuthorizationMiddleware, handler.GetOrderPharmacyById)
-	router.GET("/pharmacy-orders", authMiddleware, userAuthorizationMiddleware, handler.GetAllUserOrderPharmacies)
-	router.GET("/manager/pharmacy-orders", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetAllPartnerOrderPharmacies)
-	router.GET("/manager/pharmacy-orders/summary", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetAllPartnerOrderPharmaciesSummary)
-	router.GET("/admin/pharmacy-orders", authMiddleware, adminAuthorizationMiddleware, handler.GetAllOrderPharmacies)
+func orderEventRouting(router *gin.Engine, handler *handler.OrderEventHandler, authMiddleware gin.HandlerFunc) {
+	router.GET("/events/orders", authMiddleware, handler.StreamOrderEvents)
 }
 
-func reportRouting(router *gin.Engine, handler *handler.ReportHandler, authMiddleware gin.HandlerFunc, pharmacyManagerAuthorizationMiddleware gin.HandlerFunc, adminAuthorizationMiddleware gin.HandlerFunc) {
-	router.GET("/manager/categories/reports", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetPharmacyDrugCategoryReport)
-	router.GET("/manager/drugs/reports", authMiddleware, pharmacyManagerAuthorizationMiddleware, handler.GetPharmacyDrugReport)
-	router.GET("/admin/categories/reports", authMiddleware, adminAuthorizationMiddleware, handler.GetDrugCategoryReport)
-	router.GET("/admin/drugs/reports", authMiddleware, adminAuthorizationMiddleware, handler.GetDrugReport)
+func reportRouting(router *gin.Engine, handler *handler.ReportHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.GET("/manager/categories/reports", authMiddleware, requirePermission(appconstant.PermissionPharmacyReportsRead), handler.GetPharmacyDrugCategoryReport)
+	router.GET("/manager/drugs/reports", authMiddleware, requirePermission(appconstant.PermissionPharmacyReportsRead), handler.GetPharmacyDrugReport)
+	router.GET("/admin/categories/reports", authMiddleware, requirePermission(appconstant.PermissionReportsRead), handler.GetDrugCategoryReport)
+	router.GET("/admin/drugs/reports", authMiddleware, requirePermission(appconstant.PermissionReportsRead), handler.GetDrugReport)
+	router.GET("/manager/ratings/reports", authMiddleware, requirePermission(appconstant.PermissionPharmacyReportsRead), handler.GetPharmacyRatingReport)
+	router.GET("/admin/ratings/reports", authMiddleware, requirePermission(appconstant.PermissionReportsRead), handler.GetRatingReport)
 }
 
-func pingRouting(router *gin.Engine, handler *handler.PingHandler, authMiddleware gin.HandlerFunc, userMiddleware gin.HandlerFunc, doctorMiddleware gin.HandlerFunc, PharmacyManagerMiddleware gin.HandlerFunc, adminMiddleware gin.HandlerFunc) {
+func pingRouting(router *gin.Engine, handler *handler.PingHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
 	pingRouter := router.Group("/ping")
 
 	pingRouter.GET("", handler.Ping)
 
 	pingRouter.Use(authMiddleware)
-	pingRouter.GET("/user", userMiddleware, handler.Ping)
-	pingRouter.GET("/doctor", doctorMiddleware, handler.Ping)
-	pingRouter.GET("/pharmacy-manager", PharmacyManagerMiddleware, handler.Ping)
-	pingRouter.GET("/admin", adminMiddleware, handler.Ping)
+	pingRouter.GET("/user", requirePermission(appconstant.PermissionUsersProfile), handler.Ping)
+	pingRouter.GET("/doctor", requirePermission(appconstant.PermissionDoctorsProfile), handler.Ping)
+	pingRouter.GET("/pharmacy-manager", requirePermission(appconstant.PermissionPharmaciesManage), handler.Ping)
+	pingRouter.GET("/admin", requirePermission(appconstant.PermissionRolesManage), handler.Ping)
 
-	pingRouter.Use(userMiddleware)
+	pingRouter.Use(requirePermission(appconstant.PermissionUsersProfile))
 	pingRouter.GET("/all-user-endpoints", handler.Ping)
 }
 
-func pprofRouting(router *gin.Engine) {
-	pprofRouter := router.Group("/debug/pprof")
-
-	pprofRouter.GET("/", gin.WrapH(http.HandlerFunc(pprof.Index)))
-	pprofRouter.GET("/profile", gin.WrapH(http.HandlerFunc(pprof.Profile)))
-	pprofRouter.GET("/heap", gin.WrapH(http.HandlerFunc(pprof.Handler("heap").ServeHTTP)))
-	pprofRouter.GET("/block", gin.WrapH(http.HandlerFunc(pprof.Handler("block").ServeHTTP)))
-	pprofRouter.GET("/goroutine", gin.WrapH(http.HandlerFunc(pprof.Handler("goroutine").ServeHTTP)))
+func healthProfileRouting(router *gin.Engine, handler *handler.PatientHealthProfileHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.GET("/users/health-profile", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.GetHealthProfile)
+	router.PUT("/users/health-profile", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.UpdateHealthProfile)
+	router.GET("/users/health-profile/access-logs", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.GetAccessLogs)
+	router.GET("/v2/chat-room/:room_id/health-profile", authMiddleware, requirePermission(appconstant.PermissionConsultationsAttend), handler.GetHealthProfileForDoctor)
 }
 
-func personalRouting(router *gin.Engine, handler *handler.PersonalHandler, personalAuthMiddleware gin.HandlerFunc) {
-	personalRouter := router.Group("/personal")
+func consultationNoteRouting(router *gin.Engine, handler *handler.ConsultationNoteHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.PUT("/v2/chat-room/:room_id/notes", authMiddleware, requirePermission(appconstant.PermissionConsultationsAttend), handler.UpsertConsultationNote)
+	router.GET("/v2/chat-room/:room_id/notes", authMiddleware, handler.GetConsultationNote)
+	router.GET("/users/medical-history", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.GetMedicalHistory)
+	router.GET("/users/medical-record/export", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.ExportMedicalRecord)
+}
 
-	personalRouter.POST("/upload", personalAuthMiddleware, handler.UploadFile)
+func sickLeaveRouting(router *gin.Engine, handler *handler.SickLeaveHandler, authMiddleware gin.HandlerFunc, requirePermission PermissionMiddleware) {
+	router.POST("/v2/chat-room/:room_id/sick-leave", authMiddleware, requirePermission(appconstant.PermissionConsultationsAttend), handler.IssueSickLeaveCertificate)
+	router.GET("/sick-leave-certificates/:sick_leave_certificate_id/document", authMiddleware, handler.GetSickLeaveDocument)
+	router.GET("/sick-leave-certificates/verify/:verification_code", handler.VerifySickLeaveCertificate)
+	router.GET("/users/documents", authMiddleware, requirePermission(appconstant.PermissionHealthRecordsOwn), handler.GetUserDocuments)
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"max-health/appconstant"
 	"max-health/config"
 	"max-health/util"
 	"net/http"
@@ -10,6 +11,8 @@ import (
 	"strconv"
 	"syscall"
 	"time"
+
+	"github.com/sirupsen/logrus"
 )
 
 func Init() {
@@ -17,7 +20,19 @@ func Init() {
 
 	config := config.Init(log)
 
-	router := createRouter(log, config)
+	shutdownTracing, err := util.InitTracing(context.Background(), config)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Fatal("error initializing tracing")
+	}
+
+	router, adminRouter, workers, orderEventBroker := createRouters(log, config)
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	for _, worker := range workers {
+		go worker.Run(workerCtx)
+	}
 
 	srv := http.Server{
 		Handler: router,
@@ -30,6 +45,22 @@ func Init() {
 		}
 	}()
 
+	var adminSrv *http.Server
+	if config.AdminPort != "" {
+		adminSrv = &http.Server{
+			Handler: adminRouter,
+			Addr:    config.AdminPort,
+		}
+
+		go func() {
+			if err := adminSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("admin listen: %s\n", err)
+			}
+		}()
+	} else {
+		log.Warn("ADMIN_PORT is not set, admin listener is disabled")
+	}
+
 	quit := make(chan os.Signal, 10)
 
 	defer close(quit)
@@ -42,12 +73,33 @@ func Init() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.GracefulPeriod)*time.Second)
 	defer cancel()
 
+	stopWorkers()
+	orderEventBroker.Close()
+	for _, worker := range workers {
+		worker.Wait(ctx)
+	}
+
 	<-ctx.Done()
 
+	if adminSrv != nil {
+		if err := adminSrv.Shutdown(ctx); err != nil {
+			log.Fatalf("Admin server shutdown: %s", err.Error())
+		}
+	}
+
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("Server shutdown: %s", err.Error())
 	}
 
+	tracingCtx, cancelTracing := context.WithTimeout(context.Background(), appconstant.TracingShutdownTimeout)
+	defer cancelTracing()
+
+	if err := shutdownTracing(tracingCtx); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error flushing traces")
+	}
+
 	log.Infof("Timeout of " + strconv.Itoa(config.GracefulPeriod) + " seconds")
 	log.Info("Server exiting")
 }
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"max-health/util"
+
+	"github.com/sirupsen/logrus"
+)
+
+type pollingWorker struct {
+	name     string
+	interval time.Duration
+	poll     func(ctx context.Context) (bool, error)
+	log      *logrus.Logger
+	done     chan struct{}
+}
+
+func newPollingWorker(name string, interval time.Duration, poll func(ctx context.Context) (bool, error), log *logrus.Logger) *pollingWorker {
+	return &pollingWorker{
+		name:     name,
+		interval: interval,
+		poll:     poll,
+		log:      log,
+		done:     make(chan struct{}),
+	}
+}
+
+func (w *pollingWorker) Run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.drain(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *pollingWorker) Wait(ctx context.Context) {
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+		w.log.WithFields(logrus.Fields{
+			"worker": w.name,
+		}).Warn("worker did not stop before shutdown timeout")
+	}
+}
+
+func (w *pollingWorker) drain(ctx context.Context) {
+	ctx = util.ContextWithLogger(ctx, w.log.WithFields(logrus.Fields{
+		"worker": w.name,
+	}))
+
+	for ctx.Err() == nil {
+		more, err := w.poll(context.WithoutCancel(ctx))
+		if err != nil {
+			w.log.WithFields(logrus.Fields{
+				"worker": w.name,
+				"error":  err.Error(),
+			}).Error("worker failed")
+			return
+		}
+
+		if !more {
+			return
+		}
+	}
+}
@@ -1,120 +1,25 @@
 \c max_health_db
 
-CREATE INDEX idx_pharmacies_geom ON pharmacies USING GIST (geom);
-
-CREATE INDEX idx_pharmacy_drugs_pharmacy_id ON pharmacy_drugs (pharmacy_id);
-CREATE INDEX idx_pharmacy_drugs_drug_id ON pharmacy_drugs (drug_id);
-CREATE INDEX idx_pharmacy_drugs_deleted_at_stock_price ON pharmacy_drugs (deleted_at, stock, price);
-
-CREATE INDEX idx_drugs_drug_id ON drugs (drug_id);
-CREATE INDEX idx_drugs_deleted_active_name ON drugs (deleted_at, is_active, drug_name);
-
-CREATE EXTENSION IF NOT EXISTS pg_trgm;
-CREATE INDEX idx_drugs_drug_name_trgm ON drugs USING gin (drug_name gin_trgm_ops);
-
-INSERT INTO accounts(email, password, role_id, account_name, verified_at)
-VALUES
-('admin@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 4, 'admin', NOW()),
-('user@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 1, 'user', NOW()),
-('doctor@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor', NOW()),
-('doctor1@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor1', NOW()),
-('doctor2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor2', NOW()),
-('doctor3@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor3', NOW()),
-('doctor4@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor4', NOW()),
-('doctor5@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor5', NOW()),
-('doctor6@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor6', NOW()),
-('doctor7@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor7', NOW()),
-('doctor8@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor8', NOW()),
-('doctor9@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor9', NOW()),
-('doctor10@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor10', NOW()),
-('doctor11@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor11', NOW()),
-('doctor12@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor12', NOW()),
-('doctor13@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor13', NOW()),
-('doctor14@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor14', NOW()),
-('doctor15@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor15', NOW()),
-('doctor16@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor16', NOW()),
-('doctor17@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor17', NOW()),
-('doctor18@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor18', NOW()),
-('doctor19@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor19', NOW()),
-('doctor20@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor20', NOW()),
-('doctor21@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor21', NOW()),
-('doctor22@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor22', NOW()),
-('doctor23@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor23', NOW()),
-('doctor24@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor24', NOW()),
-('doctor25@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor25', NOW()),
-('doctor26@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor26', NOW()),
-('doctor27@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor27', NOW()),
-('doctor28@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor28', NOW()),
-('doctor29@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor29', NOW()),
-('doctor30@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor30', NOW()),
-('doctor31@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor31', NOW()),
-('doctor32@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor32', NOW()),
-('doctor33@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor33', NOW()),
-('doctor34@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor34', NOW()),
-('manager@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
-('manager1@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
-('manager2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
-('manager3@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
-('manager4@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
-('user2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 1, 'user1', NOW());
-
-INSERT INTO doctors(account_id, certificate, specialization_id, is_online)
+INSERT INTO ROLES (role_name, is_operator, two_factor_required)
 VALUES 
-(3, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE),
-(4, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 2, FALSE),
-(5, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 3, FALSE),
-(6, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 4, FALSE),
-(7, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 5, FALSE),
-(8, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 6, FALSE),
-(9, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 7, FALSE),
-(10, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 8, FALSE),
-(11, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 9, FALSE),
-(12, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 10, FALSE),
-(13, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 11, FALSE),
-(14, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 12, FALSE),
-(15, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 13, FALSE),
-(16, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 14, FALSE),
-(17, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 15, FALSE),
-(18, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 16, FALSE),
-(19, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 17, FALSE),
-(20, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE),
-(21, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 2, FALSE),
-(22, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 3, FALSE),
-(23, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 4, FALSE),
-(24, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 5, FALSE),
-(25, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 6, FALSE),
-(26, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 7, FALSE),
-(27, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 8, FALSE),
-(28, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 9, FALSE),
-(29, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 10, FALSE),
-(30, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 11, FALSE),
-(31, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 12, FALSE),
-(32, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 13, FALSE),
-(33, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 14, FALSE),
-(34, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 15, FALSE),
-(35, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 16, FALSE),
-(36, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 17, FALSE),
-(37, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE);
-
-INSERT INTO users(account_id, gender_id, date_of_birth)
-VALUES
-(2, 1, '2001-12-12'),
-(43, 2, '2002-08-21');
-
-INSERT INTO pharmacy_managers(account_id)
-VALUES
-(38),
-(39),
-(40),
-(41),
-(42);
+('user', FALSE, FALSE),
+('doctor', FALSE, FALSE),
+('pharmacy manager', FALSE, TRUE),
+('admin', TRUE, TRUE),
+('finance', TRUE, TRUE),
+('catalog editor', TRUE, TRUE);
 
-INSERT INTO ROLES (role_name)
-VALUES 
-('user'),
-('doctor'),
-('pharmacy manager'),
-('admin');
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.role_id, p.permission_id
+FROM roles r
+JOIN permissions p ON (
+    (r.role_name = 'user' AND p.permission_name IN ('users.profile', 'addresses.manage', 'carts.manage', 'orders.checkout', 'prescriptions.redeem', 'consultations.book', 'reviews.write', 'health_records.own'))
+    OR (r.role_name = 'doctor' AND p.permission_name IN ('doctors.profile', 'consultations.attend'))
+    OR (r.role_name = 'pharmacy manager' AND p.permission_name IN ('pharmacies.manage', 'stock.manage', 'pharmacy_orders.fulfil', 'reports.pharmacy', 'reviews.reply'))
+    OR (r.role_name = 'admin' AND p.permission_name IN ('drugs.read', 'drugs.write', 'categories.write', 'doctors.verify', 'reviews.moderate', 'partners.manage', 'orders.confirm_payment', 'orders.read', 'reports.read', 'accounts.manage', 'roles.manage', 'audit_logs.read', 'files.manage', 'maintenance.manage'))
+    OR (r.role_name = 'finance' AND p.permission_name IN ('orders.confirm_payment', 'orders.read', 'reports.read'))
+    OR (r.role_name = 'catalog editor' AND p.permission_name IN ('drugs.read', 'drugs.write', 'categories.write'))
+);
 
 INSERT INTO couriers (courier_name, price, is_official)
 VALUES 
@@ -161,6 +66,15 @@ VALUES
 ('obat bebas terbatas'),
 ('non-obat');
 
+INSERT INTO drug_interactions (generic_name_a, generic_name_b, severity, description)
+VALUES
+('warfarin', 'aspirin', 'major', 'increased risk of bleeding'),
+('warfarin', 'ibuprofen', 'major', 'increased risk of bleeding'),
+('aspirin', 'ibuprofen', 'moderate', 'ibuprofen may reduce the antiplatelet effect of aspirin'),
+('sildenafil', 'isosorbide dinitrate', 'major', 'severe hypotension'),
+('simvastatin', 'clarithromycin', 'major', 'increased risk of myopathy'),
+('metformin', 'alcohol', 'moderate', 'increased risk of lactic acidosis');
+
 INSERT INTO doctor_specializations (specialization_name)
 VALUES
 ('Accident and emergency medicine'),
@@ -91803,4 +91717,101 @@ VALUES
 ('9508322001', '950832', 'Mandala'),
 ('9508322002', '950832', 'Trim'),
 ('9508322003', '950832', 'Benggem'),
-('9508322004', '950832', 'Pasir Putih');
\ No newline at end of file
+('9508322004', '950832', 'Pasir Putih');
+
+INSERT INTO accounts(email, password, role_id, account_name, verified_at)
+VALUES
+('admin@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 4, 'admin', NOW()),
+('user@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 1, 'user', NOW()),
+('doctor@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor', NOW()),
+('doctor1@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor1', NOW()),
+('doctor2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor2', NOW()),
+('doctor3@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor3', NOW()),
+('doctor4@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor4', NOW()),
+('doctor5@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor5', NOW()),
+('doctor6@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor6', NOW()),
+('doctor7@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor7', NOW()),
+('doctor8@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor8', NOW()),
+('doctor9@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor9', NOW()),
+('doctor10@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor10', NOW()),
+('doctor11@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor11', NOW()),
+('doctor12@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor12', NOW()),
+('doctor13@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor13', NOW()),
+('doctor14@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor14', NOW()),
+('doctor15@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor15', NOW()),
+('doctor16@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor16', NOW()),
+('doctor17@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor17', NOW()),
+('doctor18@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor18', NOW()),
+('doctor19@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor19', NOW()),
+('doctor20@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor20', NOW()),
+('doctor21@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor21', NOW()),
+('doctor22@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor22', NOW()),
+('doctor23@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor23', NOW()),
+('doctor24@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor24', NOW()),
+('doctor25@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor25', NOW()),
+('doctor26@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor26', NOW()),
+('doctor27@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor27', NOW()),
+('doctor28@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor28', NOW()),
+('doctor29@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor29', NOW()),
+('doctor30@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor30', NOW()),
+('doctor31@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor31', NOW()),
+('doctor32@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor32', NOW()),
+('doctor33@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor33', NOW()),
+('doctor34@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 2, 'doctor34', NOW()),
+('manager@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
+('manager1@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
+('manager2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
+('manager3@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
+('manager4@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 3, 'manager', NOW()),
+('user2@example.com', '$2a$10$Ui58s.1ppver3xUPuEsoo.xwiTwk3StevmFpXpxB9P.wOkJiiuL46', 1, 'user1', NOW());
+
+INSERT INTO doctors(account_id, certificate, specialization_id, is_online, verification_status, reviewed_at)
+VALUES 
+(3, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE, 'approved', NOW()),
+(4, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 2, FALSE, 'approved', NOW()),
+(5, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 3, FALSE, 'approved', NOW()),
+(6, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 4, FALSE, 'approved', NOW()),
+(7, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 5, FALSE, 'approved', NOW()),
+(8, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 6, FALSE, 'approved', NOW()),
+(9, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 7, FALSE, 'approved', NOW()),
+(10, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 8, FALSE, 'approved', NOW()),
+(11, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 9, FALSE, 'approved', NOW()),
+(12, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 10, FALSE, 'approved', NOW()),
+(13, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 11, FALSE, 'approved', NOW()),
+(14, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 12, FALSE, 'approved', NOW()),
+(15, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 13, FALSE, 'approved', NOW()),
+(16, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 14, FALSE, 'approved', NOW()),
+(17, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 15, FALSE, 'approved', NOW()),
+(18, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 16, FALSE, 'approved', NOW()),
+(19, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 17, FALSE, 'approved', NOW()),
+(20, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE, 'approved', NOW()),
+(21, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 2, FALSE, 'approved', NOW()),
+(22, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 3, FALSE, 'approved', NOW()),
+(23, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 4, FALSE, 'approved', NOW()),
+(24, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 5, FALSE, 'approved', NOW()),
+(25, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 6, FALSE, 'approved', NOW()),
+(26, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 7, FALSE, 'approved', NOW()),
+(27, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 8, FALSE, 'approved', NOW()),
+(28, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 9, FALSE, 'approved', NOW()),
+(29, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 10, FALSE, 'approved', NOW()),
+(30, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 11, FALSE, 'approved', NOW()),
+(31, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 12, FALSE, 'approved', NOW()),
+(32, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 13, FALSE, 'approved', NOW()),
+(33, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 14, FALSE, 'approved', NOW()),
+(34, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 15, FALSE, 'approved', NOW()),
+(35, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 16, FALSE, 'approved', NOW()),
+(36, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 17, FALSE, 'approved', NOW()),
+(37, 'https://res.cloudinary.com/dpdu3tidt/image/upload/v1715690463/doctor_certificates/bdrzdvohlsijo4h3on2d.jpg', 1, FALSE, 'approved', NOW());
+
+INSERT INTO users(account_id, gender_id, date_of_birth)
+VALUES
+(2, 1, '2001-12-12'),
+(43, 2, '2002-08-21');
+
+INSERT INTO pharmacy_managers(account_id)
+VALUES
+(38),
+(39),
+(40),
+(41),
+(42);
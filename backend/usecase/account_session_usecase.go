@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/repository"
+)
+
+func (u *authenticationUsecaseImpl) Logout(ctx context.Context, sessionId string) (err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	refreshTokenRepo := tx.RefreshTokenRepository()
+	accountSessionRepo := tx.AccountSessionRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = accountSessionRepo.RevokeSession(ctx, sessionId, appconstant.SessionRevokedByLogout)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = refreshTokenRepo.RevokeCodesBySessionId(ctx, sessionId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *authenticationUsecaseImpl) LogoutAll(ctx context.Context, accountId int64) (err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = revokeAllAccountSessions(ctx, tx.AccountSessionRepository(), tx.RefreshTokenRepository(), accountId, appconstant.SessionRevokedByLogoutAll)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *authenticationUsecaseImpl) GetActiveSessions(ctx context.Context, accountId int64) ([]entity.AccountSession, error) {
+	sessions, err := u.accountSessionRepository.GetActiveSessionsByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return sessions, nil
+}
+
+func (u *authenticationUsecaseImpl) RevokeSession(ctx context.Context, accountId int64, sessionId string) (err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	refreshTokenRepo := tx.RefreshTokenRepository()
+	accountSessionRepo := tx.AccountSessionRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	isRevoked, err := accountSessionRepo.RevokeSessionByAccountId(ctx, sessionId, accountId, appconstant.SessionRevokedByUser)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if !isRevoked {
+		return apperror.SessionNotFoundError()
+	}
+
+	err = refreshTokenRepo.RevokeCodesBySessionId(ctx, sessionId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *authenticationUsecaseImpl) IsSessionActive(ctx context.Context, sessionId string) (bool, error) {
+	if sessionId == "" {
+		return false, nil
+	}
+
+	session, err := u.accountSessionRepository.FindActiveSession(ctx, sessionId)
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+
+	return session != nil, nil
+}
+
+func revokeAllAccountSessions(ctx context.Context, accountSessionRepo repository.AccountSessionRepository, refreshTokenRepo repository.RefreshTokenRepository, accountId int64, reason string) error {
+	err := accountSessionRepo.RevokeAllSessions(ctx, accountId, reason)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = refreshTokenRepo.RevokeCodesByAccountId(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
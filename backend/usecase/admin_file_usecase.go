@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type AdminFileUsecase interface {
+	UploadFile(ctx context.Context, accountId int64, file multipart.File, fileHeader multipart.FileHeader) (*dto.AdminFileResponse, error)
+	GetFiles(ctx context.Context, page, limit string) (*dto.AdminFileListResponse, error)
+	DeleteFile(ctx context.Context, adminFileId int64) error
+}
+
+type adminFileUsecaseImpl struct {
+	transaction         repository.Transaction
+	adminFileRepository repository.AdminFileRepository
+}
+
+func NewAdminFileUsecaseImpl(transaction repository.Transaction, adminFileRepository repository.AdminFileRepository) adminFileUsecaseImpl {
+	return adminFileUsecaseImpl{
+		transaction:         transaction,
+		adminFileRepository: adminFileRepository,
+	}
+}
+
+func (u *adminFileUsecaseImpl) UploadFile(ctx context.Context, accountId int64, file multipart.File, fileHeader multipart.FileHeader) (response *dto.AdminFileResponse, err error) {
+	fileName := filepath.Base(fileHeader.Filename)
+	fileHeader.Filename = strings.ToLower(fileName)
+
+	formats := []string{}
+	for format := range appconstant.AdminFileContentTypes {
+		formats = append(formats, format)
+	}
+
+	filePath, format, err := util.ValidateFile(fileHeader, appconstant.AdminFilesUrl, formats, appconstant.AdminFileMaxSize)
+	if err != nil {
+		return nil, apperror.NewAppError(http.StatusBadRequest, err, err.Error())
+	}
+
+	contentType, err := util.ValidateFileContentType(file, *format, appconstant.AdminFileContentTypes)
+	if err != nil {
+		return nil, apperror.NewAppError(http.StatusBadRequest, err, err.Error())
+	}
+
+	fileUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		deleteUploadedFile(ctx, fileUrl)
+		return nil, apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			deleteUploadedFile(ctx, fileUrl)
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	adminFile := entity.AdminFile{
+		PublicId:    *filePath,
+		Url:         fileUrl,
+		Name:        fileName,
+		ContentType: contentType,
+		Size:        fileHeader.Size,
+		UploadedBy:  accountId,
+	}
+
+	if err = tx.AdminFileRepository().CreateOne(ctx, &adminFile); err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	fileResponse := dto.ConvertToAdminFileResponse(adminFile)
+
+	if err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUploadAdminFile, appconstant.AuditEntityAdminFile, adminFile.Id, nil, fileResponse); err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &fileResponse, nil
+}
+
+func (u *adminFileUsecaseImpl) GetFiles(ctx context.Context, page, limit string) (*dto.AdminFileListResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	adminFiles, err := u.adminFileRepository.FindAll(ctx, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.adminFileRepository.FindAllTotalItem(ctx)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToAdminFileListResponse(adminFiles, pageInfo)
+
+	return &response, nil
+}
+
+func (u *adminFileUsecaseImpl) DeleteFile(ctx context.Context, adminFileId int64) (err error) {
+	adminFile, err := u.adminFileRepository.FindOneById(ctx, adminFileId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if adminFile == nil {
+		return apperror.AdminFileNotFoundError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		if err = tx.Commit(); err == nil {
+			deleteUploadedFile(ctx, adminFile.Url)
+		}
+	}()
+
+	if err = tx.AdminFileRepository().DeleteOneById(ctx, adminFileId); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionDeleteAdminFile, appconstant.AuditEntityAdminFile, adminFileId, dto.ConvertToAdminFileResponse(*adminFile), nil); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type AuditLogUsecase interface {
+	GetAuditLogs(ctx context.Context, query util.ValidatedGetAuditLogQuery) (*dto.AuditLogListResponse, error)
+	ExportAuditLogs(ctx context.Context, query util.ValidatedGetAuditLogQuery) ([]entity.AuditLog, error)
+}
+
+type auditLogUsecaseImpl struct {
+	auditLogRepository repository.AuditLogRepository
+}
+
+func NewAuditLogUsecaseImpl(auditLogRepository repository.AuditLogRepository) auditLogUsecaseImpl {
+	return auditLogUsecaseImpl{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (u *auditLogUsecaseImpl) GetAuditLogs(ctx context.Context, query util.ValidatedGetAuditLogQuery) (*dto.AuditLogListResponse, error) {
+	auditLogs, pageInfo, err := u.auditLogRepository.FindAll(ctx, query)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	response := dto.ConvertToAuditLogListResponse(auditLogs, *pageInfo)
+
+	return &response, nil
+}
+
+func (u *auditLogUsecaseImpl) ExportAuditLogs(ctx context.Context, query util.ValidatedGetAuditLogQuery) ([]entity.AuditLog, error) {
+	query.Limit = appconstant.AuditLogExportLimit
+	query.Page = 1
+
+	auditLogs, _, err := u.auditLogRepository.FindAll(ctx, query)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return auditLogs, nil
+}
+
+func recordAuditLog(ctx context.Context, auditLogRepository repository.AuditLogRepository, action, entityType string, entityId int64, before, after any) error {
+	beforeData, afterData, err := util.AuditDiff(before, after)
+	if err != nil {
+		return err
+	}
+
+	auditLog := entity.AuditLog{
+		Action:     action,
+		EntityType: entityType,
+		EntityId:   entityId,
+		Before:     beforeData,
+		After:      afterData,
+	}
+
+	if actor, ok := util.AuditActorFromContext(ctx); ok {
+		auditLog.ActorAccountId = &actor.AccountId
+		auditLog.ActorRole = &actor.Role
+		auditLog.RequestId = &actor.RequestId
+		auditLog.IpAddress = &actor.IpAddress
+	}
+
+	return auditLogRepository.CreateOne(ctx, auditLog)
+}
@@ -23,13 +23,29 @@ import (
 type AuthenticationUsecase interface {
 	RegisterDoctor(ctx context.Context, registerRequest dto.RegisterRequest, specializationId int64, file multipart.File, fileHeader multipart.FileHeader) error
 	RegisterUser(ctx context.Context, registerRequest dto.RegisterRequest) error
-	Login(ctx context.Context, account entity.Account) (*entity.Tokens, error)
+	Login(ctx context.Context, account entity.Account, session entity.AccountSession) (*entity.LoginResult, error)
 	SendVerificationEmail(ctx context.Context, sendEmailRequest dto.SendEmailRequest) error
-	GetNewAccessToken(ctx context.Context, refreshToken string) (string, error)
+	GetNewAccessToken(ctx context.Context, refreshToken string) (*entity.Tokens, error)
 	VerifyOneAccount(ctx context.Context, verificationPasswordRequest dto.VerificationPasswordRequest) error
 	SendResetPasswordToken(ctx context.Context, sendEmailRequest dto.SendEmailRequest) error
 	ResetPassword(ctx context.Context, resetPasswordTokenVerificationRequest dto.ResetPasswordVerificationRequest) error
 	VerifyToken(ctx context.Context, accessToken string) (*entity.TokenData, error)
+	Logout(ctx context.Context, sessionId string) error
+	LogoutAll(ctx context.Context, accountId int64) error
+	GetActiveSessions(ctx context.Context, accountId int64) ([]entity.AccountSession, error)
+	RevokeSession(ctx context.Context, accountId int64, sessionId string) error
+	IsSessionActive(ctx context.Context, sessionId string) (bool, error)
+	VerifyTwoFactorLogin(ctx context.Context, challengeToken string, code string, session entity.AccountSession) (*entity.Tokens, error)
+	GetTwoFactorStatus(ctx context.Context, tokenData entity.TokenData) (*entity.TwoFactorStatus, error)
+	StartTwoFactorEnrolment(ctx context.Context, accountId int64) (*entity.TwoFactorEnrolment, error)
+	ConfirmTwoFactorEnrolment(ctx context.Context, tokenData entity.TokenData, code string) (*entity.TwoFactorActivation, error)
+	RegenerateRecoveryCodes(ctx context.Context, accountId int64, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, tokenData entity.TokenData, code string) error
+	ResetTwoFactor(ctx context.Context, accountId int64) error
+	GetOidcProviders() []string
+	StartOidcLogin(ctx context.Context, provider string) (string, error)
+	StartOidcLink(ctx context.Context, accountId int64, provider string, password string, code string) (string, error)
+	CompleteOidcLogin(ctx context.Context, provider string, code string, state string, session entity.AccountSession) (*entity.LoginResult, error)
 }
 
 type authenticationUsecaseImpl struct {
@@ -39,10 +55,15 @@ type authenticationUsecaseImpl struct {
 	verificationCodeRepository   repository.VerificationCodeRepository
 	refreshTokenRepository       repository.RefreshTokenRepository
 	resetPasswordTokenRepository repository.ResetPasswordTokenRepository
+	accountSessionRepository     repository.AccountSessionRepository
+	twoFactorRepository          repository.TwoFactorRepository
+	roleRepository               repository.RoleRepository
+	oidcRepository               repository.OidcRepository
 	transaction                  repository.Transaction
 	hashHelper                   util.HashHelperIntf
 	jwtHelper                    util.JwtAuthentication
-	emailHelper                  util.EmailHelper
+	twoFactorHelper              util.TwoFactorHelper
+	oidcClient                   util.OidcClient
 }
 
 type AuthenticationUsecaseImplOpts struct {
@@ -55,10 +76,15 @@ type AuthenticationUsecaseImplOpts struct {
 	VerificationCodeRepository   repository.VerificationCodeRepository
 	RefreshTokenRepositoy        repository.RefreshTokenRepository
 	ResetPasswordTokenRepository repository.ResetPasswordTokenRepository
+	AccountSessionRepository     repository.AccountSessionRepository
+	TwoFactorRepository          repository.TwoFactorRepository
+	RoleRepository               repository.RoleRepository
+	OidcRepository               repository.OidcRepository
 	Transaction                  repository.Transaction
 	HashHelper                   util.HashHelperIntf
 	JwtHelper                    util.JwtAuthentication
-	EmailHelper                  util.EmailHelper
+	TwoFactorHelper              util.TwoFactorHelper
+	OidcClient                   util.OidcClient
 }
 
 func NewAuthenticationUsecaseImpl(opts AuthenticationUsecaseImplOpts) authenticationUsecaseImpl {
@@ -69,10 +95,15 @@ func NewAuthenticationUsecaseImpl(opts AuthenticationUsecaseImplOpts) authentica
 		verificationCodeRepository:   opts.VerificationCodeRepository,
 		refreshTokenRepository:       opts.RefreshTokenRepositoy,
 		resetPasswordTokenRepository: opts.ResetPasswordTokenRepository,
+		accountSessionRepository:     opts.AccountSessionRepository,
+		twoFactorRepository:          opts.TwoFactorRepository,
+		roleRepository:               opts.RoleRepository,
+		oidcRepository:               opts.OidcRepository,
 		transaction:                  opts.Transaction,
 		hashHelper:                   opts.HashHelper,
 		jwtHelper:                    opts.JwtHelper,
-		emailHelper:                  opts.EmailHelper,
+		twoFactorHelper:              opts.TwoFactorHelper,
+		oidcClient:                   opts.OidcClient,
 	}
 }
 
@@ -107,7 +138,7 @@ func (u *authenticationUsecaseImpl) RegisterDoctor(ctx context.Context, register
 		return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 	}
 
-	imageUrl, err := util.UploadToCloudinary(file, *filePath)
+	imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -216,13 +247,11 @@ func (u *authenticationUsecaseImpl) SendVerificationEmail(ctx context.Context, s
 		return apperror.NewAppError(http.StatusForbidden, errors.New("account has been verified"), "account has been verified")
 	}
 
-	u.emailHelper.AddRequest([]string{sendEmailRequest.Email}, appconstant.VerificationEmailSubject)
-
 	verificationToken, err := u.jwtHelper.CreateAndSign(util.JwtCustomClaims{
 		AccountId:     acc.Id,
 		Email:         sendEmailRequest.Email,
 		TokenDuration: 60,
-	}, u.jwtHelper.Config.VerifSecret)
+	}, appconstant.VerificationTokenAudience)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -230,19 +259,6 @@ func (u *authenticationUsecaseImpl) SendVerificationEmail(ctx context.Context, s
 
 	verificationCode := util.GenerateCode(6)
 
-	err = u.emailHelper.CreateBody(appconstant.VerificationEmailTemplate, struct {
-		Name string
-		Url  string
-		Code string
-	}{
-		Name: acc.Name,
-		Url:  verificationUrl,
-		Code: verificationCode,
-	})
-	if err != nil {
-		return apperror.InternalServerError(err)
-	}
-
 	tx, err := u.transaction.BeginTx()
 	if err != nil {
 		return apperror.InternalServerError(err)
@@ -268,7 +284,15 @@ func (u *authenticationUsecaseImpl) SendVerificationEmail(ctx context.Context, s
 		return apperror.InternalServerError(err)
 	}
 
-	err = u.emailHelper.SendEmail()
+	err = enqueueEmail(ctx, tx.EmailOutboxRepository(), appconstant.EmailTypeVerification, sendEmailRequest.Email, struct {
+		Name string
+		Url  string
+		Code string
+	}{
+		Name: acc.Name,
+		Url:  verificationUrl,
+		Code: verificationCode,
+	})
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -276,8 +300,7 @@ func (u *authenticationUsecaseImpl) SendVerificationEmail(ctx context.Context, s
 	return nil
 }
 
-func (u *authenticationUsecaseImpl) Login(ctx context.Context, account entity.Account) (*entity.Tokens, error) {
-	tokens := entity.Tokens{}
+func (u *authenticationUsecaseImpl) Login(ctx context.Context, account entity.Account, session entity.AccountSession) (*entity.LoginResult, error) {
 	userCredential, err := u.accountRepository.FindAccountByEmail(ctx, account.Email)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
@@ -291,25 +314,65 @@ func (u *authenticationUsecaseImpl) Login(ctx context.Context, account entity.Ac
 		return nil, apperror.AccountNotVerifiedError()
 	}
 
+	lockedFor, err := u.accountRepository.FindLockRemainingSecondsById(ctx, userCredential.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if lockedFor > 0 {
+		return nil, apperror.AccountLockedError(lockedFor)
+	}
+
 	isPassword, err := u.hashHelper.CheckPassword(account.Password, []byte(userCredential.Password))
 	if !isPassword {
-		return nil, apperror.WrongPasswordError(err)
+		return nil, u.recordFailedLogin(ctx, *userCredential, apperror.WrongPasswordError(err))
 	}
 
-	customClaims := util.JwtCustomClaims{AccountId: userCredential.Id, Email: userCredential.Email, Role: userCredential.RoleName, TokenDuration: 15}
-	accessToken, err := u.jwtHelper.CreateAndSign(customClaims, u.jwtHelper.Config.AccessSecret)
+	return u.completeLogin(ctx, *userCredential, session)
+}
+
+func (u *authenticationUsecaseImpl) completeLogin(ctx context.Context, account entity.Account, session entity.AccountSession) (*entity.LoginResult, error) {
+	twoFactor, err := u.twoFactorRepository.FindByAccountId(ctx, account.Id)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	customClaims.TokenDuration = 24 * 60
-	refreshToken, err := u.jwtHelper.CreateAndSign(customClaims, u.jwtHelper.Config.RefreshSecret)
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		challengeToken, err := u.jwtHelper.CreateAndSign(util.JwtCustomClaims{
+			AccountId:     account.Id,
+			Email:         account.Email,
+			Role:          account.RoleName,
+			TokenDuration: appconstant.TwoFactorChallengeDuration,
+			TokenId:       uuid.NewString(),
+		}, appconstant.TwoFactorChallengeAudience)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
+		return &entity.LoginResult{TwoFactorRequired: true, ChallengeToken: *challengeToken}, nil
+	}
+
+	tokens, err := u.startSession(ctx, account, session, false)
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, err
 	}
 
-	tokens.AccessToken = *accessToken
-	tokens.RefreshToken = *refreshToken
+	return &entity.LoginResult{Tokens: tokens}, nil
+}
+
+func (u *authenticationUsecaseImpl) startSession(ctx context.Context, account entity.Account, session entity.AccountSession, isTwoFactorVerified bool) (tokens *entity.Tokens, err error) {
+	session.SessionId = uuid.NewString()
+	session.AccountId = account.Id
+
+	tokens, err = u.createTokens(util.JwtCustomClaims{
+		AccountId: account.Id,
+		Email:     account.Email,
+		Role:      account.RoleName,
+		SessionId: session.SessionId,
+		TwoFactor: isTwoFactorVerified,
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	tx, err := u.transaction.BeginTx()
 	if err != nil {
@@ -317,49 +380,183 @@ func (u *authenticationUsecaseImpl) Login(ctx context.Context, account entity.Ac
 	}
 
 	refreshTokenRepo := tx.RefreshTokenRepository()
+	accountSessionRepo := tx.AccountSessionRepository()
 
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
 		}
 
-		tx.Commit()
+		err = tx.Commit()
 	}()
 
-	err = refreshTokenRepo.InvalidateCodes(ctx, userCredential.Id)
+	err = tx.AccountRepository().ResetFailedLogins(ctx, account.Id)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	err = refreshTokenRepo.PostOneCode(ctx, userCredential.Id, *refreshToken)
+	err = accountSessionRepo.CreateSession(ctx, session)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	return &tokens, nil
+	err = refreshTokenRepo.PostOneCode(ctx, account.Id, session.SessionId, tokens.RefreshToken)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return tokens, nil
+}
+
+func (u *authenticationUsecaseImpl) recordFailedLogin(ctx context.Context, account entity.Account, failedErr error) error {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	loginAttempt, err := tx.AccountRepository().RecordFailedLogin(ctx, account.Id, appconstant.MaxFailedLoginAttempts, appconstant.LoginLockoutBaseDuration, appconstant.LoginLockoutMaxDuration)
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	if loginAttempt.LockedFor != 0 && loginAttempt.FailedAttempts%appconstant.MaxFailedLoginAttempts == 0 {
+		err = enqueueEmail(ctx, tx.EmailOutboxRepository(), appconstant.EmailTypeAccountLocked, account.Email, struct {
+			Name     string
+			Attempts int
+			Duration string
+		}{
+			Name:     account.Name,
+			Attempts: loginAttempt.FailedAttempts,
+			Duration: (time.Duration(loginAttempt.LockedFor) * time.Second).Round(time.Minute).String(),
+		})
+		if err != nil {
+			tx.Rollback()
+			return apperror.InternalServerError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if loginAttempt.LockedFor == 0 {
+		return failedErr
+	}
+
+	return apperror.AccountLockedError(loginAttempt.LockedFor)
 }
 
-func (u *authenticationUsecaseImpl) GetNewAccessToken(ctx context.Context, refreshToken string) (string, error) {
-	claim, err := u.jwtHelper.ParseAndVerify(refreshToken, u.jwtHelper.Config.RefreshSecret)
+func (u *authenticationUsecaseImpl) GetNewAccessToken(ctx context.Context, refreshToken string) (*entity.Tokens, error) {
+	claims, err := u.jwtHelper.ParseAndVerify(refreshToken, appconstant.RefreshTokenAudience)
 	if err != nil {
-		return "", apperror.InternalServerError(err)
+		return nil, apperror.RefreshTokenExpiredError()
 	}
 
-	refreshToken, err = u.refreshTokenRepository.FindOneCode(ctx, refreshToken)
+	tokens, isReused, err := u.rotateRefreshToken(ctx, refreshToken, *claims)
 	if err != nil {
-		return "", apperror.InternalServerError(err)
+		return nil, err
 	}
-	if refreshToken == "" {
-		return "", apperror.RefreshTokenExpiredError()
+	if isReused {
+		return nil, apperror.RefreshTokenReusedError()
 	}
 
-	claim.TokenDuration = 15
-	accessToken, err := u.jwtHelper.CreateAndSign(*claim, u.jwtHelper.Config.AccessSecret)
+	return tokens, nil
+}
+
+func (u *authenticationUsecaseImpl) rotateRefreshToken(ctx context.Context, refreshToken string, claims util.JwtCustomClaims) (tokens *entity.Tokens, isReused bool, err error) {
+	tx, err := u.transaction.BeginTx()
 	if err != nil {
-		return "", apperror.InternalServerError(err)
+		return nil, false, apperror.InternalServerError(err)
 	}
 
-	return *accessToken, nil
+	refreshTokenRepo := tx.RefreshTokenRepository()
+	accountSessionRepo := tx.AccountSessionRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	storedToken, err := refreshTokenRepo.FindOneCodeForUpdate(ctx, refreshToken)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+	if storedToken == nil || storedToken.RevokedAt != nil {
+		return nil, false, apperror.RefreshTokenExpiredError()
+	}
+
+	if storedToken.UsedAt != nil {
+		err = accountSessionRepo.RevokeSession(ctx, storedToken.SessionId, appconstant.SessionRevokedByTokenReuse)
+		if err != nil {
+			return nil, false, apperror.InternalServerError(err)
+		}
+
+		err = refreshTokenRepo.RevokeCodesBySessionId(ctx, storedToken.SessionId)
+		if err != nil {
+			return nil, false, apperror.InternalServerError(err)
+		}
+
+		return nil, true, nil
+	}
+
+	session, err := accountSessionRepo.FindActiveSession(ctx, storedToken.SessionId)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+	if session == nil {
+		return nil, false, apperror.RefreshTokenExpiredError()
+	}
+
+	err = refreshTokenRepo.MarkCodeUsed(ctx, storedToken.Id)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+
+	claims.AccountId = session.AccountId
+	claims.SessionId = session.SessionId
+	tokens, err = u.createTokens(claims)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = refreshTokenRepo.PostOneCode(ctx, session.AccountId, session.SessionId, tokens.RefreshToken)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+
+	err = accountSessionRepo.RefreshSession(ctx, session.SessionId)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+
+	return tokens, false, nil
+}
+
+func (u *authenticationUsecaseImpl) createTokens(claims util.JwtCustomClaims) (*entity.Tokens, error) {
+	claims.TokenId = ""
+	claims.TokenDuration = appconstant.AccessTokenDuration
+	accessToken, err := u.jwtHelper.CreateAndSign(claims, appconstant.AccessTokenAudience)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	claims.TokenId = uuid.NewString()
+	claims.TokenDuration = appconstant.RefreshTokenDuration
+	refreshToken, err := u.jwtHelper.CreateAndSign(claims, appconstant.RefreshTokenAudience)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &entity.Tokens{
+		AccessToken:  *accessToken,
+		RefreshToken: *refreshToken,
+	}, nil
 }
 
 func (u *authenticationUsecaseImpl) VerifyOneAccount(ctx context.Context, verificationPasswordRequest dto.VerificationPasswordRequest) error {
@@ -414,7 +611,7 @@ func (s *authenticationUsecaseImpl) VerifyToken(ctx context.Context, accessToken
 		return nil, apperror.InvalidTokenError()
 	}
 
-	claims, err := s.jwtHelper.ParseAndVerify(accessToken, s.jwtHelper.Config.AccessSecret)
+	claims, err := s.jwtHelper.ParseAndVerify(accessToken, appconstant.AccessTokenAudience)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
@@ -422,8 +619,18 @@ func (s *authenticationUsecaseImpl) VerifyToken(ctx context.Context, accessToken
 		return nil, apperror.InvalidTokenError()
 	}
 
+	isActive, err := s.IsSessionActive(ctx, claims.SessionId)
+	if err != nil {
+		return nil, err
+	}
+	if !isActive {
+		return nil, apperror.InvalidTokenError()
+	}
+
 	return &entity.TokenData{
 		AccountId: claims.AccountId,
 		Role:      claims.Role,
+		SessionId: claims.SessionId,
+		TwoFactor: claims.TwoFactor,
 	}, nil
 }
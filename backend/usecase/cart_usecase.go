@@ -13,25 +13,27 @@ import (
 
 type CartUsecase interface {
 	CalculateDeliveryFee(ctx context.Context, deliveryFeeRequest dto.DeliveryFeeRequest) (*dto.AllDeliveryFeeResponse, error)
-	CreateOneCart(ctx context.Context, pharmacyDrugId int64) error
+	CreateOneCart(ctx context.Context, pharmacyDrugId int64, prescriptionId *int64) error
 	UpdateOneCart(ctx context.Context, cartItemID int64, quantity int) error
 	DeleteOneCart(ctx context.Context, cartItemID int64) error
 	GetAllCartById(ctx context.Context, page string, limit string) (*dto.CartDTOResponse, error)
 }
 
 type cartUsecaseImpl struct {
-	userRepository         repository.UserRepository
-	userAddressRepository  repository.UserAddressRepository
-	cartRepository         repository.CartRepository
-	pharmacyDrugRepository repository.PharmacyDrugRepository
+	userRepository             repository.UserRepository
+	userAddressRepository      repository.UserAddressRepository
+	cartRepository             repository.CartRepository
+	pharmacyDrugRepository     repository.PharmacyDrugRepository
+	prescriptionDrugRepository repository.PrescriptionDrugRepository
 }
 
-func NewCartUsecaseImpl(pharmacyDrugRepository repository.PharmacyDrugRepository, userRepository repository.UserRepository, userAddressRepository repository.UserAddressRepository, cartRepository repository.CartRepository) cartUsecaseImpl {
+func NewCartUsecaseImpl(pharmacyDrugRepository repository.PharmacyDrugRepository, userRepository repository.UserRepository, userAddressRepository repository.UserAddressRepository, cartRepository repository.CartRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository) cartUsecaseImpl {
 	return cartUsecaseImpl{
-		userRepository:         userRepository,
-		userAddressRepository:  userAddressRepository,
-		cartRepository:         cartRepository,
-		pharmacyDrugRepository: pharmacyDrugRepository,
+		userRepository:             userRepository,
+		userAddressRepository:      userAddressRepository,
+		cartRepository:             cartRepository,
+		pharmacyDrugRepository:     pharmacyDrugRepository,
+		prescriptionDrugRepository: prescriptionDrugRepository,
 	}
 }
 
@@ -83,7 +85,7 @@ func (u *cartUsecaseImpl) CalculateDeliveryFee(ctx context.Context, deliveryFeeR
 	return &deliveryFeesResponse, nil
 }
 
-func (u *cartUsecaseImpl) CreateOneCart(ctx context.Context, pharmacyDrugId int64) error {
+func (u *cartUsecaseImpl) CreateOneCart(ctx context.Context, pharmacyDrugId int64, prescriptionId *int64) error {
 	id := appconstant.AccountId
 
 	accountId := ctx.Value(id)
@@ -105,11 +107,26 @@ func (u *cartUsecaseImpl) CreateOneCart(ctx context.Context, pharmacyDrugId int6
 		return apperror.BadRequestError(err)
 	}
 
+	if pharmacyDrug == nil {
+		return apperror.BadRequestError(errors.New("pharmacy drug not found"))
+	}
+
 	if pharmacyDrug.Stock < 1 {
 		return apperror.NewAppError(422, errors.New("insufficient stock"), "insufficient stock")
 	}
 
-	_, err = u.cartRepository.PostOneCart(ctx, accountID, pharmacyDrugId, 1)
+	if pharmacyDrug.IsPrescriptionRequired && prescriptionId == nil {
+		return apperror.PrescriptionRequiredError()
+	}
+
+	if prescriptionId != nil {
+		err = u.checkPrescriptionCoverage(ctx, accountID, *prescriptionId, pharmacyDrugId, 1)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = u.cartRepository.PostOneCart(ctx, accountID, pharmacyDrugId, 1, prescriptionId)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -143,6 +160,18 @@ func (u *cartUsecaseImpl) UpdateOneCart(ctx context.Context, cartItemID int64, q
 		return apperror.NewAppError(422, errors.New("insufficient stock"), "insufficient stock")
 	}
 
+	cartPrescription, err := u.cartRepository.GetCartPrescription(ctx, cartItemID)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if cartPrescription != nil && cartPrescription.PrescriptionId != nil {
+		err = u.checkPrescriptionCoverage(ctx, accountID, *cartPrescription.PrescriptionId, cartPrescription.PharmacyDrugId, quantity)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = u.cartRepository.UpdateOneCart(ctx, accountID, cartItemID, quantity)
 	if err != nil {
 		return apperror.InternalServerError(err)
@@ -232,3 +261,20 @@ func (u *cartUsecaseImpl) GetAllCartById(ctx context.Context, limit string, page
 
 	return &cartResponse, nil
 }
+
+func (u *cartUsecaseImpl) checkPrescriptionCoverage(ctx context.Context, accountId, prescriptionId, pharmacyDrugId int64, quantity int) error {
+	remainingQuantity, err := u.prescriptionDrugRepository.GetRemainingPrescriptionQuantity(ctx, accountId, prescriptionId, pharmacyDrugId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if remainingQuantity == nil {
+		return apperror.PrescriptionNotCoveringDrugError()
+	}
+
+	if quantity > *remainingQuantity {
+		return apperror.PrescriptionQuantityExceededError()
+	}
+
+	return nil
+}
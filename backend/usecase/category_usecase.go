@@ -48,7 +48,7 @@ func (u *categoryUsecaseImpl) DeleteOneCategoryById(ctx context.Context, categor
 	}
 
 	if strings.Split(category.Url, "/")[1] == "res.cloudinary.com" {
-		util.DeleteInCloudinary(category.Url)
+		deleteUploadedFile(ctx, category.Url)
 	}
 
 	err = u.categoryRepository.DeleteOneCategoryById(ctx, categoryId)
@@ -72,7 +72,7 @@ func (u *categoryUsecaseImpl) AddOneCategory(ctx context.Context, newCategory en
 		return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 	}
 
-	imageUrl, err := util.UploadToCloudinary(file, *filePath)
+	imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -108,13 +108,13 @@ func (u *categoryUsecaseImpl) UpdateOneCategory(ctx context.Context, updatedCate
 			return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 		}
 
-		imageUrl, err := util.UploadToCloudinary(file, *filePath)
+		imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 		if err != nil {
 			return apperror.InternalServerError(err)
 		}
 		updatedCategory.Url = imageUrl
 		if strings.Split(dbCategory.Url, "/")[1] == "res.cloudinary.com" {
-			util.DeleteInCloudinary(dbCategory.Url)
+			deleteUploadedFile(ctx, dbCategory.Url)
 		}
 	} else {
 		updatedCategory.Url = dbCategory.Url
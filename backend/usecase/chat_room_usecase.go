@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"max-health/appconstant"
 	"max-health/apperror"
 	"max-health/entity"
 	"max-health/repository"
@@ -16,25 +17,30 @@ type ChatRoomUsecasae interface {
 	CloseChatRoom(ctx context.Context, userAccountId, roomId int64) error
 	DoctorJoinRoom(ctx context.Context, doctorAccountId, roomId int64) error
 	GetRoomDetail(ctx context.Context, accountId, roomId int64) (*entity.WsChatRoom, error)
+	GetChatHistory(ctx context.Context, accountId, roomId int64, beforeChatId *int64, limit int) (*entity.ChatHistory, error)
 }
 
 type chatRoomUsecaseImpl struct {
-	userRepository             repository.UserRepository
-	doctorRepository           repository.DoctorRepository
-	wsChatRoomRepository       repository.WsChatRoomRepository
-	accountRepository          repository.AccountRepository
-	chatRepository             repository.ChatRepository
-	prescriptionDrugRepository repository.PrescriptionDrugRepository
+	userRepository              repository.UserRepository
+	doctorRepository            repository.DoctorRepository
+	wsChatRoomRepository        repository.WsChatRoomRepository
+	accountRepository           repository.AccountRepository
+	chatRepository              repository.ChatRepository
+	prescriptionDrugRepository  repository.PrescriptionDrugRepository
+	patientHealthProfileUsecase PatientHealthProfileUsecase
+	notificationRepository      repository.NotificationRepository
 }
 
-func NewChatRoomUsecaseImpl(userRepository repository.UserRepository, doctorRepository repository.DoctorRepository, wsChatRoomRepository repository.WsChatRoomRepository, accountRepository repository.AccountRepository, chatRepository repository.ChatRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository) *chatRoomUsecaseImpl {
+func NewChatRoomUsecaseImpl(userRepository repository.UserRepository, doctorRepository repository.DoctorRepository, wsChatRoomRepository repository.WsChatRoomRepository, accountRepository repository.AccountRepository, chatRepository repository.ChatRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, patientHealthProfileUsecase PatientHealthProfileUsecase, notificationRepository repository.NotificationRepository) *chatRoomUsecaseImpl {
 	return &chatRoomUsecaseImpl{
-		userRepository:             userRepository,
-		doctorRepository:           doctorRepository,
-		wsChatRoomRepository:       wsChatRoomRepository,
-		accountRepository:          accountRepository,
-		chatRepository:             chatRepository,
-		prescriptionDrugRepository: prescriptionDrugRepository,
+		userRepository:              userRepository,
+		doctorRepository:            doctorRepository,
+		wsChatRoomRepository:        wsChatRoomRepository,
+		accountRepository:           accountRepository,
+		chatRepository:              chatRepository,
+		prescriptionDrugRepository:  prescriptionDrugRepository,
+		patientHealthProfileUsecase: patientHealthProfileUsecase,
+		notificationRepository:      notificationRepository,
 	}
 }
 
@@ -71,6 +77,9 @@ func (u *chatRoomUsecaseImpl) UserCreateRoom(ctx context.Context, userAccountId,
 	if doctor == nil {
 		return nil, apperror.DoctorNotFoundError()
 	}
+	if doctor.VerificationStatus != appconstant.DoctorVerificationApproved {
+		return nil, apperror.DoctorNotFoundError()
+	}
 
 	chatRoom, err := u.wsChatRoomRepository.FindActiveWsChatRoom(ctx, user.AccountId, doctor.AccountId)
 	if err != nil {
@@ -101,6 +110,17 @@ func (u *chatRoomUsecaseImpl) UserCreateRoom(ctx context.Context, userAccountId,
 
 	newWsChatRoom.Id = *roomId
 
+	notification, err := newNotification(appconstant.NotificationTypeChatRoomCreated, appconstant.NotificationTitleChatRoomCreated,
+		appconstant.NotificationMessageChatRoomCreated, map[string]any{"room_id": newWsChatRoom.Id, "user_account_id": user.AccountId})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	notification.AccountId = doctor.AccountId
+
+	if err := u.notificationRepository.CreateOne(ctx, notification); err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
 	return &newWsChatRoom, nil
 }
 
@@ -142,6 +162,17 @@ func (u *chatRoomUsecaseImpl) DoctorJoinRoom(ctx context.Context, doctorAccountI
 		return apperror.ForbiddenAction()
 	}
 
+	doctor, err := u.doctorRepository.FindDoctorByAccountId(ctx, doctorAccountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if doctor == nil {
+		return apperror.DoctorNotFoundError()
+	}
+	if doctor.VerificationStatus != appconstant.DoctorVerificationApproved {
+		return apperror.DoctorNotApprovedError()
+	}
+
 	err = u.wsChatRoomRepository.StartWsChat(ctx, roomId)
 	if err != nil {
 		return apperror.InternalServerError(err)
@@ -151,6 +182,39 @@ func (u *chatRoomUsecaseImpl) DoctorJoinRoom(ctx context.Context, doctorAccountI
 }
 
 func (u *chatRoomUsecaseImpl) GetRoomDetail(ctx context.Context, accountId, roomId int64) (*entity.WsChatRoom, error) {
+	room, err := u.findParticipatedRoom(ctx, accountId, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	chatHistory, err := u.getChatHistory(ctx, room.Id, nil, appconstant.ChatHistoryDefaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	room.Chats = chatHistory.Chats
+	room.NextChatCursor = chatHistory.NextCursor
+
+	if room.DoctorAccountId == accountId && room.ExpiredAt != nil && *room.ExpiredAt > time.Now().UnixMicro() {
+		room.PatientHealthProfile, err = u.patientHealthProfileUsecase.GetHealthProfileForRoom(ctx, *room, appconstant.HealthProfileAccessSourceRoomDetail)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return room, nil
+}
+
+func (u *chatRoomUsecaseImpl) GetChatHistory(ctx context.Context, accountId, roomId int64, beforeChatId *int64, limit int) (*entity.ChatHistory, error) {
+	room, err := u.findParticipatedRoom(ctx, accountId, roomId)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.getChatHistory(ctx, room.Id, beforeChatId, limit)
+}
+
+func (u *chatRoomUsecaseImpl) findParticipatedRoom(ctx context.Context, accountId, roomId int64) (*entity.WsChatRoom, error) {
 	account, err := u.accountRepository.FindOneById(ctx, accountId)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
@@ -171,18 +235,41 @@ func (u *chatRoomUsecaseImpl) GetRoomDetail(ctx context.Context, accountId, room
 		return nil, apperror.UnauthorizedError()
 	}
 
-	chats, err := u.chatRepository.GetAllChat(ctx, room.Id)
+	return room, nil
+}
+
+func (u *chatRoomUsecaseImpl) getChatHistory(ctx context.Context, roomId int64, beforeChatId *int64, limit int) (*entity.ChatHistory, error) {
+	chats, err := u.chatRepository.GetChatHistory(ctx, roomId, beforeChatId, limit+1)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
+	var nextCursor *int64
+
+	if len(chats) > limit {
+		chats = chats[1:]
+		nextCursor = &chats[0].Id
+	}
+
+	chatList, err := attachPrescriptionDrugs(ctx, u.prescriptionDrugRepository, chats)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.ChatHistory{
+		Chats:      chatList,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func attachPrescriptionDrugs(ctx context.Context, prescriptionDrugRepository repository.PrescriptionDrugRepository, chats []entity.Chat) ([]entity.Chat, error) {
 	var chatList []entity.Chat
 
 	for _, chat := range chats {
 		if chat.Prescription.Id != nil {
-			prescriptionDrugList, err := u.prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, *chat.Prescription.Id)
+			prescriptionDrugList, err := prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, *chat.Prescription.Id)
 			if err != nil {
-				return nil, err
+				return nil, apperror.InternalServerError(err)
 			}
 
 			chat.Prescription.PrescriptionDrugs = prescriptionDrugList
@@ -191,7 +278,5 @@ func (u *chatRoomUsecaseImpl) GetRoomDetail(ctx context.Context, accountId, room
 		chatList = append(chatList, chat)
 	}
 
-	room.Chats = chatList
-
-	return room, nil
+	return chatList, nil
 }
@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type ConsultationNoteUsecase interface {
+	UpsertConsultationNote(ctx context.Context, doctorAccountId, roomId int64, request dto.UpsertConsultationNoteRequest) (*entity.ConsultationNote, error)
+	GetConsultationNote(ctx context.Context, accountId, roomId int64) (*entity.ConsultationNote, error)
+	GetMedicalHistory(ctx context.Context, userAccountId int64, page, limit string) (*dto.MedicalHistoryResponse, error)
+	ExportMedicalRecord(ctx context.Context, userAccountId int64) (*entity.MedicalRecord, error)
+}
+
+type consultationNoteUsecaseImpl struct {
+	transaction                 repository.Transaction
+	accountRepository           repository.AccountRepository
+	userRepository              repository.UserRepository
+	wsChatRoomRepository        repository.WsChatRoomRepository
+	consultationNoteRepository  repository.ConsultationNoteRepository
+	prescriptionRepository      repository.PrescriptionRepository
+	prescriptionDrugRepository  repository.PrescriptionDrugRepository
+	patientHealthProfileUsecase PatientHealthProfileUsecase
+}
+
+func NewConsultationNoteUsecaseImpl(transaction repository.Transaction, accountRepository repository.AccountRepository, userRepository repository.UserRepository, wsChatRoomRepository repository.WsChatRoomRepository, consultationNoteRepository repository.ConsultationNoteRepository, prescriptionRepository repository.PrescriptionRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, patientHealthProfileUsecase PatientHealthProfileUsecase) consultationNoteUsecaseImpl {
+	return consultationNoteUsecaseImpl{
+		transaction:                 transaction,
+		accountRepository:           accountRepository,
+		userRepository:              userRepository,
+		wsChatRoomRepository:        wsChatRoomRepository,
+		consultationNoteRepository:  consultationNoteRepository,
+		prescriptionRepository:      prescriptionRepository,
+		prescriptionDrugRepository:  prescriptionDrugRepository,
+		patientHealthProfileUsecase: patientHealthProfileUsecase,
+	}
+}
+
+func (u *consultationNoteUsecaseImpl) UpsertConsultationNote(ctx context.Context, doctorAccountId, roomId int64, request dto.UpsertConsultationNoteRequest) (*entity.ConsultationNote, error) {
+	room, err := u.wsChatRoomRepository.FindChatRoomById(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if room == nil || room.DoctorAccountId != doctorAccountId {
+		return nil, apperror.ChatRoomNotFoundError()
+	}
+	if room.ExpiredAt == nil {
+		return nil, apperror.ChatRoomNotActiveError()
+	}
+
+	consultationNoteId, err := u.saveConsultationNote(ctx, request.ToConsultationNote(*room))
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findConsultationNote(ctx, consultationNoteId)
+}
+
+func (u *consultationNoteUsecaseImpl) GetConsultationNote(ctx context.Context, accountId, roomId int64) (*entity.ConsultationNote, error) {
+	room, err := u.wsChatRoomRepository.FindChatRoomById(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if room == nil || (room.DoctorAccountId != accountId && room.UserAccountId != accountId) {
+		return nil, apperror.ChatRoomNotFoundError()
+	}
+
+	consultationNote, err := u.consultationNoteRepository.FindConsultationNoteByRoomId(ctx, room.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if consultationNote == nil {
+		return nil, apperror.ConsultationNoteNotFoundError()
+	}
+
+	consultationNote.Diagnoses, err = u.consultationNoteRepository.GetConsultationDiagnosesByNoteId(ctx, consultationNote.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return consultationNote, nil
+}
+
+func (u *consultationNoteUsecaseImpl) GetMedicalHistory(ctx context.Context, userAccountId int64, page, limit string) (*dto.MedicalHistoryResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	entryList, err := u.consultationNoteRepository.GetMedicalHistoryByUserAccountId(ctx, userAccountId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.consultationNoteRepository.GetMedicalHistoryByUserAccountIdTotalItem(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for i, entry := range entryList {
+		switch entry.Type {
+		case appconstant.MedicalHistoryEntryConsultationNote:
+			entryList[i].ConsultationNote, err = u.findConsultationNote(ctx, entry.Id)
+		case appconstant.MedicalHistoryEntryPrescription:
+			entryList[i].Prescription, err = u.findPrescription(ctx, entry.Id)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToMedicalHistoryResponse(entryList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *consultationNoteUsecaseImpl) ExportMedicalRecord(ctx context.Context, userAccountId int64) (*entity.MedicalRecord, error) {
+	account, err := u.accountRepository.FindOneById(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return nil, apperror.AccountNotFoundError()
+	}
+
+	user, err := u.userRepository.FindUserByAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user == nil {
+		return nil, apperror.UserNotFoundError()
+	}
+
+	healthProfile, err := u.patientHealthProfileUsecase.GetHealthProfile(ctx, userAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	consultationNoteList, err := u.consultationNoteRepository.GetConsultationNotesByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for i, consultationNote := range consultationNoteList {
+		consultationNoteList[i].Diagnoses, err = u.consultationNoteRepository.GetConsultationDiagnosesByNoteId(ctx, consultationNote.Id)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+	}
+
+	prescriptionList, err := u.prescriptionRepository.GetMedicalRecordPrescriptionsByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for i, prescription := range prescriptionList {
+		prescriptionList[i].PrescriptionDrugs, err = u.prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, *prescription.Id)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+	}
+
+	return &entity.MedicalRecord{
+		Account:           *account,
+		User:              *user,
+		HealthProfile:     *healthProfile,
+		ConsultationNotes: consultationNoteList,
+		Prescriptions:     prescriptionList,
+		ExportedAt:        time.Now(),
+	}, nil
+}
+
+func (u *consultationNoteUsecaseImpl) saveConsultationNote(ctx context.Context, consultationNote entity.ConsultationNote) (consultationNoteId int64, err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	consultationNoteRepo := tx.ConsultationNoteRepository()
+
+	consultationNoteId, err = consultationNoteRepo.UpsertConsultationNote(ctx, consultationNote)
+	if err != nil {
+		return 0, err
+	}
+
+	err = consultationNoteRepo.ReplaceConsultationDiagnoses(ctx, consultationNoteId, consultationNote.Diagnoses)
+	if err != nil {
+		return 0, err
+	}
+
+	return consultationNoteId, nil
+}
+
+func (u *consultationNoteUsecaseImpl) findConsultationNote(ctx context.Context, consultationNoteId int64) (*entity.ConsultationNote, error) {
+	consultationNote, err := u.consultationNoteRepository.FindConsultationNoteById(ctx, consultationNoteId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if consultationNote == nil {
+		return nil, apperror.ConsultationNoteNotFoundError()
+	}
+
+	consultationNote.Diagnoses, err = u.consultationNoteRepository.GetConsultationDiagnosesByNoteId(ctx, consultationNote.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return consultationNote, nil
+}
+
+func (u *consultationNoteUsecaseImpl) findPrescription(ctx context.Context, prescriptionId int64) (*entity.Prescription, error) {
+	prescription, err := u.prescriptionRepository.GetMedicalRecordPrescriptionById(ctx, prescriptionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if prescription == nil {
+		return nil, apperror.InvalidPrescriptionIdError()
+	}
+
+	prescription.PrescriptionDrugs, err = u.prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, prescriptionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return prescription, nil
+}
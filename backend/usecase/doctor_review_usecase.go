@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type DoctorReviewUsecase interface {
+	CreateDoctorReview(ctx context.Context, userAccountId, roomId int64, request dto.CreateDoctorReviewRequest) (*entity.DoctorReview, error)
+	GetAllDoctorReviewsForPublic(ctx context.Context, doctorId int64, page, limit string) (*dto.DoctorReviewListResponse, error)
+	GetAllDoctorReviews(ctx context.Context, visibility, page, limit string) (*dto.AdminDoctorReviewListResponse, error)
+	UpdateDoctorReviewVisibility(ctx context.Context, doctorReviewId int64, request dto.UpdateDoctorReviewVisibilityRequest) error
+}
+
+type doctorReviewUsecaseImpl struct {
+	transaction            repository.Transaction
+	doctorRepository       repository.DoctorRepository
+	wsChatRoomRepository   repository.WsChatRoomRepository
+	doctorReviewRepository repository.DoctorReviewRepository
+}
+
+func NewDoctorReviewUsecaseImpl(transaction repository.Transaction, doctorRepository repository.DoctorRepository, wsChatRoomRepository repository.WsChatRoomRepository, doctorReviewRepository repository.DoctorReviewRepository) doctorReviewUsecaseImpl {
+	return doctorReviewUsecaseImpl{
+		transaction:            transaction,
+		doctorRepository:       doctorRepository,
+		wsChatRoomRepository:   wsChatRoomRepository,
+		doctorReviewRepository: doctorReviewRepository,
+	}
+}
+
+func (u *doctorReviewUsecaseImpl) CreateDoctorReview(ctx context.Context, userAccountId, roomId int64, request dto.CreateDoctorReviewRequest) (*entity.DoctorReview, error) {
+	chatRoom, err := u.wsChatRoomRepository.FindChatRoomById(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if chatRoom == nil {
+		return nil, apperror.ChatRoomNotFoundError()
+	}
+
+	if chatRoom.UserAccountId != userAccountId {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	if chatRoom.ExpiredAt == nil || *chatRoom.ExpiredAt >= time.Now().UnixMicro() {
+		return nil, apperror.ChatRoomNotClosedError()
+	}
+
+	existingReview, err := u.doctorReviewRepository.FindDoctorReviewByRoomId(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if existingReview != nil {
+		return nil, apperror.DoctorReviewAlreadyExistsError()
+	}
+
+	doctorReviewId, err := u.doctorReviewRepository.CreateDoctorReview(ctx, entity.DoctorReview{
+		WsChatRoomId:    roomId,
+		UserAccountId:   userAccountId,
+		DoctorAccountId: chatRoom.DoctorAccountId,
+		Rating:          request.Rating,
+		Review:          request.Review,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	doctorReview, err := u.doctorReviewRepository.FindDoctorReviewById(ctx, *doctorReviewId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if doctorReview == nil {
+		return nil, apperror.DoctorReviewNotFoundError()
+	}
+
+	return doctorReview, nil
+}
+
+func (u *doctorReviewUsecaseImpl) GetAllDoctorReviewsForPublic(ctx context.Context, doctorId int64, page, limit string) (*dto.DoctorReviewListResponse, error) {
+	doctor, err := u.doctorRepository.FindDoctorByDoctorId(ctx, doctorId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if doctor == nil {
+		return nil, apperror.DoctorNotFoundError()
+	}
+
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	doctorReviewList, err := u.doctorReviewRepository.GetAllVisibleDoctorReviewByDoctorId(ctx, doctorId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.doctorReviewRepository.GetAllVisibleDoctorReviewByDoctorIdTotalItem(ctx, doctorId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToDoctorReviewListResponse(doctorReviewList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *doctorReviewUsecaseImpl) GetAllDoctorReviews(ctx context.Context, visibility, page, limit string) (*dto.AdminDoctorReviewListResponse, error) {
+	if visibility != "" && visibility != appconstant.DoctorReviewVisibilityHidden && visibility != appconstant.DoctorReviewVisibilityVisible {
+		return nil, apperror.InvalidDoctorReviewVisibilityError()
+	}
+
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	doctorReviewList, err := u.doctorReviewRepository.GetAllDoctorReview(ctx, visibility, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.doctorReviewRepository.GetAllDoctorReviewTotalItem(ctx, visibility)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToAdminDoctorReviewListResponse(doctorReviewList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *doctorReviewUsecaseImpl) UpdateDoctorReviewVisibility(ctx context.Context, doctorReviewId int64, request dto.UpdateDoctorReviewVisibilityRequest) (err error) {
+	doctorReview, err := u.doctorReviewRepository.FindDoctorReviewById(ctx, doctorReviewId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if doctorReview == nil {
+		return apperror.DoctorReviewNotFoundError()
+	}
+
+	var hiddenReason *string
+	if *request.IsHidden {
+		hiddenReason = &request.Reason
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = tx.DoctorReviewRepository().UpdateDoctorReviewVisibility(ctx, doctorReviewId, *request.IsHidden, hiddenReason)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUpdateDoctorReviewVisibility, appconstant.AuditEntityDoctorReview, doctorReviewId,
+		map[string]any{"is_hidden": doctorReview.IsHidden, "hidden_reason": doctorReview.HiddenReason},
+		map[string]any{"is_hidden": *request.IsHidden, "hidden_reason": hiddenReason})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
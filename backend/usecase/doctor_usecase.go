@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"errors"
+	"math"
 	"mime/multipart"
 	"net/http"
 	"strconv"
@@ -28,12 +29,16 @@ type DoctorUsecase interface {
 		SortBy string,
 		Limit string,
 		SpecializationId string,
-		Page string) (*dto.GetAllDoctorResponse, error)
+		Page string,
+		MinRating string) (*dto.GetAllDoctorResponse, error)
 	GetAllDoctorSpecialization(ctx context.Context) ([]dto.DoctorSpecialization, error)
 	GetProfile(ctx context.Context, accountId int64) (*dto.DoctorProfileResponse, error)
 	GetProfileForPublic(ctx context.Context, doctorId int64) (*dto.DoctorProfileResponse, error)
 	UpdateDoctorStatus(ctx context.Context, doctorAccountId int64, isOnline bool) error
 	GetDoctorIsOnline(ctx context.Context, doctorAccountId int64) (*dto.GetDoctorStatusResponse, error)
+	GetAllDoctorVerifications(ctx context.Context, status, page, limit string) (*dto.DoctorVerificationListResponse, error)
+	GetDoctorVerification(ctx context.Context, doctorId int64) (*dto.DoctorVerificationResponse, error)
+	UpdateDoctorVerification(ctx context.Context, doctorId int64, request dto.UpdateDoctorVerificationRequest) error
 }
 
 type doctorUsecaseImpl struct {
@@ -83,13 +88,13 @@ func (u *doctorUsecaseImpl) UpdateData(ctx context.Context, doctor entity.Detail
 			return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 		}
 
-		imageUrl, err := util.UploadToCloudinary(file, *filePath)
+		imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 		if err != nil {
 			return apperror.InternalServerError(err)
 		}
 		doctor.ProfilePicture = imageUrl
 		if dbAccount.ProfilePicture != "https://res.cloudinary.com/dpdu3tidt/image/upload/v1713774687/profile_pictures/xdv5xzkz1yr0qwgkc6yk.avif" {
-			util.DeleteInCloudinary(dbAccount.ProfilePicture)
+			deleteUploadedFile(ctx, dbAccount.ProfilePicture)
 		}
 	} else {
 		doctor.ProfilePicture = dbAccount.ProfilePicture
@@ -141,7 +146,8 @@ func (u *doctorUsecaseImpl) GetAllDoctors(
 	sortBy string,
 	limit string,
 	specializationId string,
-	page string) (*dto.GetAllDoctorResponse, error) {
+	page string,
+	minRating string) (*dto.GetAllDoctorResponse, error) {
 
 	pageInt, err := strconv.Atoi(page)
 	if err != nil {
@@ -179,7 +185,7 @@ func (u *doctorUsecaseImpl) GetAllDoctors(
 
 	if sortBy != "" {
 		for _, sortByName := range sortByList {
-			if sortByName != "fee_per_patient" && sortByName != "experience" {
+			if sortByName != "fee_per_patient" && sortByName != "experience" && sortByName != "rating" && sortByName != "review_count" {
 				return nil, apperror.BadRequestError(errors.New("invalid sortBy Name"))
 			}
 		}
@@ -189,7 +195,15 @@ func (u *doctorUsecaseImpl) GetAllDoctors(
 		return nil, apperror.BadRequestError(errors.New("invalid length Sort or SortBy"))
 	}
 
-	doctors, pageInfo, err := u.doctorRepository.GetAllDoctor(c, sortList, sortByList, limitInt, offsetInt, specializationId)
+	minRatingFloat := 0.0
+	if minRating != "" {
+		minRatingFloat, err = strconv.ParseFloat(minRating, 64)
+		if err != nil || minRatingFloat < 0 || minRatingFloat > 5 {
+			return nil, apperror.BadRequestError(errors.New("invalid min_rating"))
+		}
+	}
+
+	doctors, pageInfo, err := u.doctorRepository.GetAllDoctor(c, sortList, sortByList, limitInt, offsetInt, specializationId, minRatingFloat)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
@@ -215,6 +229,8 @@ func (u *doctorUsecaseImpl) GetAllDoctors(
 			Experience:         doctor.Experience,
 			Name:               account.Name,
 			SpecializationName: *specialist,
+			Rating:             doctor.AverageRating,
+			ReviewCount:        doctor.ReviewCount,
 		}
 
 		getAllDoctor = append(getAllDoctor, doctorDto)
@@ -264,6 +280,10 @@ func (u *doctorUsecaseImpl) GetProfile(ctx context.Context, accountId int64) (*d
 	res.SpecializationId = doctor.SpecializationId
 	res.FeePerPatient = doctor.FeePerPatient
 	res.Experience = doctor.Experience
+	res.VerificationStatus = doctor.VerificationStatus
+	res.RejectionReason = doctor.RejectionReason
+	res.Rating = doctor.AverageRating
+	res.ReviewCount = doctor.ReviewCount
 
 	return res, nil
 }
@@ -287,6 +307,8 @@ func (u *doctorUsecaseImpl) GetProfileForPublic(ctx context.Context, doctorId in
 	res.SpecializationId = doctor.SpecializationId
 	res.FeePerPatient = doctor.FeePerPatient
 	res.Experience = doctor.Experience
+	res.Rating = doctor.AverageRating
+	res.ReviewCount = doctor.ReviewCount
 
 	return res, nil
 }
@@ -325,3 +347,111 @@ func (u *doctorUsecaseImpl) GetDoctorIsOnline(ctx context.Context, doctorAccount
 
 	return &dto.GetDoctorStatusResponse{IsOnline: *isOnline}, nil
 }
+
+func (u *doctorUsecaseImpl) GetAllDoctorVerifications(ctx context.Context, status, page, limit string) (*dto.DoctorVerificationListResponse, error) {
+	if status == "" {
+		status = appconstant.DoctorVerificationPending
+	}
+
+	if status != appconstant.DoctorVerificationPending && status != appconstant.DoctorVerificationApproved && status != appconstant.DoctorVerificationRejected {
+		return nil, apperror.InvalidDoctorVerificationStatusError()
+	}
+
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	doctorVerificationList, err := u.doctorRepository.GetAllDoctorVerificationByStatus(ctx, status, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.doctorRepository.GetAllDoctorVerificationByStatusTotalItem(ctx, status)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToDoctorVerificationListResponse(doctorVerificationList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *doctorUsecaseImpl) GetDoctorVerification(ctx context.Context, doctorId int64) (*dto.DoctorVerificationResponse, error) {
+	doctorVerification, err := u.doctorRepository.FindDoctorVerificationByDoctorId(ctx, doctorId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if doctorVerification == nil {
+		return nil, apperror.DoctorNotFoundError()
+	}
+
+	response := dto.ConvertToDoctorVerificationResponse(*doctorVerification)
+
+	return &response, nil
+}
+
+func (u *doctorUsecaseImpl) UpdateDoctorVerification(ctx context.Context, doctorId int64, request dto.UpdateDoctorVerificationRequest) error {
+	doctorVerification, err := u.doctorRepository.FindDoctorVerificationByDoctorId(ctx, doctorId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if doctorVerification == nil {
+		return apperror.DoctorNotFoundError()
+	}
+
+	if doctorVerification.Status == request.Status {
+		return apperror.DoctorVerificationUnchangedError()
+	}
+
+	var rejectionReason *string
+	emailType := appconstant.EmailTypeDoctorApproved
+
+	if request.Status == appconstant.DoctorVerificationRejected {
+		rejectionReason = &request.Reason
+		emailType = appconstant.EmailTypeDoctorRejected
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = tx.DoctorRepository().UpdateDoctorVerification(ctx, doctorId, request.Status, rejectionReason)
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUpdateDoctorVerification, appconstant.AuditEntityDoctor, doctorId,
+		map[string]any{"verification_status": doctorVerification.Status, "rejection_reason": doctorVerification.RejectionReason},
+		map[string]any{"verification_status": request.Status, "rejection_reason": rejectionReason})
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	err = enqueueEmail(ctx, tx.EmailOutboxRepository(), emailType, doctorVerification.Email, struct {
+		Name   string
+		Reason string
+	}{
+		Name:   doctorVerification.Name,
+		Reason: request.Reason,
+	})
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
@@ -136,20 +136,41 @@ func (u *drugUsecaseImpl) UpdateOneDrug(ctx context.Context, drugId int64, drugR
 			return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 		}
 
-		imageUrl, err := util.UploadToCloudinary(file, *filePath)
+		imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 		if err != nil {
 			return apperror.InternalServerError(err)
 		}
 
 		drug.Image = imageUrl
 		if strings.Contains(existingDrug.Image, "res.cloudinary.com") {
-			util.DeleteInCloudinary(existingDrug.Image)
+			deleteUploadedFile(ctx, existingDrug.Image)
 		}
 	} else {
 		drug.Image = existingDrug.Image
 	}
 
-	err = u.drugRepository.UpdateOneDrug(ctx, drug)
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	drugRepo := tx.DrugRepository()
+	auditLogRepo := tx.AuditLogRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+
+		tx.Commit()
+	}()
+
+	err = drugRepo.UpdateOneDrug(ctx, drug)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionUpdateDrug, appconstant.AuditEntityDrug, drug.Id, dto.ConvertToDrugAuditData(*existingDrug), dto.ConvertToDrugAuditData(drug))
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -222,14 +243,35 @@ func (u *drugUsecaseImpl) CreateOneDrug(ctx context.Context, createDrugRequest d
 		return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 	}
 
-	imageUrl, err := util.UploadToCloudinary(file, *filePath)
+	imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
 
 	drug.Image = imageUrl
 
-	if err = u.drugRepository.CreateOneDrug(ctx, drug); err != nil {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	drugRepo := tx.DrugRepository()
+	auditLogRepo := tx.AuditLogRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+
+		tx.Commit()
+	}()
+
+	drugId, err := drugRepo.CreateOneDrug(ctx, drug)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionCreateDrug, appconstant.AuditEntityDrug, drugId, nil, dto.ConvertToDrugAuditData(drug)); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
@@ -245,7 +287,27 @@ func (u *drugUsecaseImpl) DeleteOneDrug(ctx context.Context, drugId int64) error
 		return apperror.DrugNotFoundError()
 	}
 
-	if err = u.drugRepository.DeleteOneDrug(ctx, drugId); err != nil {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	drugRepo := tx.DrugRepository()
+	auditLogRepo := tx.AuditLogRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+
+		tx.Commit()
+	}()
+
+	if err = drugRepo.DeleteOneDrug(ctx, drugId); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionDeleteDrug, appconstant.AuditEntityDrug, drugId, dto.ConvertToDrugAuditData(*drug), nil); err != nil {
 		return apperror.InternalServerError(err)
 	}
 	return nil
@@ -344,6 +406,7 @@ func (u *drugUsecaseImpl) UpdateDrugsByPharmacyDrugId(ctx context.Context, pharm
 
 	pharmacyDrugRepo := tx.PharmacyDrugRepo()
 	stockChangeRepo := tx.StockChangeRepo()
+	auditLogRepo := tx.AuditLogRepository()
 	defer func() {
 		if err != nil {
 			tx.Rollback()
@@ -369,11 +432,48 @@ func (u *drugUsecaseImpl) UpdateDrugsByPharmacyDrugId(ctx context.Context, pharm
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
+
+	before := map[string]any{"stock": pharmacyDrug.Stock, "price": pharmacyDrug.Price}
+	after := map[string]any{"stock": stock, "price": price}
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionUpdatePharmacyDrug, appconstant.AuditEntityPharmacyDrug, pharmacyDrugId, before, after)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
 	return nil
 }
 
 func (u *drugUsecaseImpl) DeleteDrugsByPharmacyDrugId(ctx context.Context, pharmacyDrugId int64) error {
-	err := u.pharmacyDrugRepository.DeletePharmacyDrug(ctx, pharmacyDrugId)
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	pharmacyDrugRepo := tx.PharmacyDrugRepo()
+	auditLogRepo := tx.AuditLogRepository()
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+
+		tx.Commit()
+	}()
+
+	pharmacyDrug, err := pharmacyDrugRepo.GetPharmacyDrugByIdForUpdate(ctx, pharmacyDrugId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if pharmacyDrug == nil {
+		err = apperror.DrugNotFoundError()
+		return err
+	}
+
+	err = pharmacyDrugRepo.DeletePharmacyDrug(ctx, pharmacyDrugId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	before := map[string]any{"pharmacy_id": pharmacyDrug.PharmacyId, "drug_id": pharmacyDrug.DrugId, "stock": pharmacyDrug.Stock, "price": pharmacyDrug.Price}
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionDeletePharmacyDrug, appconstant.AuditEntityPharmacyDrug, pharmacyDrugId, before, nil)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -406,7 +506,28 @@ func (u *drugUsecaseImpl) AddDrugsByPharmacyDrugId(ctx context.Context, pharmacy
 		return apperror.BadRequestError(errors.New("drug id doesn't exist"))
 	}
 
-	err = u.pharmacyDrugRepository.AddPharmacyDrug(ctx, pharmacyId, drugId, stock, price)
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	pharmacyDrugRepo := tx.PharmacyDrugRepo()
+	auditLogRepo := tx.AuditLogRepository()
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+
+		tx.Commit()
+	}()
+
+	pharmacyDrugId, err := pharmacyDrugRepo.AddPharmacyDrug(ctx, pharmacyId, drugId, stock, price)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	after := map[string]any{"pharmacy_id": pharmacyId, "drug_id": drugId, "stock": stock, "price": price}
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionCreatePharmacyDrug, appconstant.AuditEntityPharmacyDrug, pharmacyDrugId, nil, after)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -442,6 +563,8 @@ func (u *drugUsecaseImpl) PostStockMutation(ctx context.Context, req dto.PostSto
 	pharmacyDrugRepo := tx.PharmacyDrugRepo()
 	stockMutationRepo := tx.StockMutationRepo()
 	stockChangeRepo := tx.StockChangeRepo()
+	auditLogRepo := tx.AuditLogRepository()
+	notificationRepo := tx.NotificationRepository()
 	defer func() {
 		if err != nil {
 			tx.Rollback()
@@ -488,6 +611,11 @@ func (u *drugUsecaseImpl) PostStockMutation(ctx context.Context, req dto.PostSto
 		return apperror.InternalServerError(err)
 	}
 
+	err = notifyStockMutationsRequested(ctx, notificationRepo, []entity.PossibleStockMutation{stockMutation})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
 	recipientStockChange := entity.StockChange{PharmacyDrugId: req.RecipientPharmacyDrugId, FinalStock: recipientDrug.Stock + req.Quantity,
 		Amount: req.Quantity}
 	senderStockChange := entity.StockChange{PharmacyDrugId: req.SenderPharmacyDrugId, FinalStock: senderDrug.Stock - req.Quantity,
@@ -496,5 +624,16 @@ func (u *drugUsecaseImpl) PostStockMutation(ctx context.Context, req dto.PostSto
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
+
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionMutatePharmacyDrug, appconstant.AuditEntityPharmacyDrug, req.RecipientPharmacyDrugId,
+		map[string]any{"stock": recipientDrug.Stock}, map[string]any{"stock": recipientDrug.Stock + req.Quantity, "sender_pharmacy_drug_id": req.SenderPharmacyDrugId})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionMutatePharmacyDrug, appconstant.AuditEntityPharmacyDrug, req.SenderPharmacyDrugId,
+		map[string]any{"stock": senderDrug.Stock}, map[string]any{"stock": senderDrug.Stock - req.Quantity, "recipient_pharmacy_drug_id": req.RecipientPharmacyDrugId})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
 	return nil
 }
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+
+	"github.com/sirupsen/logrus"
+)
+
+type EmailOutboxUsecase interface {
+	SendDueEmails(ctx context.Context) (*dto.EmailOutboxSendResult, error)
+	GetDeadLetters(ctx context.Context, page, limit string) (*dto.EmailOutboxListResponse, error)
+	RequeueDeadLetter(ctx context.Context, emailOutboxId int64) error
+}
+
+type emailOutboxUsecaseImpl struct {
+	emailOutboxRepository repository.EmailOutboxRepository
+	emailSender           util.EmailSender
+	emailTemplateRenderer util.EmailTemplateRenderer
+	emailFrom             string
+}
+
+func NewEmailOutboxUsecaseImpl(emailOutboxRepository repository.EmailOutboxRepository, emailSender util.EmailSender, emailTemplateRenderer util.EmailTemplateRenderer, emailFrom string) emailOutboxUsecaseImpl {
+	return emailOutboxUsecaseImpl{
+		emailOutboxRepository: emailOutboxRepository,
+		emailSender:           emailSender,
+		emailTemplateRenderer: emailTemplateRenderer,
+		emailFrom:             emailFrom,
+	}
+}
+
+func (u *emailOutboxUsecaseImpl) SendDueEmails(ctx context.Context) (*dto.EmailOutboxSendResult, error) {
+	emails, err := u.emailOutboxRepository.ClaimDue(ctx, appconstant.EmailOutboxLeaseSeconds, appconstant.EmailOutboxBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dto.EmailOutboxSendResult{
+		Claimed: len(emails),
+	}
+
+	for _, email := range emails {
+		sendErr := u.sendEmail(ctx, email)
+		if sendErr == nil {
+			if err := u.emailOutboxRepository.MarkSent(ctx, email.Id); err != nil {
+				return &result, err
+			}
+
+			result.Sent++
+			continue
+		}
+
+		lastError := sendErr.Error()
+		if len(lastError) > appconstant.EmailOutboxLastErrorMaxLength {
+			lastError = lastError[:appconstant.EmailOutboxLastErrorMaxLength]
+		}
+
+		status, err := u.emailOutboxRepository.MarkFailed(ctx, email.Id, lastError, appconstant.EmailOutboxMaxAttempts, appconstant.EmailOutboxBaseBackoffSeconds, appconstant.EmailOutboxMaxBackoffSeconds, appconstant.EmailSecretTypes)
+		if err != nil {
+			return &result, err
+		}
+
+		logger := util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"email_outbox_id": email.Id,
+			"email_type":      email.EmailType,
+			"attempts":        email.Attempts,
+			"error":           sendErr.Error(),
+		})
+
+		if status == appconstant.EmailOutboxStatusDead {
+			logger.Error("email dead-lettered")
+			result.DeadLettered++
+		} else {
+			logger.Warn("email send failed, will retry")
+			result.Retrying++
+		}
+	}
+
+	return &result, nil
+}
+
+func (u *emailOutboxUsecaseImpl) sendEmail(ctx context.Context, email entity.EmailOutbox) error {
+	var data map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(email.TemplateData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return err
+	}
+
+	message, err := u.emailTemplateRenderer.Render(email.EmailType, email.Locale, data)
+	if err != nil {
+		return err
+	}
+
+	message.From = u.emailFrom
+	message.To = []string{email.Recipient}
+
+	sendCtx, cancel := context.WithTimeout(ctx, appconstant.EmailOutboxSendTimeout)
+	defer cancel()
+
+	return u.emailSender.Send(sendCtx, *message)
+}
+
+func (u *emailOutboxUsecaseImpl) GetDeadLetters(ctx context.Context, page, limit string) (*dto.EmailOutboxListResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	emails, err := u.emailOutboxRepository.FindAllByStatus(ctx, appconstant.EmailOutboxStatusDead, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.emailOutboxRepository.FindAllByStatusTotalItem(ctx, appconstant.EmailOutboxStatusDead)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToEmailOutboxListResponse(emails, pageInfo)
+
+	return &response, nil
+}
+
+func (u *emailOutboxUsecaseImpl) RequeueDeadLetter(ctx context.Context, emailOutboxId int64) error {
+	requeued, err := u.emailOutboxRepository.RequeueDead(ctx, emailOutboxId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if !requeued {
+		return apperror.EmailOutboxNotFoundError()
+	}
+
+	return nil
+}
+
+func enqueueEmail(ctx context.Context, emailOutboxRepository repository.EmailOutboxRepository, emailType, recipient string, data any) error {
+	templateData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return emailOutboxRepository.CreateOne(ctx, &entity.EmailOutbox{
+		EmailType:    emailType,
+		Locale:       util.LocaleFromContext(ctx),
+		Recipient:    recipient,
+		TemplateData: templateData,
+	})
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/repository"
+)
+
+type MaintenanceUsecase interface {
+	GetStatus(ctx context.Context) *dto.MaintenanceStatusResponse
+	SetMaintenanceMode(ctx context.Context, isUnderMaintenance bool, message string) (*dto.MaintenanceStatusResponse, error)
+	IsUnderMaintenance() (bool, string)
+	PurgeExpiredData(ctx context.Context) (*dto.PurgeExpiredDataResponse, error)
+	GetDbStats() sql.DBStats
+}
+
+type maintenanceMode struct {
+	mu                 sync.RWMutex
+	isUnderMaintenance bool
+	message            string
+	since              *time.Time
+}
+
+type maintenanceUsecaseImpl struct {
+	transaction           repository.Transaction
+	maintenanceRepository repository.MaintenanceRepository
+	mode                  *maintenanceMode
+}
+
+func NewMaintenanceUsecaseImpl(transaction repository.Transaction, maintenanceRepository repository.MaintenanceRepository) maintenanceUsecaseImpl {
+	return maintenanceUsecaseImpl{
+		transaction:           transaction,
+		maintenanceRepository: maintenanceRepository,
+		mode:                  &maintenanceMode{},
+	}
+}
+
+func (u *maintenanceUsecaseImpl) GetStatus(ctx context.Context) *dto.MaintenanceStatusResponse {
+	isUnderMaintenance, message := u.IsUnderMaintenance()
+	stats := u.maintenanceRepository.Stats()
+
+	u.mode.mu.RLock()
+	since := u.mode.since
+	u.mode.mu.RUnlock()
+
+	return &dto.MaintenanceStatusResponse{
+		IsUnderMaintenance: isUnderMaintenance,
+		Message:            message,
+		Since:              since,
+		IsDatabaseUp:       u.maintenanceRepository.Ping(ctx) == nil,
+		OpenConnections:    stats.OpenConnections,
+		InUseConnections:   stats.InUse,
+	}
+}
+
+func (u *maintenanceUsecaseImpl) SetMaintenanceMode(ctx context.Context, isUnderMaintenance bool, message string) (*dto.MaintenanceStatusResponse, error) {
+	if !isUnderMaintenance {
+		message = ""
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	u.mode.mu.Lock()
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUpdateMaintenanceMode, appconstant.AuditEntityMaintenanceMode, 0,
+		map[string]any{"is_under_maintenance": u.mode.isUnderMaintenance, "message": u.mode.message},
+		map[string]any{"is_under_maintenance": isUnderMaintenance, "message": message})
+	if err != nil {
+		u.mode.mu.Unlock()
+		tx.Rollback()
+		return nil, apperror.InternalServerError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		u.mode.mu.Unlock()
+		return nil, apperror.InternalServerError(err)
+	}
+
+	if isUnderMaintenance && !u.mode.isUnderMaintenance {
+		now := time.Now()
+		u.mode.since = &now
+	}
+	if !isUnderMaintenance {
+		u.mode.since = nil
+	}
+	u.mode.isUnderMaintenance = isUnderMaintenance
+	u.mode.message = message
+	u.mode.mu.Unlock()
+
+	return u.GetStatus(ctx), nil
+}
+
+func (u *maintenanceUsecaseImpl) IsUnderMaintenance() (bool, string) {
+	u.mode.mu.RLock()
+	defer u.mode.mu.RUnlock()
+
+	return u.mode.isUnderMaintenance, u.mode.message
+}
+
+func (u *maintenanceUsecaseImpl) PurgeExpiredData(ctx context.Context) (*dto.PurgeExpiredDataResponse, error) {
+	purged, err := u.maintenanceRepository.PurgeExpired(ctx, appconstant.MaintenancePurgeRetentionHours)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &dto.PurgeExpiredDataResponse{
+		RetentionHours: appconstant.MaintenancePurgeRetentionHours,
+		Purged:         purged,
+	}, nil
+}
+
+func (u *maintenanceUsecaseImpl) GetDbStats() sql.DBStats {
+	return u.maintenanceRepository.Stats()
+}
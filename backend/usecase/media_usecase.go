@@ -8,6 +8,8 @@ import (
 	"max-health/util"
 	"mime/multipart"
 	"net/http"
+
+	"github.com/sirupsen/logrus"
 )
 
 type MediaUsecase interface {
@@ -26,7 +28,7 @@ func (u *mediaUsecaseImpl) UploadMedia(ctx context.Context, file multipart.File,
 		return nil, apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 	}
 
-	url, err := util.UploadToCloudinary(file, *filePath)
+	url, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
@@ -36,3 +38,12 @@ func (u *mediaUsecaseImpl) UploadMedia(ctx context.Context, file multipart.File,
 		Format: format,
 	}, nil
 }
+
+func deleteUploadedFile(ctx context.Context, fileUrl string) {
+	if err := util.DeleteInCloudinary(ctx, fileUrl); err != nil {
+		util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"error":    err.Error(),
+			"file_url": fileUrl,
+		}).Warn("failed to delete uploaded file")
+	}
+}
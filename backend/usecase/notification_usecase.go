@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+
+	"github.com/sirupsen/logrus"
+)
+
+type NotificationUsecase interface {
+	GetNotifications(ctx context.Context, accountId int64, unreadOnly bool, page, limit string) (*dto.NotificationListResponse, error)
+	MarkNotificationsRead(ctx context.Context, accountId int64, request dto.MarkNotificationsReadRequest) (*dto.MarkNotificationsReadResponse, error)
+	GenerateToken(ctx context.Context, accountId int64) (entity.WsToken, error)
+	PushPendingNotifications(ctx context.Context) (*dto.NotificationPushResult, error)
+}
+
+type notificationUsecaseImpl struct {
+	notificationRepository repository.NotificationRepository
+	centrifugoPublisher    util.CentrifugoPublisher
+	jwtHelper              util.JwtAuthentication
+}
+
+func NewNotificationUsecaseImpl(notificationRepository repository.NotificationRepository, centrifugoPublisher util.CentrifugoPublisher, jwtHelper util.JwtAuthentication) notificationUsecaseImpl {
+	return notificationUsecaseImpl{
+		notificationRepository: notificationRepository,
+		centrifugoPublisher:    centrifugoPublisher,
+		jwtHelper:              jwtHelper,
+	}
+}
+
+func (u *notificationUsecaseImpl) GetNotifications(ctx context.Context, accountId int64, unreadOnly bool, page, limit string) (*dto.NotificationListResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	notifications, err := u.notificationRepository.FindAllByAccountId(ctx, accountId, unreadOnly, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	count, err := u.notificationRepository.CountByAccountId(ctx, accountId, unreadOnly)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(count.TotalItem) / float64(limitInt))),
+		ItemCount: count.TotalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToNotificationListResponse(notifications, pageInfo, count.UnreadCount)
+
+	return &response, nil
+}
+
+func (u *notificationUsecaseImpl) MarkNotificationsRead(ctx context.Context, accountId int64, request dto.MarkNotificationsReadRequest) (*dto.MarkNotificationsReadResponse, error) {
+	var updatedCount int64
+	var err error
+
+	if request.All {
+		updatedCount, err = u.notificationRepository.MarkAllRead(ctx, accountId)
+	} else {
+		updatedCount, err = u.notificationRepository.MarkReadByIds(ctx, accountId, request.NotificationIds)
+	}
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	count, err := u.notificationRepository.CountByAccountId(ctx, accountId, true)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &dto.MarkNotificationsReadResponse{
+		UpdatedCount: updatedCount,
+		UnreadCount:  count.UnreadCount,
+	}, nil
+}
+
+func (u *notificationUsecaseImpl) GenerateToken(ctx context.Context, accountId int64) (entity.WsToken, error) {
+	var wsToken entity.WsToken
+
+	channel := util.NotificationChannel(accountId)
+	tokenExpiredAt := time.Now().Add(appconstant.NotificationTokenDuration * time.Minute).UnixMilli()
+
+	clientToken, err := u.jwtHelper.CentrifugoClientCreateAndSign(util.CentrifugoClientClaims{
+		AccountId: accountId,
+		ExpiredAt: tokenExpiredAt,
+	})
+	if err != nil {
+		return wsToken, apperror.InternalServerError(err)
+	}
+
+	channelToken, err := u.jwtHelper.CentrifugoChannelCreateAndSign(util.CentrifugoChannelClaims{
+		AccountId: accountId,
+		Channel:   channel,
+		ExpiredAt: tokenExpiredAt,
+	})
+	if err != nil {
+		return wsToken, apperror.InternalServerError(err)
+	}
+
+	wsToken.Channel = channel
+	wsToken.Token.ClientToken = *clientToken
+	wsToken.Token.ChannelToken = *channelToken
+
+	return wsToken, nil
+}
+
+func (u *notificationUsecaseImpl) PushPendingNotifications(ctx context.Context) (*dto.NotificationPushResult, error) {
+	notifications, err := u.notificationRepository.ClaimUnpushed(ctx, appconstant.NotificationPushMaxAgeSeconds, appconstant.NotificationPushBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dto.NotificationPushResult{
+		Claimed: len(notifications),
+	}
+
+	for _, notification := range notifications {
+		data, err := json.Marshal(dto.ConvertToNotificationResponse(notification))
+		if err != nil {
+			return &result, err
+		}
+
+		if err := u.centrifugoPublisher.Publish(ctx, util.NotificationChannel(notification.AccountId), data); err != nil {
+			util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+				"notification_id": notification.Id,
+				"error":           err.Error(),
+			}).Warn("notification push failed")
+			result.Failed++
+			continue
+		}
+
+		result.Pushed++
+	}
+
+	return &result, nil
+}
+
+func newNotification(notificationType, title, message string, data map[string]any) (entity.Notification, error) {
+	notificationData, err := json.Marshal(data)
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	return entity.Notification{
+		Type:    notificationType,
+		Title:   title,
+		Message: message,
+		Data:    notificationData,
+	}, nil
+}
+
+func newOrderStatusChangedNotification(orderPharmacy entity.OrderPharmacy, statusId int64) (entity.Notification, error) {
+	return newNotification(appconstant.NotificationTypeOrderStatusChanged, appconstant.NotificationTitleOrderStatusChanged,
+		fmt.Sprintf(appconstant.NotificationMessageOrderStatusChanged, orderPharmacy.OrderId, appconstant.OrderStatusNames[statusId]),
+		map[string]any{"order_id": orderPharmacy.OrderId, "order_pharmacy_id": orderPharmacy.Id, "order_status_id": statusId})
+}
+
+func notifyStockMutationsRequested(ctx context.Context, notificationRepository repository.NotificationRepository, stockMutations []entity.PossibleStockMutation) error {
+	for _, stockMutation := range stockMutations {
+		notification, err := newNotification(appconstant.NotificationTypeStockMutationRequested, appconstant.NotificationTitleStockMutationRequested,
+			fmt.Sprintf(appconstant.NotificationMessageStockMutationRequested, stockMutation.AlternativeStock),
+			map[string]any{"drug_id": stockMutation.DrugId, "pharmacy_requester_id": stockMutation.OriginalPharmacy,
+				"pharmacy_target_id": stockMutation.AlternativePharmacy, "quantity": stockMutation.AlternativeStock})
+		if err != nil {
+			return err
+		}
+
+		if err := notificationRepository.CreateForPharmacyManager(ctx, stockMutation.AlternativePharmacy, notification); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func notifyPaymentConfirmation(ctx context.Context, notificationRepository repository.NotificationRepository, orderId, statusId int64) error {
+	data := map[string]any{"order_id": orderId, "order_status_id": statusId}
+
+	if statusId != appconstant.OrderStatusProcessed {
+		notification, err := newNotification(appconstant.NotificationTypePaymentRejected, appconstant.NotificationTitlePaymentRejected,
+			fmt.Sprintf(appconstant.NotificationMessagePaymentRejected, orderId), data)
+		if err != nil {
+			return err
+		}
+
+		return notificationRepository.CreateForOrderUser(ctx, orderId, notification)
+	}
+
+	notification, err := newNotification(appconstant.NotificationTypePaymentConfirmed, appconstant.NotificationTitlePaymentConfirmed,
+		fmt.Sprintf(appconstant.NotificationMessagePaymentConfirmed, orderId), data)
+	if err != nil {
+		return err
+	}
+
+	if err := notificationRepository.CreateForOrderUser(ctx, orderId, notification); err != nil {
+		return err
+	}
+
+	notification, err = newNotification(appconstant.NotificationTypeNewOrder, appconstant.NotificationTitleNewOrder,
+		fmt.Sprintf(appconstant.NotificationMessageNewOrder, orderId), data)
+	if err != nil {
+		return err
+	}
+
+	return notificationRepository.CreateForOrderManagers(ctx, orderId, notification)
+}
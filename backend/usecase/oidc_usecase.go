@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/util"
+
+	"github.com/google/uuid"
+)
+
+func (u *authenticationUsecaseImpl) GetOidcProviders() []string {
+	return u.oidcClient.Providers()
+}
+
+func (u *authenticationUsecaseImpl) StartOidcLogin(ctx context.Context, provider string) (string, error) {
+	loginState, err := u.oidcClient.NewLoginState(provider)
+	if err != nil {
+		return "", oidcError(err)
+	}
+
+	return u.createOidcAuthorizationUrl(ctx, *loginState)
+}
+
+func (u *authenticationUsecaseImpl) StartOidcLink(ctx context.Context, accountId int64, provider string, password string, code string) (string, error) {
+	account, err := u.accountRepository.FindOneById(ctx, accountId)
+	if err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return "", apperror.AccountNotFoundError()
+	}
+	account.Id = accountId
+
+	lockedFor, err := u.accountRepository.FindLockRemainingSecondsById(ctx, accountId)
+	if err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+	if lockedFor > 0 {
+		return "", apperror.AccountLockedError(lockedFor)
+	}
+
+	isPassword, err := u.hashHelper.CheckPassword(password, []byte(account.Password))
+	if !isPassword {
+		return "", u.recordFailedLogin(ctx, *account, apperror.WrongPasswordError(err))
+	}
+
+	isValid, err := u.verifySecondFactor(ctx, accountId, code, false)
+	if err != nil {
+		return "", err
+	}
+	if !isValid {
+		return "", apperror.InvalidTwoFactorCodeError()
+	}
+
+	loginState, err := u.oidcClient.NewLoginState(provider)
+	if err != nil {
+		return "", oidcError(err)
+	}
+	loginState.LinkAccountId = &accountId
+
+	return u.createOidcAuthorizationUrl(ctx, *loginState)
+}
+
+func (u *authenticationUsecaseImpl) createOidcAuthorizationUrl(ctx context.Context, loginState entity.OidcLoginState) (string, error) {
+	authorizationUrl, err := u.oidcClient.AuthorizationUrl(ctx, loginState)
+	if err != nil {
+		return "", oidcError(err)
+	}
+
+	err = u.oidcRepository.CreateLoginState(ctx, loginState, appconstant.OidcLoginStateDuration)
+	if err != nil {
+		return "", apperror.InternalServerError(err)
+	}
+
+	return authorizationUrl, nil
+}
+
+func (u *authenticationUsecaseImpl) CompleteOidcLogin(ctx context.Context, provider string, code string, state string, session entity.AccountSession) (*entity.LoginResult, error) {
+	loginState, err := u.oidcRepository.ConsumeLoginState(ctx, provider, state)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if loginState == nil {
+		return nil, apperror.InvalidOidcLoginError(errors.New("unknown or expired oidc state"))
+	}
+
+	identity, err := u.oidcClient.Authenticate(ctx, *loginState, code)
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	var account *entity.Account
+	if loginState.LinkAccountId != nil {
+		account, err = u.linkOidcAccount(ctx, *loginState.LinkAccountId, *identity)
+	} else {
+		account, err = u.findOrCreateOidcAccount(ctx, *identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lockedFor, err := u.accountRepository.FindLockRemainingSecondsById(ctx, account.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if lockedFor > 0 {
+		return nil, apperror.AccountLockedError(lockedFor)
+	}
+
+	return u.completeLogin(ctx, *account, session)
+}
+
+func (u *authenticationUsecaseImpl) linkOidcAccount(ctx context.Context, accountId int64, identity entity.OidcIdentity) (*entity.Account, error) {
+	account, err := u.oidcRepository.FindAccountByIdentity(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account != nil && account.Id != accountId {
+		return nil, apperror.OidcIdentityLinkedError()
+	}
+
+	if account != nil {
+		err = u.oidcRepository.UpdateIdentityLastLogin(ctx, identity)
+	} else {
+		err = u.oidcRepository.CreateIdentity(ctx, accountId, identity)
+	}
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	account, err = u.oidcRepository.FindAccountByIdentity(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return nil, apperror.AccountNotFoundError()
+	}
+
+	return account, nil
+}
+
+func (u *authenticationUsecaseImpl) findOrCreateOidcAccount(ctx context.Context, identity entity.OidcIdentity) (account *entity.Account, err error) {
+	account, err = u.oidcRepository.FindAccountByIdentity(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account != nil {
+		err = u.oidcRepository.UpdateIdentityLastLogin(ctx, identity)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
+		return account, nil
+	}
+
+	if identity.Email == "" || !identity.IsEmailVerified {
+		return nil, apperror.OidcEmailNotVerifiedError()
+	}
+
+	account, err = u.accountRepository.FindAccountByEmail(ctx, identity.Email)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	if account != nil {
+		if account.RoleId != appconstant.UserId {
+			return nil, apperror.OidcAccountLinkRequiredError()
+		}
+		if account.VerifiedAt == nil {
+			return nil, apperror.AccountNotVerifiedError()
+		}
+
+		err = u.oidcRepository.CreateIdentity(ctx, account.Id, identity)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
+		return account, nil
+	}
+
+	password, err := u.hashHelper.HashPassword(uuid.NewString())
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	account = &entity.Account{
+		Email:    identity.Email,
+		Password: password,
+		RoleId:   appconstant.UserId,
+		RoleName: appconstant.UserRoleName,
+		Name:     oidcAccountName(identity),
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	accountRepo := tx.AccountRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	accountId, err := accountRepo.PostOneAccount(ctx, *account)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	account.Id = int64(*accountId)
+
+	err = tx.UserRepository().PostOneUser(ctx, *accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	err = accountRepo.VerifyOne(ctx, account.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	err = tx.OidcRepository().CreateIdentity(ctx, account.Id, identity)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return account, nil
+}
+
+func oidcAccountName(identity entity.OidcIdentity) string {
+	nonLetters := regexp.MustCompile(`[^a-zA-Z]+`)
+
+	for _, candidate := range []string{identity.Name, strings.Split(identity.Email, "@")[0]} {
+		name := strings.TrimSpace(nonLetters.ReplaceAllString(candidate, " "))
+		if util.RegexValidate(name, appconstant.NameRegexPattern) {
+			return name
+		}
+	}
+
+	return appconstant.OidcDefaultAccountName
+}
+
+func oidcError(err error) error {
+	if errors.Is(err, util.ErrOidcProviderNotFound) {
+		return apperror.OidcProviderNotFoundError()
+	}
+
+	return apperror.InvalidOidcLoginError(err)
+}
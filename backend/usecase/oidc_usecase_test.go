@@ -0,0 +1,504 @@
+package usecase_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/config"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/usecase"
+	"max-health/util"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	oidcTestProviderName = "test"
+	oidcTestClientId     = "max-health"
+	oidcTestCode         = "test-code"
+	oidcTestKeyId        = "test-key"
+)
+
+type oidcTestStore struct {
+	accounts      map[int64]*entity.Account
+	identities    map[string]int64
+	loginStates   map[string]entity.OidcLoginState
+	nextAccountId int64
+}
+
+func newOidcTestStore() *oidcTestStore {
+	return &oidcTestStore{
+		accounts:      map[int64]*entity.Account{},
+		identities:    map[string]int64{},
+		loginStates:   map[string]entity.OidcLoginState{},
+		nextAccountId: 1,
+	}
+}
+
+func (s *oidcTestStore) addAccount(email string, roleId int64, roleName string, isVerified bool) int64 {
+	account := &entity.Account{
+		Id:       s.nextAccountId,
+		Email:    email,
+		RoleId:   roleId,
+		RoleName: roleName,
+		Name:     "Existing Account",
+	}
+	if isVerified {
+		verifiedAt := time.Now()
+		account.VerifiedAt = &verifiedAt
+	}
+
+	s.accounts[account.Id] = account
+	s.nextAccountId++
+
+	return account.Id
+}
+
+func (s *oidcTestStore) identityKey(provider string, subject string) string {
+	return provider + "|" + subject
+}
+
+type fakeAccountRepository struct {
+	repository.AccountRepository
+	store *oidcTestStore
+}
+
+func (r *fakeAccountRepository) FindAccountByEmail(ctx context.Context, email string) (*entity.Account, error) {
+	for _, account := range r.store.accounts {
+		if strings.EqualFold(account.Email, email) {
+			found := *account
+			return &found, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *fakeAccountRepository) FindLockRemainingSecondsById(ctx context.Context, accountId int64) (int, error) {
+	return 0, nil
+}
+
+func (r *fakeAccountRepository) PostOneAccount(ctx context.Context, account entity.Account) (*int, error) {
+	account.Id = r.store.nextAccountId
+	account.VerifiedAt = nil
+	r.store.accounts[account.Id] = &account
+	r.store.nextAccountId++
+
+	accountId := int(account.Id)
+	return &accountId, nil
+}
+
+func (r *fakeAccountRepository) VerifyOne(ctx context.Context, accountId int64) error {
+	account, ok := r.store.accounts[accountId]
+	if !ok {
+		return errors.New("account not found")
+	}
+
+	verifiedAt := time.Now()
+	account.VerifiedAt = &verifiedAt
+
+	return nil
+}
+
+func (r *fakeAccountRepository) ResetFailedLogins(ctx context.Context, accountId int64) error {
+	return nil
+}
+
+type fakeOidcRepository struct {
+	store *oidcTestStore
+}
+
+func (r *fakeOidcRepository) CreateLoginState(ctx context.Context, loginState entity.OidcLoginState, durationMinutes int) error {
+	r.store.loginStates[loginState.State] = loginState
+	return nil
+}
+
+func (r *fakeOidcRepository) ConsumeLoginState(ctx context.Context, provider string, state string) (*entity.OidcLoginState, error) {
+	loginState, ok := r.store.loginStates[state]
+	if !ok || loginState.Provider != provider {
+		return nil, nil
+	}
+	delete(r.store.loginStates, state)
+
+	return &loginState, nil
+}
+
+func (r *fakeOidcRepository) FindAccountByIdentity(ctx context.Context, provider string, subject string) (*entity.Account, error) {
+	accountId, ok := r.store.identities[r.store.identityKey(provider, subject)]
+	if !ok {
+		return nil, nil
+	}
+
+	account := *r.store.accounts[accountId]
+	return &account, nil
+}
+
+func (r *fakeOidcRepository) CreateIdentity(ctx context.Context, accountId int64, identity entity.OidcIdentity) error {
+	key := r.store.identityKey(identity.Provider, identity.Subject)
+	if _, ok := r.store.identities[key]; ok {
+		return errors.New("duplicate identity")
+	}
+	r.store.identities[key] = accountId
+
+	return nil
+}
+
+func (r *fakeOidcRepository) UpdateIdentityLastLogin(ctx context.Context, identity entity.OidcIdentity) error {
+	return nil
+}
+
+type fakeUserRepository struct {
+	repository.UserRepository
+}
+
+func (r *fakeUserRepository) PostOneUser(ctx context.Context, accountId int) error {
+	return nil
+}
+
+type fakeTwoFactorRepository struct {
+	repository.TwoFactorRepository
+}
+
+func (r *fakeTwoFactorRepository) FindByAccountId(ctx context.Context, accountId int64) (*entity.TwoFactor, error) {
+	return nil, nil
+}
+
+type fakeAccountSessionRepository struct {
+	repository.AccountSessionRepository
+}
+
+func (r *fakeAccountSessionRepository) CreateSession(ctx context.Context, session entity.AccountSession) error {
+	return nil
+}
+
+type fakeRefreshTokenRepository struct {
+	repository.RefreshTokenRepository
+}
+
+func (r *fakeRefreshTokenRepository) PostOneCode(ctx context.Context, accountId int64, sessionId string, code string) error {
+	return nil
+}
+
+type fakeTransaction struct {
+	repository.Transaction
+	store *oidcTestStore
+}
+
+func (t *fakeTransaction) BeginTx() (repository.Transaction, error) {
+	return t, nil
+}
+
+func (t *fakeTransaction) Commit() error {
+	return nil
+}
+
+func (t *fakeTransaction) Rollback() error {
+	return nil
+}
+
+func (t *fakeTransaction) AccountRepository() repository.AccountRepository {
+	return &fakeAccountRepository{store: t.store}
+}
+
+func (t *fakeTransaction) UserRepository() repository.UserRepository {
+	return &fakeUserRepository{}
+}
+
+func (t *fakeTransaction) OidcRepository() repository.OidcRepository {
+	return &fakeOidcRepository{store: t.store}
+}
+
+func (t *fakeTransaction) AccountSessionRepository() repository.AccountSessionRepository {
+	return &fakeAccountSessionRepository{}
+}
+
+func (t *fakeTransaction) RefreshTokenRepository() repository.RefreshTokenRepository {
+	return &fakeRefreshTokenRepository{}
+}
+
+type oidcTestProvider struct {
+	server          *httptest.Server
+	key             *rsa.PrivateKey
+	nonce           string
+	subject         string
+	email           string
+	isEmailVerified bool
+}
+
+func newOidcTestProvider(t *testing.T) *oidcTestProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := &oidcTestProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(appconstant.OidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeOidcTestJson(w, map[string]any{
+			"issuer":                 provider.server.URL,
+			"authorization_endpoint": provider.server.URL + "/authorize",
+			"token_endpoint":         provider.server.URL + "/token",
+			"jwks_uri":               provider.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeOidcTestJson(w, map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": oidcTestKeyId,
+				"use": appconstant.JwtKeyUse,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("code") != oidcTestCode || r.FormValue("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			writeOidcTestJson(w, map[string]any{"error": "invalid_grant"})
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":            provider.server.URL,
+			"aud":            oidcTestClientId,
+			"sub":            provider.subject,
+			"nonce":          provider.nonce,
+			"email":          provider.email,
+			"email_verified": provider.isEmailVerified,
+			"name":           "Oidc Tester",
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(time.Minute).Unix(),
+		})
+		token.Header[appconstant.JwtKeyIdHeader] = oidcTestKeyId
+
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		writeOidcTestJson(w, map[string]any{"id_token": idToken})
+	})
+
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+
+	return provider
+}
+
+func writeOidcTestJson(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+type oidcTestEnv struct {
+	store    *oidcTestStore
+	provider *oidcTestProvider
+	usecase  usecase.AuthenticationUsecase
+}
+
+func newOidcTestEnv(t *testing.T) *oidcTestEnv {
+	t.Setenv("HASH_COST", "4")
+
+	provider := newOidcTestProvider(t)
+	store := newOidcTestStore()
+
+	cfg := &config.Config{
+		Issuer: "max-health-test",
+		OidcProviders: []config.OidcProviderConfig{{
+			Name:        oidcTestProviderName,
+			Issuer:      provider.server.URL,
+			ClientId:    oidcTestClientId,
+			RedirectUrl: "http://localhost/oidc/callback",
+			Scopes:      []string{"openid", "email"},
+		}},
+	}
+
+	_, signingKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keySet, err := util.NewJwtKeySet("test", util.JwtKey{
+		Id:         "test",
+		Method:     jwt.SigningMethodEdDSA,
+		PrivateKey: signingKey,
+		PublicKey:  signingKey.Public(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	oidcClient := util.NewOidcClientImpl(cfg)
+	authenticationUsecase := usecase.NewAuthenticationUsecaseImpl(usecase.AuthenticationUsecaseImplOpts{
+		AccountRepository:        &fakeAccountRepository{store: store},
+		AccountSessionRepository: &fakeAccountSessionRepository{},
+		TwoFactorRepository:      &fakeTwoFactorRepository{},
+		OidcRepository:           &fakeOidcRepository{store: store},
+		Transaction:              &fakeTransaction{store: store},
+		HashHelper:               &util.HashHelperImpl{},
+		JwtHelper:                util.JwtAuthentication{Config: *cfg, KeySet: keySet},
+		OidcClient:               &oidcClient,
+	})
+
+	return &oidcTestEnv{
+		store:    store,
+		provider: provider,
+		usecase:  &authenticationUsecase,
+	}
+}
+
+func (e *oidcTestEnv) callback(t *testing.T, subject string, email string, linkAccountId *int64) (*entity.LoginResult, error) {
+	ctx := context.Background()
+
+	authorizationUrl, err := e.usecase.StartOidcLogin(ctx, oidcTestProviderName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedUrl, err := url.Parse(authorizationUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := parsedUrl.Query().Get("state")
+
+	if linkAccountId != nil {
+		loginState := e.store.loginStates[state]
+		loginState.LinkAccountId = linkAccountId
+		e.store.loginStates[state] = loginState
+	}
+
+	e.provider.nonce = parsedUrl.Query().Get("nonce")
+	e.provider.subject = subject
+	e.provider.email = email
+	e.provider.isEmailVerified = true
+
+	return e.usecase.CompleteOidcLogin(ctx, oidcTestProviderName, oidcTestCode, state, entity.AccountSession{})
+}
+
+func assertAppErrorCode(t *testing.T, err error, code int) {
+	t.Helper()
+
+	var appErr *apperror.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("expected app error with code %d, got %v", code, err)
+	}
+	if appErr.Code != code {
+		t.Fatalf("expected app error with code %d, got %d: %s", code, appErr.Code, appErr.Message)
+	}
+}
+
+func assertLoggedIn(t *testing.T, loginResult *entity.LoginResult, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatalf("expected login to succeed, got %v", err)
+	}
+	if loginResult == nil || loginResult.Tokens == nil || loginResult.Tokens.AccessToken == "" {
+		t.Fatal("expected login result with tokens")
+	}
+}
+
+func TestCompleteOidcLoginCreatesVerifiedUserAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+
+	loginResult, err := env.callback(t, "subject-new", "new@example.com", nil)
+	assertLoggedIn(t, loginResult, err)
+
+	accountId, ok := env.store.identities[env.store.identityKey(oidcTestProviderName, "subject-new")]
+	if !ok {
+		t.Fatal("expected identity to be linked")
+	}
+	account := env.store.accounts[accountId]
+	if account.RoleId != appconstant.UserId {
+		t.Fatalf("expected user role, got %d", account.RoleId)
+	}
+	if account.VerifiedAt == nil {
+		t.Fatal("expected new account to be verified")
+	}
+}
+
+func TestCompleteOidcLoginLinksVerifiedUserAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+	accountId := env.store.addAccount("user@example.com", appconstant.UserId, appconstant.UserRoleName, true)
+
+	loginResult, err := env.callback(t, "subject-user", "user@example.com", nil)
+	assertLoggedIn(t, loginResult, err)
+
+	if linkedId := env.store.identities[env.store.identityKey(oidcTestProviderName, "subject-user")]; linkedId != accountId {
+		t.Fatalf("expected identity linked to account %d, got %d", accountId, linkedId)
+	}
+}
+
+func TestCompleteOidcLoginRejectsNonUserAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+	env.store.addAccount("doctor@example.com", appconstant.DoctorId, appconstant.DoctorRoleName, true)
+
+	_, err := env.callback(t, "subject-doctor", "doctor@example.com", nil)
+	assertAppErrorCode(t, err, http.StatusForbidden)
+
+	if len(env.store.identities) != 0 {
+		t.Fatal("expected no identity to be linked")
+	}
+}
+
+func TestCompleteOidcLoginRejectsUnverifiedAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+	accountId := env.store.addAccount("unverified@example.com", appconstant.UserId, appconstant.UserRoleName, false)
+
+	_, err := env.callback(t, "subject-unverified", "unverified@example.com", nil)
+	assertAppErrorCode(t, err, http.StatusUnauthorized)
+
+	if env.store.accounts[accountId].VerifiedAt != nil {
+		t.Fatal("expected existing account to stay unverified")
+	}
+	if len(env.store.identities) != 0 {
+		t.Fatal("expected no identity to be linked")
+	}
+}
+
+func TestCompleteOidcLoginLinksAuthenticatedAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+	accountId := env.store.addAccount("doctor@example.com", appconstant.DoctorId, appconstant.DoctorRoleName, true)
+
+	loginResult, err := env.callback(t, "subject-doctor", "other@example.com", &accountId)
+	assertLoggedIn(t, loginResult, err)
+
+	if linkedId := env.store.identities[env.store.identityKey(oidcTestProviderName, "subject-doctor")]; linkedId != accountId {
+		t.Fatalf("expected identity linked to account %d, got %d", accountId, linkedId)
+	}
+}
+
+func TestCompleteOidcLoginRejectsIdentityLinkedToAnotherAccount(t *testing.T) {
+	env := newOidcTestEnv(t)
+	userId := env.store.addAccount("user@example.com", appconstant.UserId, appconstant.UserRoleName, true)
+	doctorId := env.store.addAccount("doctor@example.com", appconstant.DoctorId, appconstant.DoctorRoleName, true)
+	env.store.identities[env.store.identityKey(oidcTestProviderName, "subject-taken")] = userId
+
+	_, err := env.callback(t, "subject-taken", "user@example.com", &doctorId)
+	assertAppErrorCode(t, err, http.StatusConflict)
+}
+
+func TestCompleteOidcLoginRejectsUnknownState(t *testing.T) {
+	env := newOidcTestEnv(t)
+
+	_, err := env.usecase.CompleteOidcLogin(context.Background(), oidcTestProviderName, oidcTestCode, fmt.Sprintf("unknown-%d", time.Now().UnixNano()), entity.AccountSession{})
+	assertAppErrorCode(t, err, http.StatusUnauthorized)
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type OrderEventUsecase interface {
+	Subscribe(ctx context.Context, accountId int64, lastEventId int64) (*util.OrderEventSubscription, error)
+}
+
+type orderEventUsecaseImpl struct {
+	userRepository            repository.UserRepository
+	pharmacyManagerRepository repository.PharmacyManagerRepository
+	orderEventSubscriber      util.OrderEventSubscriber
+}
+
+func NewOrderEventUsecaseImpl(userRepository repository.UserRepository, pharmacyManagerRepository repository.PharmacyManagerRepository, orderEventSubscriber util.OrderEventSubscriber) orderEventUsecaseImpl {
+	return orderEventUsecaseImpl{
+		userRepository:            userRepository,
+		pharmacyManagerRepository: pharmacyManagerRepository,
+		orderEventSubscriber:      orderEventSubscriber,
+	}
+}
+
+func (u *orderEventUsecaseImpl) Subscribe(ctx context.Context, accountId int64, lastEventId int64) (*util.OrderEventSubscription, error) {
+	user, err := u.userRepository.FindUserByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user != nil {
+		return u.orderEventSubscriber.Subscribe(lastEventId, func(event entity.OrderEvent) bool {
+			return event.UserId == user.Id
+		}), nil
+	}
+
+	manager, err := u.pharmacyManagerRepository.FindOneByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if manager != nil {
+		return u.orderEventSubscriber.Subscribe(lastEventId, func(event entity.OrderEvent) bool {
+			return event.PharmacyManagerId == manager.Id
+		}), nil
+	}
+
+	return nil, apperror.ForbiddenAction()
+}
+
+func newOrderEvents(orderId int64, orderPharmacies []entity.OrderPharmacy, statusId int64) []entity.OrderEvent {
+	events := []entity.OrderEvent{}
+
+	for _, orderPharmacy := range orderPharmacies {
+		events = append(events, entity.OrderEvent{
+			Type:              appconstant.OrderEventTypeOrderStatusChanged,
+			OrderId:           orderId,
+			OrderPharmacyId:   orderPharmacy.Id,
+			OrderStatusId:     statusId,
+			UserId:            orderPharmacy.UserId,
+			PharmacyManagerId: orderPharmacy.PharmacyManagerId,
+		})
+	}
+
+	return events
+}
+
+func newOrderPharmacyEvent(orderPharmacy entity.OrderPharmacy, statusId int64) entity.OrderEvent {
+	return entity.OrderEvent{
+		Type:              appconstant.OrderEventTypeOrderPharmacyStatusChanged,
+		OrderId:           orderPharmacy.OrderId,
+		OrderPharmacyId:   orderPharmacy.Id,
+		OrderStatusId:     statusId,
+		UserId:            orderPharmacy.UserId,
+		PharmacyManagerId: orderPharmacy.PharmacyManagerId,
+	}
+}
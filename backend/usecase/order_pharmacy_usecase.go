@@ -27,15 +27,17 @@ type orderPharmacyUsecaseImpl struct {
 	orderItemRepository       repository.OrderItemRepository
 	userRepository            repository.UserRepository
 	pharmacyManagerRepository repository.PharmacyManagerRepository
+	orderEventPublisher       util.OrderEventPublisher
 }
 
-func NewOrderPharmacyUsecaseImpl(transaction repository.Transaction, orderPharmacyRepository repository.OrderPharmacyRepository, orderItemRepository repository.OrderItemRepository, userRepository repository.UserRepository, pharmacyManagerRepository repository.PharmacyManagerRepository) orderPharmacyUsecaseImpl {
+func NewOrderPharmacyUsecaseImpl(transaction repository.Transaction, orderPharmacyRepository repository.OrderPharmacyRepository, orderItemRepository repository.OrderItemRepository, userRepository repository.UserRepository, pharmacyManagerRepository repository.PharmacyManagerRepository, orderEventPublisher util.OrderEventPublisher) orderPharmacyUsecaseImpl {
 	return orderPharmacyUsecaseImpl{
 		transaction:               transaction,
 		orderPharmacyRepository:   orderPharmacyRepository,
 		orderItemRepository:       orderItemRepository,
 		userRepository:            userRepository,
 		pharmacyManagerRepository: pharmacyManagerRepository,
+		orderEventPublisher:       orderEventPublisher,
 	}
 }
 
@@ -175,7 +177,35 @@ func (u *orderPharmacyUsecaseImpl) UpdateStatusToSent(ctx context.Context, accou
 		return apperror.InvalidOrderStatusError()
 	}
 
-	err = u.orderPharmacyRepository.UpdateOneStatusById(ctx, orderPharmacyId, 4)
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	orderPharmacyRepo := tx.OrderPharmacyRepository()
+	notificationRepo := tx.NotificationRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		if tx.Commit() == nil {
+			u.orderEventPublisher.Publish(newOrderPharmacyEvent(*orderPharmacy, 4))
+		}
+	}()
+
+	err = orderPharmacyRepo.UpdateOneStatusById(ctx, orderPharmacyId, 4)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	notification, err := newOrderStatusChangedNotification(*orderPharmacy, 4)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = notificationRepo.CreateForOrderPharmacyUser(ctx, orderPharmacyId, notification)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -207,7 +237,35 @@ func (u *orderPharmacyUsecaseImpl) UpdateStatusToConfirmed(ctx context.Context,
 		return apperror.InvalidOrderStatusError()
 	}
 
-	err = u.orderPharmacyRepository.UpdateOneStatusById(ctx, orderPharmacyId, 5)
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	orderPharmacyRepo := tx.OrderPharmacyRepository()
+	notificationRepo := tx.NotificationRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		if tx.Commit() == nil {
+			u.orderEventPublisher.Publish(newOrderPharmacyEvent(*orderPharmacy, 5))
+		}
+	}()
+
+	err = orderPharmacyRepo.UpdateOneStatusById(ctx, orderPharmacyId, 5)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	notification, err := newOrderStatusChangedNotification(*orderPharmacy, 5)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = notificationRepo.CreateForOrderPharmacyManager(ctx, orderPharmacyId, notification)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -254,12 +312,17 @@ func (u *orderPharmacyUsecaseImpl) UpdateStatusToCancelled(ctx context.Context,
 	pharmacyDrugRepo := tx.PharmacyDrugRepo()
 	orderPharmacyRepo := tx.OrderPharmacyRepository()
 	stockChangeRepo := tx.StockChangeRepo()
+	notificationRepo := tx.NotificationRepository()
 
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
+		}
+
+		if tx.Commit() == nil {
+			u.orderEventPublisher.Publish(newOrderPharmacyEvent(*orderPharmacy, 6))
 		}
-		tx.Commit()
 	}()
 
 	err = orderPharmacyRepo.UpdateOneStatusById(ctx, orderPharmacyId, 6)
@@ -276,5 +339,15 @@ func (u *orderPharmacyUsecaseImpl) UpdateStatusToCancelled(ctx context.Context,
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
+
+	notification, err := newOrderStatusChangedNotification(*orderPharmacy, 6)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = notificationRepo.CreateForOrderPharmacyUser(ctx, orderPharmacyId, notification)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
 	return nil
 }
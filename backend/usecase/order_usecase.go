@@ -12,6 +12,8 @@ import (
 	"max-health/entity"
 	"max-health/repository"
 	"max-health/util"
+
+	"github.com/sirupsen/logrus"
 )
 
 type OrderUsecase interface {
@@ -29,14 +31,16 @@ type orderUsecaseImpl struct {
 	userRepository          repository.UserRepository
 	orderRepository         repository.OrderRepository
 	orderPharmacyRepository repository.OrderPharmacyRepository
+	orderEventPublisher     util.OrderEventPublisher
 }
 
-func NewOrderUsecaseImpl(transaction repository.Transaction, userRepository repository.UserRepository, orderRepository repository.OrderRepository, orderPharmacyRepository repository.OrderPharmacyRepository) orderUsecaseImpl {
+func NewOrderUsecaseImpl(transaction repository.Transaction, userRepository repository.UserRepository, orderRepository repository.OrderRepository, orderPharmacyRepository repository.OrderPharmacyRepository, orderEventPublisher util.OrderEventPublisher) orderUsecaseImpl {
 	return orderUsecaseImpl{
 		transaction:             transaction,
 		userRepository:          userRepository,
 		orderRepository:         orderRepository,
 		orderPharmacyRepository: orderPharmacyRepository,
+		orderEventPublisher:     orderEventPublisher,
 	}
 }
 
@@ -67,10 +71,14 @@ func (u *orderUsecaseImpl) CheckoutOrder(ctx context.Context, orderCheckoutReque
 	pharmacyDrugRepo := tx.PharmacyDrugRepo()
 	stockChangeRepo := tx.StockChangeRepo()
 	stockMutationRepo := tx.StockMutationRepo()
+	prescriptionRepo := tx.PrescriptionRepository()
+	prescriptionDrugRepo := tx.PrescriptionDrugRepository()
+	notificationRepo := tx.NotificationRepository()
 
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
 		}
 
 		tx.Commit()
@@ -93,6 +101,36 @@ func (u *orderUsecaseImpl) CheckoutOrder(ctx context.Context, orderCheckoutReque
 		}
 	}
 
+	for _, pharmacy := range orderPharmacies {
+		for _, cartItem := range pharmacy.CartItems {
+			if cartItem.PrescriptionId == nil {
+				if cartItem.IsPrescriptionRequired {
+					err = apperror.PrescriptionRequiredError()
+					return nil, err
+				}
+
+				continue
+			}
+
+			var isDispensed bool
+
+			isDispensed, err = prescriptionDrugRepo.DispensePrescriptionDrug(ctx, orderCheckoutRequest.AccountId, *cartItem.PrescriptionId, cartItem.PharmacyDrugId, cartItem.Quantity)
+			if err != nil {
+				return nil, apperror.InternalServerError(err)
+			}
+
+			if !isDispensed {
+				err = apperror.PrescriptionQuantityExceededError()
+				return nil, err
+			}
+
+			err = prescriptionRepo.SetPrescriptionOrderedAtNow(ctx, *cartItem.PrescriptionId)
+			if err != nil {
+				return nil, apperror.InternalServerError(err)
+			}
+		}
+	}
+
 	err = orderItemRepo.PostOrderItems(ctx, orderPharmacies)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
@@ -176,6 +214,11 @@ func (u *orderUsecaseImpl) CheckoutOrder(ctx context.Context, orderCheckoutReque
 			return nil, apperror.InternalServerError(err)
 		}
 
+		err = notifyStockMutationsRequested(ctx, notificationRepo, stockMutationList)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
 		err = stockChangeRepo.PostStockChangesFromMutation(ctx, stockChangesList)
 		if err != nil {
 			return nil, apperror.InternalServerError(err)
@@ -227,27 +270,57 @@ func (u *orderUsecaseImpl) ConfirmPayment(ctx context.Context, orderId int64, st
 
 	orderRepo := tx.OrderRepository()
 	orderPharmacyRepo := tx.OrderPharmacyRepository()
+	auditLogRepo := tx.AuditLogRepository()
+	notificationRepo := tx.NotificationRepository()
 
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+				"order_id":        orderId,
+				"order_status_id": statusId,
+				"error":           err.Error(),
+			}).Error("payment confirmation failed")
+			return
 		}
-		tx.Commit()
+
+		if commitErr := tx.Commit(); commitErr != nil {
+			util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+				"order_id":        orderId,
+				"order_status_id": statusId,
+				"error":           commitErr.Error(),
+			}).Error("payment confirmation failed")
+			return
+		}
+
+		u.orderEventPublisher.Publish(newOrderEvents(orderId, orderPharmacies, statusId)...)
 	}()
 
+	paymentProof := order.PaymentProof
 	if statusId == 1 {
 		if strings.Split(order.PaymentProof, "/")[2] == "res.cloudinary.com" {
-			util.DeleteInCloudinary(order.PaymentProof)
+			deleteUploadedFile(ctx, order.PaymentProof)
 		}
-		if err := orderRepo.UpdatePaymentProofOne(ctx, &entity.Order{
+		if err = orderRepo.UpdatePaymentProofOne(ctx, &entity.Order{
 			Id:           orderId,
 			PaymentProof: "",
 		}); err != nil {
 			return apperror.InternalServerError(err)
 		}
+		paymentProof = ""
+	}
+
+	if err = orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, statusId); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	before := map[string]any{"order_status_id": orderPharmacies[0].OrderStatusId, "payment_proof": order.PaymentProof}
+	after := map[string]any{"order_status_id": statusId, "payment_proof": paymentProof}
+	if err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionConfirmPayment, appconstant.AuditEntityOrder, orderId, before, after); err != nil {
+		return apperror.InternalServerError(err)
 	}
 
-	if err := orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, statusId); err != nil {
+	if err = notifyPaymentConfirmation(ctx, notificationRepo, orderId, statusId); err != nil {
 		return apperror.InternalServerError(err)
 	}
 	return nil
@@ -284,7 +357,7 @@ func (u *orderUsecaseImpl) UploadPaymentProofOrder(ctx context.Context, accountI
 		return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 	}
 
-	paymentProofUrl, err := util.UploadToCloudinary(file, *filePath)
+	paymentProofUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -300,19 +373,22 @@ func (u *orderUsecaseImpl) UploadPaymentProofOrder(ctx context.Context, accountI
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
 		}
 
-		tx.Commit()
+		if tx.Commit() == nil {
+			u.orderEventPublisher.Publish(newOrderEvents(orderId, orderPharmacies, 2)...)
+		}
 	}()
 
-	if err := orderRepo.UpdatePaymentProofOne(ctx, &entity.Order{
+	if err = orderRepo.UpdatePaymentProofOne(ctx, &entity.Order{
 		Id:           orderId,
 		PaymentProof: paymentProofUrl,
 	}); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
-	if err := orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, 2); err != nil {
+	if err = orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, 2); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
@@ -410,11 +486,14 @@ func (u *orderUsecaseImpl) CancelOrder(ctx context.Context, accountId int64, ord
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
 		}
 
-		tx.Commit()
+		if tx.Commit() == nil {
+			u.orderEventPublisher.Publish(newOrderEvents(orderId, orderPharmacies, 6)...)
+		}
 	}()
-	if err := orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, 6); err != nil {
+	if err = orderPharmacyRepo.UpdateStatusBulkByOrderId(ctx, orderId, 6); err != nil {
 		return apperror.InternalServerError(err)
 	}
 	stockChanges, err := pharmacyDrugRepo.UpdatePharmacyDrugsByOrderId(ctx, orderId)
@@ -27,7 +27,7 @@ type partnerUsecaseImpl struct {
 	pharmacyManagerRepository repository.PharmacyManagerRepository
 	transaction               repository.Transaction
 	hashHelper                util.HashHelperIntf
-	emailHelper               util.EmailHelper
+	jwtHelper                 util.JwtAuthentication
 }
 
 type PartnerUsecaseImplOpts struct {
@@ -35,7 +35,7 @@ type PartnerUsecaseImplOpts struct {
 	PharmacyManagerRepository repository.PharmacyManagerRepository
 	Transaction               repository.Transaction
 	HashHelper                util.HashHelperIntf
-	EmailHelper               util.EmailHelper
+	JwtHelper                 util.JwtAuthentication
 }
 
 func NewPartnerUsecaseImpl(opts PartnerUsecaseImplOpts) partnerUsecaseImpl {
@@ -44,7 +44,7 @@ func NewPartnerUsecaseImpl(opts PartnerUsecaseImplOpts) partnerUsecaseImpl {
 		pharmacyManagerRepository: opts.PharmacyManagerRepository,
 		transaction:               opts.Transaction,
 		hashHelper:                opts.HashHelper,
-		emailHelper:               opts.EmailHelper,
+		jwtHelper:                 opts.JwtHelper,
 	}
 }
 
@@ -70,7 +70,7 @@ func (u *partnerUsecaseImpl) AddOnePartner(ctx context.Context, registerRequest
 		return apperror.EmailTakenError()
 	}
 
-	imageUrl, err := util.UploadToCloudinary(file, *filePath)
+	imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -105,7 +105,13 @@ func (u *partnerUsecaseImpl) AddOnePartner(ctx context.Context, registerRequest
 		return apperror.InternalServerError(err)
 	}
 
-	if err = pharmacyManagerRepo.PostOne(ctx, *accountId); err != nil {
+	pharmacyManagerId, err := pharmacyManagerRepo.PostOne(ctx, *accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	after := map[string]any{"account_id": *accountId, "email": account.Email, "name": account.Name}
+	if err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionCreatePartner, appconstant.AuditEntityPharmacyManager, *pharmacyManagerId, nil, after); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
@@ -121,7 +127,7 @@ func (u *partnerUsecaseImpl) GetAllPartners(ctx context.Context) (*dto.AllPartne
 	return dto.ConvertToAllPartnersResponse(pharmacyManagers), err
 }
 
-func (u *partnerUsecaseImpl) UpdateOnePartner(ctx context.Context, updateAccountRequest dto.UpdateAccountRequest, pharmacyManagerId int64, file multipart.File, fileHeader *multipart.FileHeader) error {
+func (u *partnerUsecaseImpl) UpdateOnePartner(ctx context.Context, updateAccountRequest dto.UpdateAccountRequest, pharmacyManagerId int64, file multipart.File, fileHeader *multipart.FileHeader) (err error) {
 	accountRequest := dto.UpdateAccountRequestToAccount(updateAccountRequest)
 
 	accountRequest.Name = strings.Trim(accountRequest.Name, " ")
@@ -155,20 +161,41 @@ func (u *partnerUsecaseImpl) UpdateOnePartner(ctx context.Context, updateAccount
 			return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 		}
 
-		imageUrl, err := util.UploadToCloudinary(file, *filePath)
+		imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 		if err != nil {
 			return apperror.InternalServerError(err)
 		}
 		accountRequest.ProfilePicture = imageUrl
 		if account.ProfilePicture != "https://res.cloudinary.com/dpdu3tidt/image/upload/v1713774687/profile_pictures/xdv5xzkz1yr0qwgkc6yk.avif" {
-			util.DeleteInCloudinary(account.ProfilePicture)
+			deleteUploadedFile(ctx, account.ProfilePicture)
 		}
 	} else {
 		accountRequest.ProfilePicture = account.ProfilePicture
 	}
 
 	accountRequest.Id = pharmacyManager.Account.Id
-	if err = u.accountRepository.UpdateNameAndProfilePictureOne(ctx, &accountRequest); err != nil {
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	if err = tx.AccountRepository().UpdateNameAndProfilePictureOne(ctx, &accountRequest); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	before := map[string]any{"name": account.Name, "profile_picture": account.ProfilePicture}
+	after := map[string]any{"name": accountRequest.Name, "profile_picture": accountRequest.ProfilePicture}
+	if err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUpdatePartner, appconstant.AuditEntityPharmacyManager, pharmacyManagerId, before, after); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
@@ -185,7 +212,7 @@ func (u *partnerUsecaseImpl) DeleteOnePartner(ctx context.Context, pharmacyManag
 	}
 
 	if strings.Split(pharmacyManager.Account.ProfilePicture, "/")[1] == "res.cloudinary.com" {
-		util.DeleteInCloudinary(pharmacyManager.Account.ProfilePicture)
+		deleteUploadedFile(ctx, pharmacyManager.Account.ProfilePicture)
 	}
 
 	tx, err := u.transaction.BeginTx()
@@ -195,6 +222,7 @@ func (u *partnerUsecaseImpl) DeleteOnePartner(ctx context.Context, pharmacyManag
 
 	accountRepo := tx.AccountRepository()
 	pharmacyManagerRepo := tx.PharmacyManagerRepository()
+	auditLogRepo := tx.AuditLogRepository()
 
 	defer func() {
 		if err != nil {
@@ -212,6 +240,11 @@ func (u *partnerUsecaseImpl) DeleteOnePartner(ctx context.Context, pharmacyManag
 		return apperror.InternalServerError(err)
 	}
 
+	before := map[string]any{"account_id": pharmacyManager.Account.Id, "email": pharmacyManager.Account.Email, "name": pharmacyManager.Account.Name}
+	if err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionDeletePartner, appconstant.AuditEntityPharmacyManager, pharmacyManagerId, before, nil); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
 	return nil
 }
 
@@ -225,35 +258,64 @@ func (u *partnerUsecaseImpl) SendCredentials(ctx context.Context, sendEmailReque
 		return apperror.NewAppError(http.StatusUnauthorized, errors.New(appconstant.MsgAccountNotRegistered), appconstant.MsgAccountNotRegistered)
 	}
 
-	rawPassword := util.GenerateCode(8)
-	password, err := u.hashHelper.HashPassword(rawPassword)
+	password, err := u.hashHelper.HashPassword(util.GenerateCode(appconstant.PlaceholderPasswordLength))
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
 
 	account.Password = password
 
-	u.emailHelper.AddRequest([]string{sendEmailRequest.Email}, appconstant.CredentialsEmailSubject)
+	setPasswordToken, err := u.jwtHelper.CreateAndSign(util.JwtCustomClaims{
+		AccountId:     account.Id,
+		Email:         account.Email,
+		TokenDuration: 60,
+	}, appconstant.ResetPasswordTokenAudience)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
 
-	if err = u.emailHelper.CreateBody(appconstant.CredentialsEmailTemplate, struct {
-		Name        string
-		Email       string
-		Credentials string
-	}{
-		Name:        account.Name,
-		Email:       account.Email,
-		Credentials: rawPassword,
-	}); err != nil {
+	setPasswordCode := util.GenerateCode(6)
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
 		return apperror.InternalServerError(err)
 	}
 
-	err = u.accountRepository.UpdatePasswordOne(ctx, account)
+	err = tx.AccountRepository().UpdatePasswordOne(ctx, account)
 	if err != nil {
+		tx.Rollback()
 		return apperror.InternalServerError(err)
 	}
 
-	err = u.emailHelper.SendEmail()
+	err = tx.ResetPasswordTokenRepository().InvalidateTokens(ctx, account.Id)
 	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	err = tx.ResetPasswordTokenRepository().PostOneToken(ctx, account.Id, setPasswordCode)
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	err = enqueueEmail(ctx, tx.EmailOutboxRepository(), appconstant.EmailTypeCredentials, account.Email, struct {
+		Name  string
+		Email string
+		Url   string
+		Code  string
+	}{
+		Name:  account.Name,
+		Email: account.Email,
+		Url:   newResetPasswordUrl(*setPasswordToken),
+		Code:  setPasswordCode,
+	})
+	if err != nil {
+		tx.Rollback()
+		return apperror.InternalServerError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
 		return apperror.InternalServerError(err)
 	}
 
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type PatientHealthProfileUsecase interface {
+	GetHealthProfile(ctx context.Context, userAccountId int64) (*entity.PatientHealthProfile, error)
+	UpdateHealthProfile(ctx context.Context, profile entity.PatientHealthProfile) (*entity.PatientHealthProfile, error)
+	GetHealthProfileForDoctor(ctx context.Context, doctorAccountId, roomId int64) (*entity.PatientHealthProfile, error)
+	GetHealthProfileForRoom(ctx context.Context, room entity.WsChatRoom, accessSource string) (*entity.PatientHealthProfile, error)
+	GetAccessLogs(ctx context.Context, userAccountId int64, page, limit string) (*dto.HealthProfileAccessLogListResponse, error)
+}
+
+type patientHealthProfileUsecaseImpl struct {
+	transaction                    repository.Transaction
+	userRepository                 repository.UserRepository
+	wsChatRoomRepository           repository.WsChatRoomRepository
+	patientAllergyRepository       repository.PatientAllergyRepository
+	patientHealthProfileRepository repository.PatientHealthProfileRepository
+}
+
+func NewPatientHealthProfileUsecaseImpl(transaction repository.Transaction, userRepository repository.UserRepository, wsChatRoomRepository repository.WsChatRoomRepository, patientAllergyRepository repository.PatientAllergyRepository, patientHealthProfileRepository repository.PatientHealthProfileRepository) patientHealthProfileUsecaseImpl {
+	return patientHealthProfileUsecaseImpl{
+		transaction:                    transaction,
+		userRepository:                 userRepository,
+		wsChatRoomRepository:           wsChatRoomRepository,
+		patientAllergyRepository:       patientAllergyRepository,
+		patientHealthProfileRepository: patientHealthProfileRepository,
+	}
+}
+
+func (u *patientHealthProfileUsecaseImpl) GetHealthProfile(ctx context.Context, userAccountId int64) (*entity.PatientHealthProfile, error) {
+	user, err := u.userRepository.FindUserByAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user == nil {
+		return nil, apperror.UserNotFoundError()
+	}
+
+	return u.findHealthProfile(ctx, userAccountId)
+}
+
+func (u *patientHealthProfileUsecaseImpl) UpdateHealthProfile(ctx context.Context, profile entity.PatientHealthProfile) (*entity.PatientHealthProfile, error) {
+	user, err := u.userRepository.FindUserByAccountId(ctx, profile.UserAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user == nil {
+		return nil, apperror.UserNotFoundError()
+	}
+
+	if (profile.HeightCm != nil && !profile.HeightCm.IsPositive()) || (profile.WeightKg != nil && !profile.WeightKg.IsPositive()) {
+		return nil, apperror.InvalidHealthProfileMeasurementError()
+	}
+
+	err = u.replaceHealthProfile(ctx, profile)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findHealthProfile(ctx, profile.UserAccountId)
+}
+
+func (u *patientHealthProfileUsecaseImpl) GetHealthProfileForDoctor(ctx context.Context, doctorAccountId, roomId int64) (*entity.PatientHealthProfile, error) {
+	room, err := u.wsChatRoomRepository.FindChatRoomById(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if room == nil || room.DoctorAccountId != doctorAccountId {
+		return nil, apperror.ChatRoomNotFoundError()
+	}
+
+	return u.GetHealthProfileForRoom(ctx, *room, appconstant.HealthProfileAccessSourceHealthProfile)
+}
+
+func (u *patientHealthProfileUsecaseImpl) GetHealthProfileForRoom(ctx context.Context, room entity.WsChatRoom, accessSource string) (*entity.PatientHealthProfile, error) {
+	if room.ExpiredAt == nil || *room.ExpiredAt < time.Now().UnixMicro() {
+		return nil, apperror.ChatRoomNotActiveError()
+	}
+
+	profile, err := u.findHealthProfile(ctx, room.UserAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.patientHealthProfileRepository.CreateAccessLog(ctx, entity.HealthProfileAccessLog{
+		UserAccountId:   room.UserAccountId,
+		DoctorAccountId: room.DoctorAccountId,
+		WsChatRoomId:    room.Id,
+		AccessSource:    accessSource,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return profile, nil
+}
+
+func (u *patientHealthProfileUsecaseImpl) GetAccessLogs(ctx context.Context, userAccountId int64, page, limit string) (*dto.HealthProfileAccessLogListResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	accessLogList, err := u.patientHealthProfileRepository.GetAccessLogsByUserAccountId(ctx, userAccountId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.patientHealthProfileRepository.GetAccessLogsByUserAccountIdTotalItem(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToHealthProfileAccessLogListResponse(accessLogList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *patientHealthProfileUsecaseImpl) replaceHealthProfile(ctx context.Context, profile entity.PatientHealthProfile) (err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = tx.PatientHealthProfileRepository().UpsertHealthProfile(ctx, profile)
+	if err != nil {
+		return err
+	}
+
+	err = tx.PatientAllergyRepository().ReplaceAllergens(ctx, profile.UserAccountId, profile.Allergies)
+	if err != nil {
+		return err
+	}
+
+	err = tx.PatientHealthProfileRepository().ReplaceChronicConditions(ctx, profile.UserAccountId, profile.ChronicConditions)
+	if err != nil {
+		return err
+	}
+
+	err = tx.PatientHealthProfileRepository().ReplaceMedications(ctx, profile.UserAccountId, profile.Medications)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *patientHealthProfileUsecaseImpl) findHealthProfile(ctx context.Context, userAccountId int64) (*entity.PatientHealthProfile, error) {
+	profile, err := u.patientHealthProfileRepository.FindHealthProfileByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if profile == nil {
+		profile = &entity.PatientHealthProfile{
+			UserAccountId:   userAccountId,
+			PregnancyStatus: appconstant.PregnancyStatusUnknown,
+		}
+	}
+
+	profile.Allergies, err = u.patientAllergyRepository.GetAllergensByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	profile.ChronicConditions, err = u.patientHealthProfileRepository.GetChronicConditionsByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	profile.Medications, err = u.patientHealthProfileRepository.GetMedicationsByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type PharmacyReviewUsecase interface {
+	CreatePharmacyReview(ctx context.Context, accountId, orderPharmacyId int64, request dto.PharmacyReviewRequest) (*entity.PharmacyReview, error)
+	UpdatePharmacyReview(ctx context.Context, accountId, orderPharmacyId int64, request dto.PharmacyReviewRequest) (*entity.PharmacyReview, error)
+	CreateOrderItemReview(ctx context.Context, accountId, orderItemId int64, request dto.PharmacyReviewRequest) (*entity.OrderItemReview, error)
+	UpdateOrderItemReview(ctx context.Context, accountId, orderItemId int64, request dto.PharmacyReviewRequest) (*entity.OrderItemReview, error)
+	ReplyPharmacyReview(ctx context.Context, accountId, pharmacyReviewId int64, request dto.ReviewReplyRequest) (*entity.PharmacyReview, error)
+	ReplyOrderItemReview(ctx context.Context, accountId, orderItemReviewId int64, request dto.ReviewReplyRequest) (*entity.OrderItemReview, error)
+	GetAllPharmacyReviews(ctx context.Context, pharmacyId int64, page, limit string) (*dto.PharmacyReviewListResponse, error)
+	GetAllDrugReviews(ctx context.Context, drugId int64, page, limit string) (*dto.OrderItemReviewListResponse, error)
+}
+
+type pharmacyReviewUsecaseImpl struct {
+	userRepository            repository.UserRepository
+	pharmacyManagerRepository repository.PharmacyManagerRepository
+	pharmacyRepository        repository.PharmacyRepository
+	drugRepository            repository.DrugRepository
+	pharmacyReviewRepository  repository.PharmacyReviewRepository
+	orderItemReviewRepository repository.OrderItemReviewRepository
+}
+
+func NewPharmacyReviewUsecaseImpl(userRepository repository.UserRepository, pharmacyManagerRepository repository.PharmacyManagerRepository, pharmacyRepository repository.PharmacyRepository, drugRepository repository.DrugRepository, pharmacyReviewRepository repository.PharmacyReviewRepository, orderItemReviewRepository repository.OrderItemReviewRepository) pharmacyReviewUsecaseImpl {
+	return pharmacyReviewUsecaseImpl{
+		userRepository:            userRepository,
+		pharmacyManagerRepository: pharmacyManagerRepository,
+		pharmacyRepository:        pharmacyRepository,
+		drugRepository:            drugRepository,
+		pharmacyReviewRepository:  pharmacyReviewRepository,
+		orderItemReviewRepository: orderItemReviewRepository,
+	}
+}
+
+func (u *pharmacyReviewUsecaseImpl) findReviewableOrderPharmacy(ctx context.Context, accountId, orderPharmacyId int64) (*entity.ReviewableOrderPharmacy, error) {
+	user, err := u.userRepository.FindUserByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user == nil {
+		return nil, apperror.UserNotFoundError()
+	}
+
+	orderPharmacy, err := u.pharmacyReviewRepository.FindReviewableOrderPharmacy(ctx, orderPharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if orderPharmacy == nil {
+		return nil, apperror.PharmacyOrderNotFoundError()
+	}
+
+	if orderPharmacy.UserId != user.Id {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	if orderPharmacy.OrderStatusId != appconstant.OrderStatusConfirmed {
+		return nil, apperror.OrderNotConfirmedError()
+	}
+
+	return orderPharmacy, nil
+}
+
+func (u *pharmacyReviewUsecaseImpl) findReviewableOrderItem(ctx context.Context, accountId, orderItemId int64) (*entity.ReviewableOrderItem, error) {
+	user, err := u.userRepository.FindUserByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if user == nil {
+		return nil, apperror.UserNotFoundError()
+	}
+
+	orderItem, err := u.orderItemReviewRepository.FindReviewableOrderItem(ctx, orderItemId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if orderItem == nil {
+		return nil, apperror.OrderItemNotFoundError()
+	}
+
+	if orderItem.UserId != user.Id {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	if orderItem.OrderStatusId != appconstant.OrderStatusConfirmed {
+		return nil, apperror.OrderNotConfirmedError()
+	}
+
+	return orderItem, nil
+}
+
+func (u *pharmacyReviewUsecaseImpl) CreatePharmacyReview(ctx context.Context, accountId, orderPharmacyId int64, request dto.PharmacyReviewRequest) (*entity.PharmacyReview, error) {
+	orderPharmacy, err := u.findReviewableOrderPharmacy(ctx, accountId, orderPharmacyId)
+	if err != nil {
+		return nil, err
+	}
+
+	existingReview, err := u.pharmacyReviewRepository.FindPharmacyReviewByOrderPharmacyId(ctx, orderPharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if existingReview != nil {
+		return nil, apperror.PharmacyReviewAlreadyExistsError()
+	}
+
+	pharmacyReviewId, err := u.pharmacyReviewRepository.CreatePharmacyReview(ctx, entity.PharmacyReview{
+		OrderPharmacyId: orderPharmacy.OrderPharmacyId,
+		PharmacyId:      orderPharmacy.PharmacyId,
+		UserId:          orderPharmacy.UserId,
+		Rating:          request.Rating,
+		Review:          request.Review,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findPharmacyReviewById(ctx, *pharmacyReviewId)
+}
+
+func (u *pharmacyReviewUsecaseImpl) UpdatePharmacyReview(ctx context.Context, accountId, orderPharmacyId int64, request dto.PharmacyReviewRequest) (*entity.PharmacyReview, error) {
+	_, err := u.findReviewableOrderPharmacy(ctx, accountId, orderPharmacyId)
+	if err != nil {
+		return nil, err
+	}
+
+	pharmacyReview, err := u.pharmacyReviewRepository.FindPharmacyReviewByOrderPharmacyId(ctx, orderPharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacyReview == nil {
+		return nil, apperror.PharmacyReviewNotFoundError()
+	}
+
+	if time.Since(pharmacyReview.CreatedAt) > appconstant.ReviewEditWindow {
+		return nil, apperror.ReviewEditWindowExpiredError()
+	}
+
+	err = u.pharmacyReviewRepository.UpdatePharmacyReview(ctx, pharmacyReview.Id, request.Rating, request.Review)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findPharmacyReviewById(ctx, pharmacyReview.Id)
+}
+
+func (u *pharmacyReviewUsecaseImpl) CreateOrderItemReview(ctx context.Context, accountId, orderItemId int64, request dto.PharmacyReviewRequest) (*entity.OrderItemReview, error) {
+	orderItem, err := u.findReviewableOrderItem(ctx, accountId, orderItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	existingReview, err := u.orderItemReviewRepository.FindOrderItemReviewByOrderItemId(ctx, orderItemId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if existingReview != nil {
+		return nil, apperror.OrderItemReviewAlreadyExistsError()
+	}
+
+	orderItemReviewId, err := u.orderItemReviewRepository.CreateOrderItemReview(ctx, entity.OrderItemReview{
+		OrderItemId:    orderItem.OrderItemId,
+		PharmacyId:     orderItem.PharmacyId,
+		PharmacyDrugId: orderItem.PharmacyDrugId,
+		DrugId:         orderItem.DrugId,
+		UserId:         orderItem.UserId,
+		Rating:         request.Rating,
+		Review:         request.Review,
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findOrderItemReviewById(ctx, *orderItemReviewId)
+}
+
+func (u *pharmacyReviewUsecaseImpl) UpdateOrderItemReview(ctx context.Context, accountId, orderItemId int64, request dto.PharmacyReviewRequest) (*entity.OrderItemReview, error) {
+	_, err := u.findReviewableOrderItem(ctx, accountId, orderItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderItemReview, err := u.orderItemReviewRepository.FindOrderItemReviewByOrderItemId(ctx, orderItemId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if orderItemReview == nil {
+		return nil, apperror.OrderItemReviewNotFoundError()
+	}
+
+	if time.Since(orderItemReview.CreatedAt) > appconstant.ReviewEditWindow {
+		return nil, apperror.ReviewEditWindowExpiredError()
+	}
+
+	err = u.orderItemReviewRepository.UpdateOrderItemReview(ctx, orderItemReview.Id, request.Rating, request.Review)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findOrderItemReviewById(ctx, orderItemReview.Id)
+}
+
+func (u *pharmacyReviewUsecaseImpl) ReplyPharmacyReview(ctx context.Context, accountId, pharmacyReviewId int64, request dto.ReviewReplyRequest) (*entity.PharmacyReview, error) {
+	pharmacyManager, err := u.pharmacyManagerRepository.FindOneByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacyManager == nil {
+		return nil, apperror.PharmacyManagerNotFoundError()
+	}
+
+	pharmacyReview, err := u.findPharmacyReviewById(ctx, pharmacyReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	if pharmacyReview.PharmacyManagerId != pharmacyManager.Id {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	err = u.pharmacyReviewRepository.UpdatePharmacyReviewReply(ctx, pharmacyReviewId, request.Reply)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findPharmacyReviewById(ctx, pharmacyReviewId)
+}
+
+func (u *pharmacyReviewUsecaseImpl) ReplyOrderItemReview(ctx context.Context, accountId, orderItemReviewId int64, request dto.ReviewReplyRequest) (*entity.OrderItemReview, error) {
+	pharmacyManager, err := u.pharmacyManagerRepository.FindOneByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacyManager == nil {
+		return nil, apperror.PharmacyManagerNotFoundError()
+	}
+
+	orderItemReview, err := u.findOrderItemReviewById(ctx, orderItemReviewId)
+	if err != nil {
+		return nil, err
+	}
+
+	if orderItemReview.PharmacyManagerId != pharmacyManager.Id {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	err = u.orderItemReviewRepository.UpdateOrderItemReviewReply(ctx, orderItemReviewId, request.Reply)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findOrderItemReviewById(ctx, orderItemReviewId)
+}
+
+func (u *pharmacyReviewUsecaseImpl) GetAllPharmacyReviews(ctx context.Context, pharmacyId int64, page, limit string) (*dto.PharmacyReviewListResponse, error) {
+	pharmacy, err := u.pharmacyRepository.GetOnePharmacyByPharmacyId(ctx, pharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacy == nil {
+		return nil, apperror.PharmacyNotFoundError()
+	}
+
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	pharmacyReviewList, err := u.pharmacyReviewRepository.GetAllPharmacyReviewByPharmacyId(ctx, pharmacyId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.pharmacyReviewRepository.GetAllPharmacyReviewByPharmacyIdTotalItem(ctx, pharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToPharmacyReviewListResponse(pharmacyReviewList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *pharmacyReviewUsecaseImpl) GetAllDrugReviews(ctx context.Context, drugId int64, page, limit string) (*dto.OrderItemReviewListResponse, error) {
+	drug, err := u.drugRepository.GetOneActiveDrugById(ctx, drugId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if drug == nil {
+		return nil, apperror.DrugNotFoundError()
+	}
+
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	orderItemReviewList, err := u.orderItemReviewRepository.GetAllOrderItemReviewByDrugId(ctx, drugId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.orderItemReviewRepository.GetAllOrderItemReviewByDrugIdTotalItem(ctx, drugId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToOrderItemReviewListResponse(orderItemReviewList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *pharmacyReviewUsecaseImpl) findPharmacyReviewById(ctx context.Context, pharmacyReviewId int64) (*entity.PharmacyReview, error) {
+	pharmacyReview, err := u.pharmacyReviewRepository.FindPharmacyReviewById(ctx, pharmacyReviewId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacyReview == nil {
+		return nil, apperror.PharmacyReviewNotFoundError()
+	}
+
+	return pharmacyReview, nil
+}
+
+func (u *pharmacyReviewUsecaseImpl) findOrderItemReviewById(ctx context.Context, orderItemReviewId int64) (*entity.OrderItemReview, error) {
+	orderItemReview, err := u.orderItemReviewRepository.FindOrderItemReviewById(ctx, orderItemReviewId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if orderItemReview == nil {
+		return nil, apperror.OrderItemReviewNotFoundError()
+	}
+
+	return orderItemReview, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"max-health/appconstant"
 	"max-health/apperror"
 	"max-health/dto"
 	"max-health/repository"
@@ -260,7 +261,7 @@ func (u *pharmacyUsecaseImpl) DeleteOnePharmacyById(ctx context.Context, account
 		return apperror.PharmacyManagerNotFoundError()
 	}
 
-	oldPharmacy, err := u.pharmacyRepository.FindOneById(ctx, pharmacyId)
+	oldPharmacy, err := u.pharmacyRepository.GetOnePharmacyByPharmacyId(ctx, pharmacyId)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -287,6 +288,7 @@ func (u *pharmacyUsecaseImpl) DeleteOnePharmacyById(ctx context.Context, account
 	pharmacyRepo := tx.PharmacyRepository()
 	pharmacyOperationalRepo := tx.PharmacyOperationalRepository()
 	pharmacyCourierRepo := tx.PharmacyCourierRepository()
+	auditLogRepo := tx.AuditLogRepository()
 
 	defer func() {
 		if err != nil {
@@ -308,6 +310,19 @@ func (u *pharmacyUsecaseImpl) DeleteOnePharmacyById(ctx context.Context, account
 		return apperror.InternalServerError(err)
 	}
 
+	before := map[string]any{
+		"pharmacy_manager_id":       oldPharmacy.PharmacyManagerId,
+		"name":                      oldPharmacy.Name,
+		"pharmacist_name":           oldPharmacy.PharmacistName,
+		"pharmacist_license_number": oldPharmacy.PharmacistLicenseNumber,
+		"pharmacist_phone_number":   oldPharmacy.PharmacistPhoneNumber,
+		"city":                      oldPharmacy.City,
+		"address":                   oldPharmacy.Address,
+	}
+	if err = recordAuditLog(ctx, auditLogRepo, appconstant.AuditActionDeletePharmacy, appconstant.AuditEntityPharmacy, pharmacyId, before, nil); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,300 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/repository"
+)
+
+type PrescriptionValidationUsecase interface {
+	ValidatePrescription(ctx context.Context, userAccountId int64, prescriptionDrugs []entity.PrescriptionDrug) (*entity.PrescriptionValidation, error)
+}
+
+type prescriptionValidationUsecaseImpl struct {
+	drugRepository                 repository.DrugRepository
+	drugInteractionRepository      repository.DrugInteractionRepository
+	patientAllergyRepository       repository.PatientAllergyRepository
+	patientHealthProfileRepository repository.PatientHealthProfileRepository
+}
+
+func NewPrescriptionValidationUsecaseImpl(drugRepository repository.DrugRepository, drugInteractionRepository repository.DrugInteractionRepository, patientAllergyRepository repository.PatientAllergyRepository, patientHealthProfileRepository repository.PatientHealthProfileRepository) *prescriptionValidationUsecaseImpl {
+	return &prescriptionValidationUsecaseImpl{
+		drugRepository:                 drugRepository,
+		drugInteractionRepository:      drugInteractionRepository,
+		patientAllergyRepository:       patientAllergyRepository,
+		patientHealthProfileRepository: patientHealthProfileRepository,
+	}
+}
+
+func (u *prescriptionValidationUsecaseImpl) ValidatePrescription(ctx context.Context, userAccountId int64, prescriptionDrugs []entity.PrescriptionDrug) (*entity.PrescriptionValidation, error) {
+	var validation entity.PrescriptionValidation
+
+	drugIds := []int64{}
+	for _, prescriptionDrug := range prescriptionDrugs {
+		drugIds = append(drugIds, prescriptionDrug.Drug.Id)
+	}
+
+	drugList, err := u.drugRepository.GetPrescribableDrugsByIds(ctx, drugIds)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	drugMap := map[int64]entity.PrescribableDrug{}
+	for _, drug := range drugList {
+		drugMap[drug.Id] = drug
+	}
+
+	prescribedDrugs := []entity.PrescribableDrug{}
+
+	for _, prescriptionDrug := range prescriptionDrugs {
+		drug, ok := drugMap[prescriptionDrug.Drug.Id]
+		if !ok {
+			validation.Errors = append(validation.Errors, entity.PrescriptionIssue{
+				Code:    appconstant.PrescriptionIssueUnknownDrug,
+				DrugId:  prescriptionDrug.Drug.Id,
+				Message: fmt.Sprintf("drug %d does not exist", prescriptionDrug.Drug.Id),
+			})
+			continue
+		}
+
+		if !drug.IsActive {
+			validation.Errors = append(validation.Errors, entity.PrescriptionIssue{
+				Code:    appconstant.PrescriptionIssueInactiveDrug,
+				DrugId:  drug.Id,
+				Message: fmt.Sprintf("%s is unavailable", drug.Name),
+			})
+			continue
+		}
+
+		maxQuantity := appconstant.DefaultMaxPrescriptionQuantity
+		if drug.MaxPrescriptionQuantity != nil {
+			maxQuantity = *drug.MaxPrescriptionQuantity
+		}
+
+		if prescriptionDrug.Quantity > maxQuantity {
+			validation.Errors = append(validation.Errors, entity.PrescriptionIssue{
+				Code:    appconstant.PrescriptionIssueMaxQuantityExceeded,
+				DrugId:  drug.Id,
+				Message: fmt.Sprintf("%s can be prescribed at most %d at a time", drug.Name, maxQuantity),
+			})
+		}
+
+		prescribedDrugs = append(prescribedDrugs, drug)
+	}
+
+	validation.Warnings = append(validation.Warnings, checkDuplicateTherapy(prescribedDrugs)...)
+
+	interactionWarnings, err := u.checkDrugInteractions(ctx, prescribedDrugs)
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Warnings = append(validation.Warnings, interactionWarnings...)
+
+	allergyWarnings, err := u.checkAllergies(ctx, userAccountId, prescribedDrugs)
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Warnings = append(validation.Warnings, allergyWarnings...)
+
+	medicationWarnings, err := u.checkCurrentMedications(ctx, userAccountId, prescribedDrugs)
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Warnings = append(validation.Warnings, medicationWarnings...)
+
+	pregnancyWarnings, err := u.checkPregnancy(ctx, userAccountId, prescribedDrugs)
+	if err != nil {
+		return nil, err
+	}
+
+	validation.Warnings = append(validation.Warnings, pregnancyWarnings...)
+
+	return &validation, nil
+}
+
+func checkDuplicateTherapy(drugs []entity.PrescribableDrug) []entity.PrescriptionIssue {
+	var issues []entity.PrescriptionIssue
+
+	for i := 0; i < len(drugs); i++ {
+		for j := i + 1; j < len(drugs); j++ {
+			if !strings.EqualFold(drugs[i].GenericName, drugs[j].GenericName) {
+				continue
+			}
+
+			relatedDrugId := drugs[j].Id
+
+			issues = append(issues, entity.PrescriptionIssue{
+				Code:          appconstant.PrescriptionIssueDuplicateTherapy,
+				DrugId:        drugs[i].Id,
+				RelatedDrugId: &relatedDrugId,
+				Message:       fmt.Sprintf("%s and %s share the same generic name %s", drugs[i].Name, drugs[j].Name, drugs[i].GenericName),
+			})
+		}
+	}
+
+	return issues
+}
+
+func (u *prescriptionValidationUsecaseImpl) checkDrugInteractions(ctx context.Context, drugs []entity.PrescribableDrug) ([]entity.PrescriptionIssue, error) {
+	var issues []entity.PrescriptionIssue
+
+	if len(drugs) < 2 {
+		return issues, nil
+	}
+
+	genericNames := []string{}
+	for _, drug := range drugs {
+		genericNames = append(genericNames, strings.ToLower(drug.GenericName))
+	}
+
+	interactionList, err := u.drugInteractionRepository.FindDrugInteractions(ctx, genericNames)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for _, interaction := range interactionList {
+		for i := 0; i < len(drugs); i++ {
+			for j := 0; j < len(drugs); j++ {
+				if i == j {
+					continue
+				}
+
+				if !strings.EqualFold(drugs[i].GenericName, interaction.GenericNameA) || !strings.EqualFold(drugs[j].GenericName, interaction.GenericNameB) {
+					continue
+				}
+
+				relatedDrugId := drugs[j].Id
+
+				issues = append(issues, entity.PrescriptionIssue{
+					Code:          appconstant.PrescriptionIssueDrugInteraction,
+					DrugId:        drugs[i].Id,
+					RelatedDrugId: &relatedDrugId,
+					Message:       fmt.Sprintf("%s interaction between %s and %s: %s", interaction.Severity, drugs[i].Name, drugs[j].Name, interaction.Description),
+				})
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+func (u *prescriptionValidationUsecaseImpl) checkAllergies(ctx context.Context, userAccountId int64, drugs []entity.PrescribableDrug) ([]entity.PrescriptionIssue, error) {
+	var issues []entity.PrescriptionIssue
+
+	allergenList, err := u.patientAllergyRepository.GetAllergensByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for _, drug := range drugs {
+		for _, allergen := range allergenList {
+			allergen = strings.ToLower(strings.TrimSpace(allergen))
+			if allergen == "" {
+				continue
+			}
+
+			if !strings.Contains(strings.ToLower(drug.GenericName), allergen) && !strings.Contains(strings.ToLower(drug.Name), allergen) {
+				continue
+			}
+
+			issues = append(issues, entity.PrescriptionIssue{
+				Code:    appconstant.PrescriptionIssueAllergy,
+				DrugId:  drug.Id,
+				Message: fmt.Sprintf("patient has a recorded allergy to %s", allergen),
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+func (u *prescriptionValidationUsecaseImpl) checkCurrentMedications(ctx context.Context, userAccountId int64, drugs []entity.PrescribableDrug) ([]entity.PrescriptionIssue, error) {
+	var issues []entity.PrescriptionIssue
+
+	medicationList, err := u.patientHealthProfileRepository.GetMedicationsByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	if len(medicationList) == 0 || len(drugs) == 0 {
+		return issues, nil
+	}
+
+	genericNames := []string{}
+	for _, drug := range drugs {
+		genericNames = append(genericNames, strings.ToLower(drug.GenericName))
+	}
+	for _, medication := range medicationList {
+		genericNames = append(genericNames, strings.ToLower(strings.TrimSpace(medication.Name)))
+	}
+
+	interactionList, err := u.drugInteractionRepository.FindDrugInteractions(ctx, genericNames)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	for _, drug := range drugs {
+		for _, medication := range medicationList {
+			medicationName := strings.TrimSpace(medication.Name)
+
+			if strings.EqualFold(drug.GenericName, medicationName) || strings.EqualFold(drug.Name, medicationName) {
+				issues = append(issues, entity.PrescriptionIssue{
+					Code:    appconstant.PrescriptionIssueCurrentMedication,
+					DrugId:  drug.Id,
+					Message: fmt.Sprintf("patient is already taking %s", medicationName),
+				})
+				continue
+			}
+
+			for _, interaction := range interactionList {
+				isInteracting := (strings.EqualFold(drug.GenericName, interaction.GenericNameA) && strings.EqualFold(medicationName, interaction.GenericNameB)) ||
+					(strings.EqualFold(drug.GenericName, interaction.GenericNameB) && strings.EqualFold(medicationName, interaction.GenericNameA))
+				if !isInteracting {
+					continue
+				}
+
+				issues = append(issues, entity.PrescriptionIssue{
+					Code:    appconstant.PrescriptionIssueCurrentMedication,
+					DrugId:  drug.Id,
+					Message: fmt.Sprintf("%s interaction between %s and current medication %s: %s", interaction.Severity, drug.Name, medicationName, interaction.Description),
+				})
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+func (u *prescriptionValidationUsecaseImpl) checkPregnancy(ctx context.Context, userAccountId int64, drugs []entity.PrescribableDrug) ([]entity.PrescriptionIssue, error) {
+	var issues []entity.PrescriptionIssue
+
+	profile, err := u.patientHealthProfileRepository.FindHealthProfileByUserAccountId(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if profile == nil {
+		return issues, nil
+	}
+
+	if profile.PregnancyStatus != appconstant.PregnancyStatusPregnant && profile.PregnancyStatus != appconstant.PregnancyStatusBreastfeeding {
+		return issues, nil
+	}
+
+	for _, drug := range drugs {
+		issues = append(issues, entity.PrescriptionIssue{
+			Code:    appconstant.PrescriptionIssuePregnancy,
+			DrugId:  drug.Id,
+			Message: fmt.Sprintf("patient is %s, make sure %s is safe to use", profile.PregnancyStatus, drug.Name),
+		})
+	}
+
+	return issues, nil
+}
@@ -14,19 +14,23 @@ type ReportUsecase interface {
 	GetPharmacyDrugReport(ctx context.Context, accountId int64, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllDrugSalesVolumeRevenueResponse, error)
 	GetDrugCategoryReport(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllDrugCategorySalesVolumeRevenueResponse, error)
 	GetDrugReport(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllDrugSalesVolumeRevenueResponse, error)
+	GetPharmacyRatingReport(ctx context.Context, accountId int64, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllPharmacyRatingReportResponse, error)
+	GetRatingReport(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllPharmacyRatingReportResponse, error)
 }
 
 type reportUsecaseImpl struct {
 	orderItemRepository       repository.OrderItemRepository
 	pharmacyRepository        repository.PharmacyRepository
 	pharmacyManagerRepository repository.PharmacyManagerRepository
+	pharmacyReviewRepository  repository.PharmacyReviewRepository
 }
 
-func NewreportUsecaseImpl(orderItemRepository repository.OrderItemRepository, pharmacyRepository repository.PharmacyRepository, pharmacyManagerRepository repository.PharmacyManagerRepository) reportUsecaseImpl {
+func NewreportUsecaseImpl(orderItemRepository repository.OrderItemRepository, pharmacyRepository repository.PharmacyRepository, pharmacyManagerRepository repository.PharmacyManagerRepository, pharmacyReviewRepository repository.PharmacyReviewRepository) reportUsecaseImpl {
 	return reportUsecaseImpl{
 		orderItemRepository:       orderItemRepository,
 		pharmacyRepository:        pharmacyRepository,
 		pharmacyManagerRepository: pharmacyManagerRepository,
+		pharmacyReviewRepository:  pharmacyReviewRepository,
 	}
 }
 
@@ -123,3 +127,50 @@ func (u *reportUsecaseImpl) GetDrugReport(ctx context.Context, validatedGetRepor
 
 	return dto.ConvertToAllDrugSalesVolumeRevenueResponse(drugReport), err
 }
+
+func (u *reportUsecaseImpl) GetPharmacyRatingReport(ctx context.Context, accountId int64, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllPharmacyRatingReportResponse, error) {
+	pharmacyManager, err := u.pharmacyManagerRepository.FindOneByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacyManager == nil {
+		return nil, apperror.PharmacyManagerNotFoundError()
+	}
+
+	pharmacy, err := u.pharmacyRepository.GetOnePharmacyByPharmacyId(ctx, validatedGetReportQuery.PharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	if pharmacy == nil {
+		return nil, apperror.PharmacyNotFoundError()
+	}
+
+	if pharmacy.PharmacyManagerId != pharmacyManager.Id {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	pharmacyRatingReport, err := u.pharmacyReviewRepository.FindPharmacyRatingReportByPharmacyId(ctx, validatedGetReportQuery)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return dto.ConvertToAllPharmacyRatingReportResponse(pharmacyRatingReport), nil
+}
+
+func (u *reportUsecaseImpl) GetRatingReport(ctx context.Context, validatedGetReportQuery util.ValidatedGetReportQuery) (*dto.AllPharmacyRatingReportResponse, error) {
+	pharmacy, err := u.pharmacyRepository.GetOnePharmacyByPharmacyId(ctx, validatedGetReportQuery.PharmacyId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if pharmacy == nil {
+		return nil, apperror.PharmacyNotFoundError()
+	}
+
+	pharmacyRatingReport, err := u.pharmacyReviewRepository.FindPharmacyRatingReportByPharmacyId(ctx, validatedGetReportQuery)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return dto.ConvertToAllPharmacyRatingReportResponse(pharmacyRatingReport), nil
+}
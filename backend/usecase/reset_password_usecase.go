@@ -29,33 +29,18 @@ func (u *authenticationUsecaseImpl) SendResetPasswordToken(ctx context.Context,
 		return apperror.AccountNotVerifiedError()
 	}
 
-	u.emailHelper.AddRequest([]string{sendEmailRequest.Email}, appconstant.ResetPasswordEmailSubject)
-
 	resetPasswordToken, err := u.jwtHelper.CreateAndSign(util.JwtCustomClaims{
 		AccountId:     acc.Id,
 		Email:         sendEmailRequest.Email,
 		TokenDuration: 60,
-	}, u.jwtHelper.Config.ResetPasswordSecret)
+	}, appconstant.ResetPasswordTokenAudience)
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
-	resetPasswordUrl := fmt.Sprintf("http://%s%s/reset-password/verification/%s", os.Getenv("HOST"), os.Getenv("FE_PORT"), *resetPasswordToken)
+	resetPasswordUrl := newResetPasswordUrl(*resetPasswordToken)
 
 	resetPasswordCode := util.GenerateCode(6)
 
-	err = u.emailHelper.CreateBody(appconstant.ResetPasswordEmailTemplate, struct {
-		Name string
-		Url  string
-		Code string
-	}{
-		Name: acc.Name,
-		Url:  resetPasswordUrl,
-		Code: resetPasswordCode,
-	})
-	if err != nil {
-		return apperror.InternalServerError(err)
-	}
-
 	tx, err := u.transaction.BeginTx()
 	if err != nil {
 		return apperror.InternalServerError(err)
@@ -81,7 +66,15 @@ func (u *authenticationUsecaseImpl) SendResetPasswordToken(ctx context.Context,
 		return apperror.InternalServerError(err)
 	}
 
-	err = u.emailHelper.SendEmail()
+	err = enqueueEmail(ctx, tx.EmailOutboxRepository(), appconstant.EmailTypeResetPassword, sendEmailRequest.Email, struct {
+		Name string
+		Url  string
+		Code string
+	}{
+		Name: acc.Name,
+		Url:  resetPasswordUrl,
+		Code: resetPasswordCode,
+	})
 	if err != nil {
 		return apperror.InternalServerError(err)
 	}
@@ -146,5 +139,17 @@ func (u *authenticationUsecaseImpl) ResetPassword(ctx context.Context, resetPass
 		return apperror.InternalServerError(err)
 	}
 
+	if err = accountRepo.ResetFailedLogins(ctx, resetPasswordToken.AccountId); err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	if err = revokeAllAccountSessions(ctx, tx.AccountSessionRepository(), tx.RefreshTokenRepository(), resetPasswordToken.AccountId, appconstant.SessionRevokedByPasswordReset); err != nil {
+		return err
+	}
+
 	return nil
 }
+
+func newResetPasswordUrl(token string) string {
+	return fmt.Sprintf("http://%s%s/reset-password/verification/%s", os.Getenv("HOST"), os.Getenv("FE_PORT"), token)
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+
+	"github.com/google/uuid"
+)
+
+type RoleUsecase interface {
+	GetRoleByName(ctx context.Context, roleName string) (*entity.Role, error)
+	GetRoles(ctx context.Context) ([]entity.Role, error)
+	GetPermissions(ctx context.Context) ([]entity.Permission, error)
+	UpdateRolePermissions(ctx context.Context, roleId int64, permissionNames []string) (*entity.Role, error)
+	AssignAccountRole(ctx context.Context, adminAccountId int64, accountId int64, roleId int64) error
+	CreateOperator(ctx context.Context, account entity.Account) error
+}
+
+type roleCache struct {
+	mu        sync.RWMutex
+	roles     map[string]entity.Role
+	expiredAt time.Time
+}
+
+type roleUsecaseImpl struct {
+	transaction       repository.Transaction
+	accountRepository repository.AccountRepository
+	roleRepository    repository.RoleRepository
+	hashHelper        util.HashHelperIntf
+	cache             *roleCache
+}
+
+func NewRoleUsecaseImpl(
+	transaction repository.Transaction,
+	accountRepository repository.AccountRepository,
+	roleRepository repository.RoleRepository,
+	hashHelper util.HashHelperIntf,
+) roleUsecaseImpl {
+	return roleUsecaseImpl{
+		transaction:       transaction,
+		accountRepository: accountRepository,
+		roleRepository:    roleRepository,
+		hashHelper:        hashHelper,
+		cache:             &roleCache{},
+	}
+}
+
+func (u *roleUsecaseImpl) GetRoleByName(ctx context.Context, roleName string) (*entity.Role, error) {
+	u.cache.mu.RLock()
+	role, ok := u.cache.roles[roleName]
+	isValid := time.Now().Before(u.cache.expiredAt)
+	u.cache.mu.RUnlock()
+
+	if ok && isValid {
+		return &role, nil
+	}
+
+	roles, err := u.roleRepository.GetRoles(ctx)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	cachedRoles := map[string]entity.Role{}
+	for _, role := range roles {
+		cachedRoles[role.Name] = role
+	}
+
+	u.cache.mu.Lock()
+	u.cache.roles = cachedRoles
+	u.cache.expiredAt = time.Now().Add(appconstant.RoleCacheDuration)
+	u.cache.mu.Unlock()
+
+	role, ok = cachedRoles[roleName]
+	if !ok {
+		return nil, nil
+	}
+
+	return &role, nil
+}
+
+func (u *roleUsecaseImpl) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	roles, err := u.roleRepository.GetRoles(ctx)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return roles, nil
+}
+
+func (u *roleUsecaseImpl) GetPermissions(ctx context.Context) ([]entity.Permission, error) {
+	permissions, err := u.roleRepository.GetPermissions(ctx)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return permissions, nil
+}
+
+func (u *roleUsecaseImpl) UpdateRolePermissions(ctx context.Context, roleId int64, permissionNames []string) (role *entity.Role, err error) {
+	permissionSet := map[string]bool{}
+	uniquePermissionNames := []string{}
+	for _, permissionName := range permissionNames {
+		permissionName = strings.TrimSpace(permissionName)
+		if !permissionSet[permissionName] {
+			permissionSet[permissionName] = true
+			uniquePermissionNames = append(uniquePermissionNames, permissionName)
+		}
+	}
+
+	role, err = u.roleRepository.FindRoleById(ctx, roleId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if role == nil {
+		return nil, apperror.RoleNotFoundError()
+	}
+	if role.Name == appconstant.AdminRoleName && !permissionSet[appconstant.PermissionRolesManage] {
+		return nil, apperror.RolesManagePermissionRequiredError()
+	}
+
+	count, err := u.roleRepository.CountPermissionsByNames(ctx, uniquePermissionNames)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if count != len(uniquePermissionNames) {
+		return nil, apperror.InvalidPermissionError()
+	}
+
+	previousPermissions := role.Permissions
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+		u.invalidateCache()
+	}()
+
+	err = tx.RoleRepository().ReplaceRolePermissions(ctx, roleId, uniquePermissionNames)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	role, err = tx.RoleRepository().FindRoleById(ctx, roleId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionUpdateRolePermissions, appconstant.AuditEntityRole, roleId,
+		map[string]any{"permissions": previousPermissions}, map[string]any{"permissions": role.Permissions})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return role, nil
+}
+
+func (u *roleUsecaseImpl) AssignAccountRole(ctx context.Context, adminAccountId int64, accountId int64, roleId int64) (err error) {
+	if adminAccountId == accountId {
+		return apperror.OwnRoleChangeError()
+	}
+
+	currentRole, err := u.roleRepository.FindRoleByAccountId(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if currentRole == nil {
+		return apperror.AccountNotFoundError()
+	}
+
+	role, err := u.roleRepository.FindRoleById(ctx, roleId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if role == nil {
+		return apperror.RoleNotFoundError()
+	}
+
+	if !currentRole.IsOperator || !role.IsOperator {
+		return apperror.OperatorRoleRequiredError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = tx.RoleRepository().UpdateAccountRole(ctx, accountId, roleId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = revokeAllAccountSessions(ctx, tx.AccountSessionRepository(), tx.RefreshTokenRepository(), accountId, appconstant.SessionRevokedByRoleChange)
+	if err != nil {
+		return err
+	}
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionAssignAccountRole, appconstant.AuditEntityAccount, accountId,
+		map[string]any{"role": currentRole.Name}, map[string]any{"role": role.Name})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *roleUsecaseImpl) CreateOperator(ctx context.Context, account entity.Account) (err error) {
+	role, err := u.roleRepository.FindRoleById(ctx, account.RoleId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if role == nil {
+		return apperror.RoleNotFoundError()
+	}
+	if !role.IsOperator {
+		return apperror.OperatorRoleRequiredError()
+	}
+
+	acc, err := u.accountRepository.FindAccountByEmail(ctx, account.Email)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if acc != nil {
+		return apperror.EmailTakenError()
+	}
+
+	account.Name = strings.TrimSpace(account.Name)
+	if !util.RegexValidate(account.Name, appconstant.NameRegexPattern) {
+		return apperror.InvalidNameError(nil)
+	}
+
+	password, err := u.hashHelper.HashPassword(uuid.NewString())
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	account.Password = password
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	accountId, err := tx.AccountRepository().PostOneAccount(ctx, account)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionCreateOperator, appconstant.AuditEntityAccount, int64(*accountId),
+		nil, map[string]any{"email": account.Email, "name": account.Name, "role": role.Name})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *roleUsecaseImpl) invalidateCache() {
+	u.cache.mu.Lock()
+	u.cache.expiredAt = time.Time{}
+	u.cache.mu.Unlock()
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/dto"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+type SickLeaveUsecase interface {
+	IssueSickLeaveCertificate(ctx context.Context, doctorAccountId, roomId int64, request dto.IssueSickLeaveRequest) (*entity.SickLeaveCertificate, error)
+	GetSickLeaveDocument(ctx context.Context, accountId, sickLeaveId int64) ([]byte, error)
+	VerifySickLeaveCertificate(ctx context.Context, verificationCode string) (*entity.SickLeaveVerification, error)
+	GetUserDocuments(ctx context.Context, userAccountId int64, page, limit string) (*dto.UserDocumentListResponse, error)
+}
+
+type sickLeaveUsecaseImpl struct {
+	wsChatRoomRepository    repository.WsChatRoomRepository
+	sickLeaveRepository     repository.SickLeaveRepository
+	sickLeaveDocumentHelper util.SickLeaveDocumentHelper
+}
+
+func NewSickLeaveUsecaseImpl(wsChatRoomRepository repository.WsChatRoomRepository, sickLeaveRepository repository.SickLeaveRepository, sickLeaveDocumentHelper util.SickLeaveDocumentHelper) sickLeaveUsecaseImpl {
+	return sickLeaveUsecaseImpl{
+		wsChatRoomRepository:    wsChatRoomRepository,
+		sickLeaveRepository:     sickLeaveRepository,
+		sickLeaveDocumentHelper: sickLeaveDocumentHelper,
+	}
+}
+
+func (u *sickLeaveUsecaseImpl) IssueSickLeaveCertificate(ctx context.Context, doctorAccountId, roomId int64, request dto.IssueSickLeaveRequest) (*entity.SickLeaveCertificate, error) {
+	room, err := u.wsChatRoomRepository.FindChatRoomById(ctx, roomId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if room == nil || room.DoctorAccountId != doctorAccountId {
+		return nil, apperror.ChatRoomNotFoundError()
+	}
+	if room.ExpiredAt == nil {
+		return nil, apperror.ChatRoomNotActiveError()
+	}
+
+	startDate, err := time.Parse(appconstant.SickLeaveDateFormat, request.StartDate)
+	if err != nil {
+		return nil, apperror.InvalidSickLeaveStartDateError()
+	}
+
+	verificationCode, err := util.GenerateSecureCode(appconstant.PrescriptionVerificationCodeLength)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	certificate := entity.SickLeaveCertificate{
+		WsChatRoomId:     room.Id,
+		UserAccountId:    room.UserAccountId,
+		DoctorAccountId:  room.DoctorAccountId,
+		StartDate:        startDate,
+		Days:             request.Days,
+		DiagnosisSummary: request.DiagnosisSummary,
+		VerificationCode: verificationCode,
+	}
+	certificate.Signature = u.sickLeaveDocumentHelper.Sign(certificate)
+
+	sickLeaveId, err := u.sickLeaveRepository.CreateSickLeaveCertificate(ctx, certificate)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return u.findSickLeaveCertificate(ctx, sickLeaveId)
+}
+
+func (u *sickLeaveUsecaseImpl) GetSickLeaveDocument(ctx context.Context, accountId, sickLeaveId int64) ([]byte, error) {
+	certificate, err := u.findSickLeaveCertificate(ctx, sickLeaveId)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate.UserAccountId != accountId && certificate.DoctorAccountId != accountId {
+		return nil, apperror.SickLeaveNotFoundError()
+	}
+
+	pdf, err := u.sickLeaveDocumentHelper.Render(*certificate)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return pdf, nil
+}
+
+func (u *sickLeaveUsecaseImpl) VerifySickLeaveCertificate(ctx context.Context, verificationCode string) (*entity.SickLeaveVerification, error) {
+	certificate, err := u.sickLeaveRepository.FindSickLeaveCertificateByVerificationCode(ctx, verificationCode)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if certificate == nil {
+		return nil, apperror.InvalidSickLeaveVerificationCodeError()
+	}
+
+	return &entity.SickLeaveVerification{
+		Certificate: *certificate,
+		IsValid:     u.sickLeaveDocumentHelper.Verify(*certificate),
+	}, nil
+}
+
+func (u *sickLeaveUsecaseImpl) GetUserDocuments(ctx context.Context, userAccountId int64, page, limit string) (*dto.UserDocumentListResponse, error) {
+	limitInt, offsetInt, err := util.CheckPharmacyDrugPagination(page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	documentList, err := u.sickLeaveRepository.GetUserDocumentsByUserAccountId(ctx, userAccountId, limitInt, offsetInt)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	totalItem, err := u.sickLeaveRepository.GetUserDocumentsByUserAccountIdTotalItem(ctx, userAccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	pageInfo := entity.PageInfo{
+		PageCount: int(math.Ceil(float64(totalItem) / float64(limitInt))),
+		ItemCount: totalItem,
+		Page:      offsetInt/limitInt + 1,
+	}
+
+	response := dto.ConvertToUserDocumentListResponse(documentList, pageInfo)
+
+	return &response, nil
+}
+
+func (u *sickLeaveUsecaseImpl) findSickLeaveCertificate(ctx context.Context, sickLeaveId int64) (*entity.SickLeaveCertificate, error) {
+	certificate, err := u.sickLeaveRepository.FindSickLeaveCertificateById(ctx, sickLeaveId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if certificate == nil {
+		return nil, apperror.SickLeaveNotFoundError()
+	}
+
+	return certificate, nil
+}
@@ -19,6 +19,8 @@ type TelemedicineUsecase interface {
 	GetAllPrescriptions(ctx context.Context, accountId int64, limit, page string) (*dto.PrescriptionResponseList, error)
 	PrepareForCheckout(ctx context.Context, accountId, prescriptionId int64, addressIdString string) (*dto.PreapareForCheckoutResponse, error)
 	CheckoutFromPrescription(ctx context.Context, checkoutFromPrescriptionRequest dto.CheckoutFromPrescriptionRequest) (*int64, error)
+	GetPrescriptionDocument(ctx context.Context, accountId, prescriptionId int64) ([]byte, error)
+	VerifyPrescription(ctx context.Context, verificationCode string) (*entity.PrescriptionVerification, error)
 }
 
 type telemedicineUsecaseImpl struct {
@@ -32,9 +34,10 @@ type telemedicineUsecaseImpl struct {
 	userAddressRepository      repository.UserAddressRepository
 	pharmacyRepository         repository.PharmacyRepository
 	transaction                repository.Transaction
+	prescriptionDocumentHelper util.PrescriptionDocumentHelper
 }
 
-func NewTelemedicineUsecaseImpl(userRepository repository.UserRepository, doctorRepository repository.DoctorRepository, pharmacyDrugRepository repository.PharmacyDrugRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, prescriptionRepository repository.PrescriptionRepository, cartRepository repository.CartRepository, orderRepository repository.OrderRepository, userAddressRepository repository.UserAddressRepository, pharmacyRepository repository.PharmacyRepository, transaction repository.Transaction) telemedicineUsecaseImpl {
+func NewTelemedicineUsecaseImpl(userRepository repository.UserRepository, doctorRepository repository.DoctorRepository, pharmacyDrugRepository repository.PharmacyDrugRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, prescriptionRepository repository.PrescriptionRepository, cartRepository repository.CartRepository, orderRepository repository.OrderRepository, userAddressRepository repository.UserAddressRepository, pharmacyRepository repository.PharmacyRepository, transaction repository.Transaction, prescriptionDocumentHelper util.PrescriptionDocumentHelper) telemedicineUsecaseImpl {
 	return telemedicineUsecaseImpl{
 		userRepository:             userRepository,
 		doctorRepository:           doctorRepository,
@@ -46,6 +49,7 @@ func NewTelemedicineUsecaseImpl(userRepository repository.UserRepository, doctor
 		userAddressRepository:      userAddressRepository,
 		pharmacyRepository:         pharmacyRepository,
 		transaction:                transaction,
+		prescriptionDocumentHelper: prescriptionDocumentHelper,
 	}
 }
 
@@ -117,13 +121,17 @@ func (u *telemedicineUsecaseImpl) PrepareForCheckout(ctx context.Context, accoun
 		return nil, apperror.InternalServerError(err)
 	}
 
+	if prescription == nil || prescription.UserAccountId != accountId {
+		return nil, apperror.InvalidPrescriptionIdError()
+	}
+
 	addressId, err := strconv.Atoi(addressIdString)
 	if err != nil {
 		return nil, apperror.AddressIdInvalidError()
 	}
 
-	if prescription.OrderedAt != nil {
-		return nil, apperror.PrescriptionHasBeenUsedError()
+	if prescription.IsExpired() {
+		return nil, apperror.PrescriptionHasExpiredError()
 	}
 
 	userCredential, err := u.userRepository.FindUserByAccountId(ctx, accountId)
@@ -152,12 +160,23 @@ func (u *telemedicineUsecaseImpl) PrepareForCheckout(ctx context.Context, accoun
 		return nil, apperror.InternalServerError(err)
 	}
 
+	prescription.PrescriptionDrugs = prescriptionDrugList
+
+	if prescription.IsExhausted() {
+		return nil, apperror.PrescriptionExhaustedError()
+	}
+
 	var checkoutPreparation entity.PrepareForCheckout
 
 	checkoutPreparation.UserAddress = *userAddress
 
 out:
 	for _, prescriptionDrug := range prescriptionDrugList {
+		prescriptionDrug.Quantity = prescription.RemainingQuantity(prescriptionDrug)
+		if prescriptionDrug.Quantity == 0 {
+			continue
+		}
+
 		if !prescriptionDrug.Drug.IsActive {
 			return nil, apperror.DrugIsInactiveError()
 		}
@@ -223,8 +242,12 @@ func (u *telemedicineUsecaseImpl) CheckoutFromPrescription(ctx context.Context,
 		return nil, apperror.InternalServerError(err)
 	}
 
-	if prescription.OrderedAt != nil {
-		return nil, apperror.PrescriptionHasBeenUsedError()
+	if prescription == nil || prescription.UserAccountId != checkoutFromPrescriptionRequest.AccountId {
+		return nil, apperror.InvalidPrescriptionIdError()
+	}
+
+	if prescription.IsExpired() {
+		return nil, apperror.PrescriptionHasExpiredError()
 	}
 
 	tx, err := u.transaction.BeginTx()
@@ -240,22 +263,43 @@ func (u *telemedicineUsecaseImpl) CheckoutFromPrescription(ctx context.Context,
 	stockChangeRepo := tx.StockChangeRepo()
 	stockMutationRepo := tx.StockMutationRepo()
 	prescriptionRepo := tx.PrescriptionRepository()
+	prescriptionDrugRepo := tx.PrescriptionDrugRepository()
+	notificationRepo := tx.NotificationRepository()
 
 	defer func() {
 		if err != nil {
 			tx.Rollback()
+			return
 		}
 
 		tx.Commit()
 	}()
 
+	for _, pharmacy := range checkoutFromPrescriptionRequest.Pharmacies {
+		for _, pharmacyDrugQuantity := range pharmacy.PharmacyDrugs {
+			var isDispensed bool
+
+			isDispensed, err = prescriptionDrugRepo.DispensePrescriptionDrug(ctx, checkoutFromPrescriptionRequest.AccountId, *prescription.Id, pharmacyDrugQuantity.PharmacyDrugId, pharmacyDrugQuantity.Quantity)
+			if err != nil {
+				return nil, apperror.InternalServerError(err)
+			}
+
+			if !isDispensed {
+				err = apperror.PrescriptionQuantityExceededError()
+				return nil, err
+			}
+		}
+	}
+
 	orderCheckoutRequest := dto.ConvertPrescriptionCheckoutRequest(checkoutFromPrescriptionRequest)
 
 	for i, pharmacy := range checkoutFromPrescriptionRequest.Pharmacies {
 		var cartItemIds []int64
 
 		for _, phamacyDrugQuantity := range pharmacy.PharmacyDrugs {
-			cartItemId, err := cartRepo.PostOneCart(ctx, checkoutFromPrescriptionRequest.AccountId, phamacyDrugQuantity.PharmacyDrugId, phamacyDrugQuantity.Quantity)
+			var cartItemId *int64
+
+			cartItemId, err = cartRepo.PostOneCart(ctx, checkoutFromPrescriptionRequest.AccountId, phamacyDrugQuantity.PharmacyDrugId, phamacyDrugQuantity.Quantity, prescription.Id)
 			if err != nil {
 				return nil, apperror.InternalServerError(err)
 			}
@@ -366,6 +410,11 @@ func (u *telemedicineUsecaseImpl) CheckoutFromPrescription(ctx context.Context,
 			return nil, apperror.InternalServerError(err)
 		}
 
+		err = notifyStockMutationsRequested(ctx, notificationRepo, stockMutationList)
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
 		err = stockChangeRepo.PostStockChangesFromMutation(ctx, stockChangesList)
 		if err != nil {
 			return nil, apperror.InternalServerError(err)
@@ -389,3 +438,70 @@ func (u *telemedicineUsecaseImpl) CheckoutFromPrescription(ctx context.Context,
 
 	return &orderId, nil
 }
+
+func (u *telemedicineUsecaseImpl) GetPrescriptionDocument(ctx context.Context, accountId, prescriptionId int64) ([]byte, error) {
+	document, err := u.findPrescriptionDocument(ctx, prescriptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.PatientAccountId != accountId && document.DoctorAccountId != accountId {
+		return nil, apperror.InvalidPrescriptionIdError()
+	}
+
+	if document.VerificationCode == nil || document.Signature == nil {
+		return nil, apperror.PrescriptionNotSignedError()
+	}
+
+	pdf, err := u.prescriptionDocumentHelper.Render(*document)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return pdf, nil
+}
+
+func (u *telemedicineUsecaseImpl) VerifyPrescription(ctx context.Context, verificationCode string) (*entity.PrescriptionVerification, error) {
+	document, err := u.prescriptionRepository.GetPrescriptionDocumentByVerificationCode(ctx, verificationCode)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if document == nil {
+		return nil, apperror.InvalidPrescriptionVerificationCodeError()
+	}
+
+	prescriptionDrugs, err := u.prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, document.PrescriptionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	document.PrescriptionDrugs = prescriptionDrugs
+
+	if !u.prescriptionDocumentHelper.Verify(*document) {
+		return &entity.PrescriptionVerification{IsValid: false}, nil
+	}
+
+	return &entity.PrescriptionVerification{
+		Document: *document,
+		IsValid:  true,
+	}, nil
+}
+
+func (u *telemedicineUsecaseImpl) findPrescriptionDocument(ctx context.Context, prescriptionId int64) (*entity.PrescriptionDocument, error) {
+	document, err := u.prescriptionRepository.GetPrescriptionDocumentById(ctx, prescriptionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if document == nil {
+		return nil, apperror.InvalidPrescriptionIdError()
+	}
+
+	prescriptionDrugs, err := u.prescriptionDrugRepository.GetAllPrescriptionDrug(ctx, prescriptionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	document.PrescriptionDrugs = prescriptionDrugs
+
+	return document, nil
+}
@@ -0,0 +1,412 @@
+package usecase
+
+import (
+	"context"
+
+	"max-health/appconstant"
+	"max-health/apperror"
+	"max-health/entity"
+	"max-health/repository"
+	"max-health/util"
+)
+
+func (u *authenticationUsecaseImpl) VerifyTwoFactorLogin(ctx context.Context, challengeToken string, code string, session entity.AccountSession) (*entity.Tokens, error) {
+	claims, err := u.jwtHelper.ParseAndVerify(challengeToken, appconstant.TwoFactorChallengeAudience)
+	if err != nil {
+		return nil, apperror.InvalidTwoFactorChallengeError()
+	}
+
+	account, err := u.accountRepository.FindAccountByEmail(ctx, claims.Email)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account == nil || account.Id != claims.AccountId {
+		return nil, apperror.InvalidTwoFactorChallengeError()
+	}
+
+	lockedFor, err := u.accountRepository.FindLockRemainingSecondsById(ctx, account.Id)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if lockedFor > 0 {
+		return nil, apperror.AccountLockedError(lockedFor)
+	}
+
+	isValid, err := u.verifySecondFactor(ctx, account.Id, code, true)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, u.recordFailedLogin(ctx, *account, apperror.InvalidTwoFactorCodeError())
+	}
+
+	return u.startSession(ctx, *account, session, true)
+}
+
+func (u *authenticationUsecaseImpl) GetTwoFactorStatus(ctx context.Context, tokenData entity.TokenData) (*entity.TwoFactorStatus, error) {
+	isRequired, err := u.isTwoFactorRequired(ctx, tokenData.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	status := entity.TwoFactorStatus{
+		IsRequired: isRequired,
+	}
+
+	twoFactor, err := u.twoFactorRepository.FindByAccountId(ctx, tokenData.AccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if twoFactor == nil || twoFactor.EnabledAt == nil {
+		return &status, nil
+	}
+
+	remainingRecoveryCodes, err := u.twoFactorRepository.CountRemainingRecoveryCodes(ctx, tokenData.AccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	status.IsEnabled = true
+	status.RemainingRecoveryCodes = remainingRecoveryCodes
+
+	return &status, nil
+}
+
+func (u *authenticationUsecaseImpl) StartTwoFactorEnrolment(ctx context.Context, accountId int64) (*entity.TwoFactorEnrolment, error) {
+	twoFactor, err := u.twoFactorRepository.FindByAccountId(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if twoFactor != nil && twoFactor.EnabledAt != nil {
+		return nil, apperror.TwoFactorAlreadyEnabledError()
+	}
+
+	account, err := u.accountRepository.FindOneById(ctx, accountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return nil, apperror.AccountNotFoundError()
+	}
+
+	secret, err := u.twoFactorHelper.GenerateSecret()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	encryptedSecret, err := u.twoFactorHelper.EncryptSecret(secret)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	err = u.twoFactorRepository.UpsertPending(ctx, accountId, encryptedSecret)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	provisioningUri := u.twoFactorHelper.ProvisioningUri(account.Email, secret)
+	qrCode, err := u.twoFactorHelper.RenderQrCode(provisioningUri)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &entity.TwoFactorEnrolment{
+		Secret:          secret,
+		ProvisioningUri: provisioningUri,
+		QrCode:          qrCode,
+	}, nil
+}
+
+func (u *authenticationUsecaseImpl) ConfirmTwoFactorEnrolment(ctx context.Context, tokenData entity.TokenData, code string) (activation *entity.TwoFactorActivation, err error) {
+	account, err := u.accountRepository.FindOneById(ctx, tokenData.AccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return nil, apperror.AccountNotFoundError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	twoFactorRepo := tx.TwoFactorRepository()
+	refreshTokenRepo := tx.RefreshTokenRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	twoFactor, err := twoFactorRepo.FindByAccountIdForUpdate(ctx, tokenData.AccountId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+	if twoFactor == nil {
+		return nil, apperror.TwoFactorNotEnrolledError()
+	}
+	if twoFactor.EnabledAt != nil {
+		return nil, apperror.TwoFactorAlreadyEnabledError()
+	}
+
+	secret, err := u.twoFactorHelper.DecryptSecret(twoFactor.Secret)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	step, isValid := u.twoFactorHelper.ValidateCode(secret, code, twoFactor.LastUsedStep)
+	if !isValid {
+		return nil, apperror.InvalidTwoFactorCodeError()
+	}
+
+	err = twoFactorRepo.Enable(ctx, tokenData.AccountId, step)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	recoveryCodes, err := u.replaceRecoveryCodes(ctx, twoFactorRepo, tokenData.AccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = refreshTokenRepo.RevokeCodesBySessionId(ctx, tokenData.SessionId)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	tokens, err := u.createTokens(util.JwtCustomClaims{
+		AccountId: tokenData.AccountId,
+		Email:     account.Email,
+		Role:      tokenData.Role,
+		SessionId: tokenData.SessionId,
+		TwoFactor: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = refreshTokenRepo.PostOneCode(ctx, tokenData.AccountId, tokenData.SessionId, tokens.RefreshToken)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return &entity.TwoFactorActivation{
+		RecoveryCodes: recoveryCodes,
+		Tokens:        tokens,
+	}, nil
+}
+
+func (u *authenticationUsecaseImpl) RegenerateRecoveryCodes(ctx context.Context, accountId int64, code string) (recoveryCodes []string, err error) {
+	isValid, err := u.verifySecondFactor(ctx, accountId, code, false)
+	if err != nil {
+		return nil, err
+	}
+	if !isValid {
+		return nil, apperror.InvalidTwoFactorCodeError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	return u.replaceRecoveryCodes(ctx, tx.TwoFactorRepository(), accountId)
+}
+
+func (u *authenticationUsecaseImpl) DisableTwoFactor(ctx context.Context, tokenData entity.TokenData, code string) (err error) {
+	isRequired, err := u.isTwoFactorRequired(ctx, tokenData.Role)
+	if err != nil {
+		return err
+	}
+	if isRequired {
+		return apperror.TwoFactorMandatoryError()
+	}
+
+	isValid, err := u.verifySecondFactor(ctx, tokenData.AccountId, code, true)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return apperror.InvalidTwoFactorCodeError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	twoFactorRepo := tx.TwoFactorRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	err = twoFactorRepo.Delete(ctx, tokenData.AccountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = twoFactorRepo.DeleteRecoveryCodes(ctx, tokenData.AccountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *authenticationUsecaseImpl) ResetTwoFactor(ctx context.Context, accountId int64) (err error) {
+	account, err := u.accountRepository.FindOneById(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if account == nil {
+		return apperror.AccountNotFoundError()
+	}
+
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	twoFactorRepo := tx.TwoFactorRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	twoFactor, err := twoFactorRepo.FindByAccountIdForUpdate(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = twoFactorRepo.Delete(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = twoFactorRepo.DeleteRecoveryCodes(ctx, accountId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	err = revokeAllAccountSessions(ctx, tx.AccountSessionRepository(), tx.RefreshTokenRepository(), accountId, appconstant.SessionRevokedByTwoFactorReset)
+	if err != nil {
+		return err
+	}
+
+	isTwoFactorEnabled := twoFactor != nil && twoFactor.EnabledAt != nil
+	err = recordAuditLog(ctx, tx.AuditLogRepository(), appconstant.AuditActionResetTwoFactor, appconstant.AuditEntityAccount, accountId,
+		map[string]any{"two_factor_enabled": isTwoFactorEnabled}, map[string]any{"two_factor_enabled": false})
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
+}
+
+func (u *authenticationUsecaseImpl) verifySecondFactor(ctx context.Context, accountId int64, code string, isRecoveryCodeAllowed bool) (isValid bool, err error) {
+	tx, err := u.transaction.BeginTx()
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+
+	twoFactorRepo := tx.TwoFactorRepository()
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+			return
+		}
+
+		err = tx.Commit()
+	}()
+
+	twoFactor, err := twoFactorRepo.FindByAccountIdForUpdate(ctx, accountId)
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+	if twoFactor == nil || twoFactor.EnabledAt == nil {
+		return false, apperror.TwoFactorNotEnabledError()
+	}
+
+	secret, err := u.twoFactorHelper.DecryptSecret(twoFactor.Secret)
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+
+	step, isValid := u.twoFactorHelper.ValidateCode(secret, code, twoFactor.LastUsedStep)
+	if isValid {
+		err = twoFactorRepo.UpdateLastUsedStep(ctx, accountId, step)
+		if err != nil {
+			return false, apperror.InternalServerError(err)
+		}
+
+		return true, nil
+	}
+
+	if !isRecoveryCodeAllowed {
+		return false, nil
+	}
+
+	isUsed, err := twoFactorRepo.UseRecoveryCode(ctx, accountId, u.twoFactorHelper.HashRecoveryCode(code))
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+
+	return isUsed, nil
+}
+
+func (u *authenticationUsecaseImpl) replaceRecoveryCodes(ctx context.Context, twoFactorRepo repository.TwoFactorRepository, accountId int64) ([]string, error) {
+	recoveryCodes, err := u.twoFactorHelper.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	codeHashes := []string{}
+	for _, recoveryCode := range recoveryCodes {
+		codeHashes = append(codeHashes, u.twoFactorHelper.HashRecoveryCode(recoveryCode))
+	}
+
+	err = twoFactorRepo.ReplaceRecoveryCodes(ctx, accountId, codeHashes)
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return recoveryCodes, nil
+}
+
+func (u *authenticationUsecaseImpl) isTwoFactorRequired(ctx context.Context, roleName string) (bool, error) {
+	role, err := u.roleRepository.FindRoleByName(ctx, roleName)
+	if err != nil {
+		return false, apperror.InternalServerError(err)
+	}
+	if role == nil {
+		return false, nil
+	}
+
+	return role.IsTwoFactorRequired, nil
+}
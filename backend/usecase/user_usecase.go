@@ -75,13 +75,13 @@ func (u *userUsecaseImpl) UpdateData(ctx context.Context, user entity.DetailedUs
 			return apperror.NewAppError(http.StatusBadRequest, err, err.Error())
 		}
 
-		imageUrl, err := util.UploadToCloudinary(file, *filePath)
+		imageUrl, err := util.UploadToCloudinary(ctx, file, *filePath)
 		if err != nil {
 			return apperror.InternalServerError(err)
 		}
 		user.ProfilePicture = imageUrl
 		if dbAccount.ProfilePicture != "https://res.cloudinary.com/dpdu3tidt/image/upload/v1713774687/profile_pictures/xdv5xzkz1yr0qwgkc6yk.avif" {
-			util.DeleteInCloudinary(dbAccount.ProfilePicture)
+			deleteUploadedFile(ctx, dbAccount.ProfilePicture)
 		}
 	} else {
 		user.ProfilePicture = dbAccount.ProfilePicture
@@ -3,15 +3,19 @@ package usecase
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"max-health/appconstant"
 	"max-health/apperror"
 	"max-health/dto"
 	"max-health/entity"
 	"max-health/repository"
 	"max-health/util"
+	"net/http"
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/sirupsen/logrus"
 )
 
 type WsUsecase interface {
@@ -19,23 +23,38 @@ type WsUsecase interface {
 	HandleCentrifugo(ctx context.Context, wsToken entity.WsToken, toClient, fromClient chan []byte, chClose chan bool) error
 }
 
+type wsSession struct {
+	room               entity.WsChatRoom
+	accountId          int64
+	lastReplayedChatId int64
+	firstLiveChatId    int64
+}
+
 type wsUsecaseImpl struct {
-	wsChatRoomRepository       repository.WsChatRoomRepository
-	prescriptionRepository     repository.PrescriptionRepository
-	prescriptionDrugRepository repository.PrescriptionDrugRepository
-	chatRepository             repository.ChatRepository
-	jwtHelper                  util.JwtAuthentication
-	transaction                repository.Transaction
+	wsChatRoomRepository          repository.WsChatRoomRepository
+	prescriptionRepository        repository.PrescriptionRepository
+	prescriptionDrugRepository    repository.PrescriptionDrugRepository
+	chatRepository                repository.ChatRepository
+	chatReadStateRepository       repository.ChatReadStateRepository
+	sickLeaveRepository           repository.SickLeaveRepository
+	prescriptionValidationUsecase PrescriptionValidationUsecase
+	prescriptionDocumentHelper    util.PrescriptionDocumentHelper
+	jwtHelper                     util.JwtAuthentication
+	transaction                   repository.Transaction
 }
 
-func NewWsUsecaseImpl(wsChatRoomRepository repository.WsChatRoomRepository, prescriptionRepository repository.PrescriptionRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, chatRepository repository.ChatRepository, jwtHelper util.JwtAuthentication, transaction repository.Transaction) *wsUsecaseImpl {
+func NewWsUsecaseImpl(wsChatRoomRepository repository.WsChatRoomRepository, prescriptionRepository repository.PrescriptionRepository, prescriptionDrugRepository repository.PrescriptionDrugRepository, chatRepository repository.ChatRepository, chatReadStateRepository repository.ChatReadStateRepository, sickLeaveRepository repository.SickLeaveRepository, prescriptionValidationUsecase PrescriptionValidationUsecase, prescriptionDocumentHelper util.PrescriptionDocumentHelper, jwtHelper util.JwtAuthentication, transaction repository.Transaction) *wsUsecaseImpl {
 	return &wsUsecaseImpl{
-		wsChatRoomRepository:       wsChatRoomRepository,
-		prescriptionRepository:     prescriptionRepository,
-		prescriptionDrugRepository: prescriptionDrugRepository,
-		chatRepository:             chatRepository,
-		jwtHelper:                  jwtHelper,
-		transaction:                transaction,
+		wsChatRoomRepository:          wsChatRoomRepository,
+		prescriptionRepository:        prescriptionRepository,
+		prescriptionDrugRepository:    prescriptionDrugRepository,
+		chatRepository:                chatRepository,
+		chatReadStateRepository:       chatReadStateRepository,
+		sickLeaveRepository:           sickLeaveRepository,
+		prescriptionValidationUsecase: prescriptionValidationUsecase,
+		prescriptionDocumentHelper:    prescriptionDocumentHelper,
+		jwtHelper:                     jwtHelper,
+		transaction:                   transaction,
 	}
 }
 
@@ -95,6 +114,19 @@ func (u *wsUsecaseImpl) HandleCentrifugo(ctx context.Context, wsToken entity.WsT
 		}
 	}
 
+	clientClaims, err := u.jwtHelper.CentrifugoClientParseAndVerify(wsToken.Token.ClientToken)
+	if err != nil {
+		return apperror.UnauthorizedError()
+	}
+	if clientClaims.AccountId != room.UserAccountId && clientClaims.AccountId != room.DoctorAccountId {
+		return apperror.UnauthorizedError()
+	}
+
+	session := wsSession{
+		room:      *room,
+		accountId: clientClaims.AccountId,
+	}
+
 	fromCentrifugo := make(chan []byte, 5)
 	defer close(fromCentrifugo)
 
@@ -114,21 +146,50 @@ func (u *wsUsecaseImpl) HandleCentrifugo(ctx context.Context, wsToken entity.WsT
 		return apperror.InternalServerError(err)
 	}
 
+	if wsToken.LastChatId != nil {
+		res, err := u.replayMissedChats(ctx, &session, *wsToken.LastChatId, toClient)
+		if err != nil {
+			return err
+		}
+
+		toClient <- res
+	}
+
 outer:
 	for {
 		select {
-		case data := <-fromClient:
-			res, err := u.handleChatMessage(ctx, data)
-			if err != nil {
+		case data, ok := <-fromClient:
+			if !ok {
 				break outer
 			}
 
+			res, isSenderOnly, err := u.handleClientMessage(ctx, &session, data, toClient)
+			if err != nil {
+				toClient <- newWsErrorMessage(ctx, err)
+				continue
+			}
+
+			if isSenderOnly {
+				toClient <- res
+				continue
+			}
+
 			err = centrifugoHelper.Publish(ctx, res)
 			if err != nil {
 				continue
 			}
 
 		case data := <-fromCentrifugo:
+			chatId := chatIdFromMessage(data)
+			if chatId != 0 {
+				if chatId <= session.lastReplayedChatId {
+					continue
+				}
+				if session.firstLiveChatId == 0 {
+					session.firstLiveChatId = chatId
+				}
+			}
+
 			toClient <- data
 		}
 	}
@@ -136,38 +197,278 @@ outer:
 	return nil
 }
 
-func (u *wsUsecaseImpl) handleChatMessage(ctx context.Context, chatData []byte) ([]byte, error) {
-	var wsDataReq dto.WsChatData
-	err := json.Unmarshal(chatData, &wsDataReq)
+func newWsErrorMessage(ctx context.Context, err error) []byte {
+	code := http.StatusInternalServerError
+	message := appconstant.MsgInternalServerError
+
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) && appErr.Code < http.StatusInternalServerError {
+		code = appErr.Code
+		message = appErr.Message
+	} else {
+		util.LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"error": err.Error(),
+		}).Error("error handling websocket message")
+	}
+
+	res, _ := json.Marshal(dto.WsMessage{
+		Type: appconstant.WsMessageTypeError,
+		Data: dto.WsErrorEvent{
+			Code:    code,
+			Message: message,
+		},
+	})
+
+	return res
+}
+
+func (u *wsUsecaseImpl) replayMissedChats(ctx context.Context, session *wsSession, lastChatId int64, toClient chan []byte) ([]byte, error) {
+	missedChats, err := u.chatRepository.GetChatsAfterId(ctx, session.room.Id, lastChatId, appconstant.ChatReplayLimit+1)
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
+	hasMore := len(missedChats) > appconstant.ChatReplayLimit
+	if hasMore {
+		missedChats = missedChats[:appconstant.ChatReplayLimit]
+	}
+
+	if session.firstLiveChatId != 0 {
+		for i, chat := range missedChats {
+			if chat.Id >= session.firstLiveChatId {
+				missedChats = missedChats[:i]
+				hasMore = false
+				break
+			}
+		}
+	}
+
+	missedChats, err = attachPrescriptionDrugs(ctx, u.prescriptionDrugRepository, missedChats)
+	if err != nil {
+		return nil, err
+	}
+
+	lastReplayedChatId := lastChatId
+
+	for _, chat := range missedChats {
+		res, err := json.Marshal(dto.ConvertToChatDTO(chat))
+		if err != nil {
+			return nil, apperror.InternalServerError(err)
+		}
+
+		toClient <- res
+
+		lastReplayedChatId = chat.Id
+	}
+
+	if lastReplayedChatId > session.lastReplayedChatId {
+		session.lastReplayedChatId = lastReplayedChatId
+	}
+
+	res, err := json.Marshal(dto.WsMessage{
+		Type: appconstant.WsMessageTypeReplay,
+		Data: dto.WsReplayEvent{
+			LastChatId: lastReplayedChatId,
+			HasMore:    hasMore,
+		},
+	})
+	if err != nil {
+		return nil, apperror.InternalServerError(err)
+	}
+
+	return res, nil
+}
+
+func chatIdFromMessage(data []byte) int64 {
+	var chat dto.Chat
+
+	err := json.Unmarshal(data, &chat)
+	if err != nil {
+		return 0
+	}
+
+	return chat.Id
+}
+
+func (u *wsUsecaseImpl) handleClientMessage(ctx context.Context, session *wsSession, message []byte, toClient chan []byte) ([]byte, bool, error) {
+	var wsMsg dto.WsMessage
+	err := json.Unmarshal(message, &wsMsg)
+	if err != nil {
+		return nil, false, apperror.BadRequestError(err)
+	}
+
+	data, err := json.Marshal(wsMsg.Data)
+	if err != nil {
+		return nil, false, apperror.InternalServerError(err)
+	}
+
+	switch wsMsg.Type {
+	case appconstant.WsMessageTypeChat:
+		return u.handleChatMessage(ctx, *session, data)
+	case appconstant.WsMessageTypeTyping:
+		res, err := u.handleTypingMessage(*session, data)
+		return res, false, err
+	case appconstant.WsMessageTypeDelivered, appconstant.WsMessageTypeRead:
+		res, err := u.handleReceiptMessage(ctx, *session, wsMsg.Type, data)
+		return res, false, err
+	case appconstant.WsMessageTypeReplay:
+		res, err := u.handleReplayMessage(ctx, session, data, toClient)
+		return res, true, err
+	}
+
+	return nil, false, apperror.BadRequestError(fmt.Errorf("unknown message type: %s", wsMsg.Type))
+}
+
+func (u *wsUsecaseImpl) handleReplayMessage(ctx context.Context, session *wsSession, replayData []byte, toClient chan []byte) ([]byte, error) {
+	var wsDataReq dto.WsReplayData
+	err := json.Unmarshal(replayData, &wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
+	err = validator.New().Struct(wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
+	if wsDataReq.Channel != session.room.Hash {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	return u.replayMissedChats(ctx, session, wsDataReq.LastChatId, toClient)
+}
+
+func (u *wsUsecaseImpl) handleTypingMessage(session wsSession, typingData []byte) ([]byte, error) {
+	var wsDataReq dto.WsTypingData
+	err := json.Unmarshal(typingData, &wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
 	err = validator.New().Struct(wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
+	if wsDataReq.Channel != session.room.Hash {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	res, err := json.Marshal(dto.WsMessage{
+		Type: appconstant.WsMessageTypeTyping,
+		Data: dto.WsTypingEvent{
+			AccountId: session.accountId,
+			IsTyping:  wsDataReq.IsTyping,
+		},
+	})
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	channel, side, chat := dto.ToChatEntity(wsDataReq)
+	return res, nil
+}
+
+func (u *wsUsecaseImpl) handleReceiptMessage(ctx context.Context, session wsSession, messageType string, receiptData []byte) ([]byte, error) {
+	var wsDataReq dto.WsReceiptData
+	err := json.Unmarshal(receiptData, &wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
+
+	err = validator.New().Struct(wsDataReq)
+	if err != nil {
+		return nil, apperror.BadRequestError(err)
+	}
 
-	room, err := u.wsChatRoomRepository.FindWsChatRoomByHash(ctx, channel)
+	if wsDataReq.Channel != session.room.Hash {
+		return nil, apperror.ForbiddenAction()
+	}
+
+	if messageType == appconstant.WsMessageTypeRead {
+		err = u.chatReadStateRepository.UpdateLastReadChat(ctx, session.room.Id, session.accountId, wsDataReq.ChatId)
+	} else {
+		err = u.chatReadStateRepository.UpdateLastDeliveredChat(ctx, session.room.Id, session.accountId, wsDataReq.ChatId)
+	}
 	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
-	if room == nil {
+
+	res, err := json.Marshal(dto.WsMessage{
+		Type: messageType,
+		Data: dto.WsReceiptEvent{
+			AccountId: session.accountId,
+			ChatId:    wsDataReq.ChatId,
+		},
+	})
+	if err != nil {
 		return nil, apperror.InternalServerError(err)
 	}
 
-	chat.SenderAccountId = room.UserAccountId
-	if side == 2 {
-		chat.SenderAccountId = room.DoctorAccountId
+	return res, nil
+}
+
+func (u *wsUsecaseImpl) handleChatMessage(ctx context.Context, session wsSession, chatData []byte) ([]byte, bool, error) {
+	var wsDataReq dto.WsChatData
+	err := json.Unmarshal(chatData, &wsDataReq)
+	if err != nil {
+		return nil, false, apperror.BadRequestError(err)
+	}
+
+	err = validator.New().Struct(wsDataReq)
+	if err != nil {
+		return nil, false, apperror.BadRequestError(err)
+	}
+
+	channel, chat := dto.ToChatEntity(wsDataReq)
+
+	if channel != session.room.Hash {
+		return nil, false, apperror.ForbiddenAction()
+	}
+	room := session.room
+	isDoctor := session.accountId == room.DoctorAccountId
+
+	chat.SenderAccountId = session.accountId
+
+	if chat.SickLeave != nil {
+		if !isDoctor {
+			return nil, false, apperror.ForbiddenAction()
+		}
+
+		sickLeave, err := u.sickLeaveRepository.FindSickLeaveCertificateById(ctx, chat.SickLeave.Id)
+		if err != nil {
+			return nil, false, apperror.InternalServerError(err)
+		}
+		if sickLeave == nil || sickLeave.WsChatRoomId != room.Id {
+			return nil, false, apperror.SickLeaveNotFoundError()
+		}
+
+		chat.SickLeave = sickLeave
+	}
+
+	if len(chat.Prescription.PrescriptionDrugs) > 0 && isDoctor {
+		validation, err := u.prescriptionValidationUsecase.ValidatePrescription(ctx, room.UserAccountId, chat.Prescription.PrescriptionDrugs)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if validation.IsRejected(wsDataReq.AcknowledgeWarnings) {
+			res, err := json.Marshal(dto.WsMessage{
+				Type: appconstant.WsMessageTypePrescriptionValidation,
+				Data: dto.ToPrescriptionValidationResponse(*validation),
+			})
+			if err != nil {
+				return nil, false, apperror.InternalServerError(err)
+			}
+
+			return res, true, nil
+		}
 	}
 
 	chat.RoomId = room.Id
 
 	tx, err := u.transaction.BeginTx()
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, false, apperror.InternalServerError(err)
 	}
 	defer func() {
 		if err != nil {
@@ -182,25 +483,42 @@ func (u *wsUsecaseImpl) handleChatMessage(ctx context.Context, chatData []byte)
 	prescriptionDrugRepo := tx.PrescriptionDrugRepository()
 	chatRepo := tx.ChatRepository()
 
-	if len(chat.Prescription.PrescriptionDrugs) > 0 && side == 2 {
-		prescriptionId, err := prescriptionRepo.CreateOnePrescription(ctx, room.UserAccountId, room.DoctorAccountId)
+	if len(chat.Prescription.PrescriptionDrugs) > 0 && isDoctor {
+		validDays := wsDataReq.ValidDays
+		if validDays == 0 {
+			validDays = appconstant.DefaultPrescriptionValidDays
+		}
+
+		expiredAt := time.Now().AddDate(0, 0, validDays)
+
+		chat.Prescription.UserAccountId = room.UserAccountId
+		chat.Prescription.DoctorAccountId = room.DoctorAccountId
+		chat.Prescription.ExpiredAt = &expiredAt
+
+		var prescriptionId *int64
+		prescriptionId, err = prescriptionRepo.CreateOnePrescription(ctx, chat.Prescription)
 		if err != nil {
-			return nil, apperror.InternalServerError(err)
+			return nil, false, apperror.InternalServerError(err)
 		}
 
 		for _, prescriptionDrug := range chat.Prescription.PrescriptionDrugs {
-			err := prescriptionDrugRepo.PostOnePrescriptionDrug(ctx, *prescriptionId, prescriptionDrug)
+			err = prescriptionDrugRepo.PostOnePrescriptionDrug(ctx, *prescriptionId, prescriptionDrug)
 			if err != nil {
-				return nil, apperror.InternalServerError(err)
+				return nil, false, apperror.InternalServerError(err)
 			}
 		}
 
+		err = u.signPrescription(ctx, prescriptionRepo, prescriptionDrugRepo, *prescriptionId)
+		if err != nil {
+			return nil, false, err
+		}
+
 		chat.Prescription.Id = prescriptionId
 	}
 
 	chatId, createdAt, err := chatRepo.PostOneChat(ctx, chat)
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, false, apperror.InternalServerError(err)
 	}
 
 	chat.Id = *chatId
@@ -208,8 +526,37 @@ func (u *wsUsecaseImpl) handleChatMessage(ctx context.Context, chatData []byte)
 
 	res, err := json.Marshal(dto.ConvertToChatDTO(chat))
 	if err != nil {
-		return nil, apperror.InternalServerError(err)
+		return nil, false, apperror.InternalServerError(err)
 	}
 
-	return res, nil
+	return res, false, nil
+}
+
+func (u *wsUsecaseImpl) signPrescription(ctx context.Context, prescriptionRepo repository.PrescriptionRepository, prescriptionDrugRepo repository.PrescriptionDrugRepository, prescriptionId int64) error {
+	document, err := prescriptionRepo.GetPrescriptionDocumentById(ctx, prescriptionId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+	if document == nil {
+		return apperror.InvalidPrescriptionIdError()
+	}
+
+	document.PrescriptionDrugs, err = prescriptionDrugRepo.GetAllPrescriptionDrug(ctx, prescriptionId)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	verificationCode, err := util.GenerateSecureCode(appconstant.PrescriptionVerificationCodeLength)
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	document.VerificationCode = &verificationCode
+
+	err = prescriptionRepo.SetPrescriptionSignature(ctx, prescriptionId, verificationCode, u.prescriptionDocumentHelper.Sign(*document))
+	if err != nil {
+		return apperror.InternalServerError(err)
+	}
+
+	return nil
 }
@@ -0,0 +1,77 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"max-health/entity"
+)
+
+type auditActorKey struct{}
+
+func ContextWithAuditActor(ctx context.Context, actor entity.AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+func AuditActorFromContext(ctx context.Context) (entity.AuditActor, bool) {
+	actor, ok := ctx.Value(auditActorKey{}).(entity.AuditActor)
+	return actor, ok
+}
+
+func AuditDiff(before, after any) ([]byte, []byte, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if beforeFields != nil && afterFields != nil {
+		for key, value := range beforeFields {
+			if afterValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, afterValue) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+	}
+
+	beforeJson, err := marshalAuditFields(beforeFields)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterJson, err := marshalAuditFields(afterFields)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return beforeJson, afterJson, nil
+}
+
+func auditFields(value any) (map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]any{}
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func marshalAuditFields(fields map[string]any) ([]byte, error) {
+	if fields == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,89 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"max-health/appconstant"
+	"max-health/config"
+)
+
+type CentrifugoPublisher interface {
+	Publish(ctx context.Context, channel string, data []byte) error
+}
+
+type centrifugoPublisherImpl struct {
+	httpClient *http.Client
+	apiUrl     string
+	apiKey     string
+}
+
+func NewCentrifugoPublisherImpl(config *config.Config) centrifugoPublisherImpl {
+	return centrifugoPublisherImpl{
+		httpClient: &http.Client{Timeout: appconstant.CentrifugoHttpTimeout},
+		apiUrl:     config.CentrifugoApiUrl,
+		apiKey:     config.CentrifugoApiKey,
+	}
+}
+
+func (p *centrifugoPublisherImpl) Publish(ctx context.Context, channel string, data []byte) error {
+	if p.apiUrl == "" {
+		return nil
+	}
+
+	body, err := json.Marshal(struct {
+		Channel string          `json:"channel"`
+		Data    json.RawMessage `json:"data"`
+	}{
+		Channel: channel,
+		Data:    data,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.apiUrl+appconstant.CentrifugoPublishPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(appconstant.CentrifugoApiKeyHeader, p.apiKey)
+
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, appconstant.CentrifugoMaxResponseBytes))
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("centrifugo publish returned %d", res.StatusCode)
+	}
+
+	var result struct {
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(resBody, &result); err != nil {
+		return err
+	}
+	if result.Error != nil {
+		return fmt.Errorf("centrifugo publish failed: %d %s", result.Error.Code, result.Error.Message)
+	}
+
+	return nil
+}
+
+func NotificationChannel(accountId int64) string {
+	return fmt.Sprintf(appconstant.NotificationChannelFormat, accountId)
+}
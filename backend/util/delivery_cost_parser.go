@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -8,29 +9,54 @@ import (
 	"os"
 	"strings"
 
+	"max-health/appconstant"
 	"max-health/entity"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
 )
 
-func GetUnofficialDelivery(origin int64, destination int64, weight int64, courier string) ([]entity.CourierOption, error) {
-	services := []entity.CourierOption{}
+func GetUnofficialDelivery(ctx context.Context, origin int64, destination int64, weight int64, courier string) (services []entity.CourierOption, err error) {
+	ctx, span := StartSpan(ctx, appconstant.SpanRajaOngkirCost,
+		attribute.Int64("rajaongkir.origin", origin),
+		attribute.Int64("rajaongkir.destination", destination),
+		attribute.Int64("rajaongkir.weight", weight),
+		attribute.String("rajaongkir.courier", courier),
+	)
+	defer func() { EndSpan(span, err) }()
+
+	services = []entity.CourierOption{}
 	url := "https://api.rajaongkir.com/starter/cost"
 
 	param := fmt.Sprintf("origin=%d&destination=%d&weight=%d&courier=%s", origin, destination, weight, courier)
 	payload := strings.NewReader(param)
-	req, _ := http.NewRequest("POST", url, payload)
+	req, _ := http.NewRequestWithContext(ctx, "POST", url, payload)
 
 	key := os.Getenv("RAJA_ONGKIR_API_KEY")
 	req.Header.Add("key", key)
 	req.Header.Add("content-type", "application/x-www-form-urlencoded")
 
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
+	res, requestErr := http.DefaultClient.Do(req)
+	if requestErr != nil {
+		span.RecordError(requestErr)
+		LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"error":   requestErr.Error(),
+			"courier": courier,
+		}).Warn("rajaongkir cost request failed")
 		return services, nil
 	}
 
 	defer res.Body.Close()
-	body, err := ioutil.ReadAll(res.Body)
-	if err != nil {
+	span.SetAttributes(semconv.HTTPResponseStatusCode(res.StatusCode))
+
+	body, readErr := ioutil.ReadAll(res.Body)
+	if readErr != nil {
+		span.RecordError(readErr)
+		LoggerFromContext(ctx).WithFields(logrus.Fields{
+			"error":   readErr.Error(),
+			"courier": courier,
+		}).Warn("rajaongkir cost response could not be read")
 		return services, nil
 	}
 
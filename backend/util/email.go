@@ -2,75 +2,168 @@ package util
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
-	"html/template"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"max-health/appconstant"
 	"max-health/config"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
-type EmailHelper interface {
-	AddRequest(to []string, subject string)
-	CreateBody(emailTemplate string, data interface{}) error
-	SendEmail() error
+type EmailMessage struct {
+	From     string
+	To       []string
+	Subject  string
+	HtmlBody string
+	TextBody string
 }
 
-type emailHelperIpl struct {
-	config       config.Config
-	emailRequest emailRequest
+type EmailSender interface {
+	Send(ctx context.Context, message EmailMessage) error
 }
 
-func NewEmailHelperIpl(config *config.Config) emailHelperIpl {
-	return emailHelperIpl{
-		config: *config,
+func NewEmailSender(config *config.Config) (EmailSender, error) {
+	switch config.EmailMode {
+	case appconstant.EmailModeSmtp:
+		return &smtpEmailSender{
+			config: *config,
+		}, nil
+	case appconstant.EmailModeSink:
+		if config.EmailSinkDir == "" {
+			return nil, fmt.Errorf("EMAIL_SINK_DIR is required when EMAIL_MODE is %s", appconstant.EmailModeSink)
+		}
+		if err := os.MkdirAll(config.EmailSinkDir, 0o755); err != nil {
+			return nil, err
+		}
+		return &sinkEmailSender{
+			dir: config.EmailSinkDir,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown EMAIL_MODE %q", config.EmailMode)
 	}
 }
 
-type emailRequest struct {
-	from    string
-	to      []string
-	subject string
-	body    string
+type smtpEmailSender struct {
+	config config.Config
 }
 
-func (eh *emailHelperIpl) AddRequest(to []string, subject string) {
-	eh.emailRequest = emailRequest{
-		from:    eh.config.SendEmailUsername,
-		to:      to,
-		subject: subject,
+func (s *smtpEmailSender) Send(ctx context.Context, message EmailMessage) (err error) {
+	_, span := StartSpan(ctx, appconstant.SpanSmtpSend,
+		attribute.String("smtp.host", s.config.SendEmailHost),
+		attribute.Int("smtp.recipients", len(message.To)),
+	)
+	defer func() { EndSpan(span, err) }()
+
+	msg, err := BuildEmailMessage(message)
+	if err != nil {
+		return err
 	}
-}
 
-func (eh *emailHelperIpl) SendEmail() error {
-	mime := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	subject := "Subject: " + eh.emailRequest.subject + "!\n"
-	msg := []byte(subject + mime + "\n" + eh.emailRequest.body)
+	addr := fmt.Sprintf("%s:%s", s.config.SendEmailHost, s.config.SendEmailPort)
 
-	addr := fmt.Sprintf("%s:%s", eh.config.SendEmailHost, eh.config.SendEmailPort)
+	var auth smtp.Auth
+	if s.config.SendEmailUsername != "" {
+		auth = smtp.PlainAuth(s.config.SendEmailIdentity, s.config.SendEmailUsername, s.config.SendEmailPassword, s.config.SendEmailHost)
+	}
 
-	auth := smtp.PlainAuth(eh.config.SendEmailIdentity, eh.config.SendEmailUsername, eh.config.SendEmailPassword, eh.config.SendEmailHost)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- smtp.SendMail(addr, auth, message.From, message.To, msg)
+	}()
 
-	if err := smtp.SendMail(addr, auth, eh.emailRequest.from, eh.emailRequest.to, msg); err != nil {
+	select {
+	case err := <-errCh:
 		return err
+	case <-ctx.Done():
+		return ctx.Err()
 	}
+}
 
-	return nil
+type sinkEmailSender struct {
+	dir string
 }
 
-func (eh *emailHelperIpl) CreateBody(emailTemplate string, data interface{}) error {
-	t, err := template.New("emailTemplate").Parse(emailTemplate)
+func (s *sinkEmailSender) Send(ctx context.Context, message EmailMessage) error {
+	msg, err := BuildEmailMessage(message)
 	if err != nil {
 		return err
 	}
 
+	fileName := fmt.Sprintf("%s_%s%s", time.Now().UTC().Format("20060102T150405.000000000"), strings.Join(message.To, "_"), appconstant.EmailSinkFileExtension)
+
+	return os.WriteFile(filepath.Join(s.dir, filepath.Base(fileName)), msg, 0o644)
+}
+
+func BuildEmailMessage(message EmailMessage) ([]byte, error) {
 	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
 
-	err = t.Execute(buf, data)
+	messageId, err := newEmailMessageId(message.From)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	eh.emailRequest.body = buf.String()
+	header := []string{
+		"From: " + message.From,
+		"To: " + strings.Join(message.To, ", "),
+		"Subject: " + mime.QEncoding.Encode("UTF-8", message.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + messageId,
+		"MIME-Version: 1.0",
+		fmt.Sprintf("Content-Type: multipart/alternative; boundary=%q", writer.Boundary()),
+	}
+	buf.WriteString(strings.Join(header, "\r\n") + "\r\n\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{contentType: "text/plain; charset=\"UTF-8\"", body: message.TextBody},
+		{contentType: "text/html; charset=\"UTF-8\"", body: message.HtmlBody},
+	}
+
+	for _, p := range parts {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := part.Write([]byte(p.body)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func newEmailMessageId(from string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domain = from[at+1:]
+	}
 
-	return nil
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain), nil
 }
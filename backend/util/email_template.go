@@ -0,0 +1,139 @@
+package util
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"max-health/appconstant"
+	"max-health/config"
+)
+
+//go:embed email_templates
+var embeddedEmailTemplates embed.FS
+
+type EmailTemplateRenderer interface {
+	Render(emailType, locale string, data any) (*EmailMessage, error)
+}
+
+type emailTemplate struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+type emailTemplateRendererImpl struct {
+	templates map[string]emailTemplate
+}
+
+func NewEmailTemplateRendererImpl(config *config.Config) (emailTemplateRendererImpl, error) {
+	var templateFS fs.FS = os.DirFS(config.EmailTemplatesDir)
+	if config.EmailTemplatesDir == "" {
+		sub, err := fs.Sub(embeddedEmailTemplates, "email_templates")
+		if err != nil {
+			return emailTemplateRendererImpl{}, err
+		}
+		templateFS = sub
+	}
+
+	templates := map[string]emailTemplate{}
+
+	htmlFiles, err := fs.Glob(templateFS, "*/*.html")
+	if err != nil {
+		return emailTemplateRendererImpl{}, err
+	}
+
+	for _, htmlFile := range htmlFiles {
+		emailType := path.Dir(htmlFile)
+		locale := strings.TrimSuffix(path.Base(htmlFile), ".html")
+		textFile := path.Join(emailType, locale+".txt")
+
+		htmlTemplate, err := htmltemplate.ParseFS(templateFS, htmlFile)
+		if err != nil {
+			return emailTemplateRendererImpl{}, err
+		}
+
+		textTemplate, err := texttemplate.ParseFS(templateFS, textFile)
+		if err != nil {
+			return emailTemplateRendererImpl{}, err
+		}
+
+		if textTemplate.Lookup(appconstant.EmailSubjectTemplate) == nil {
+			return emailTemplateRendererImpl{}, fmt.Errorf("email template %s has no %q block", textFile, appconstant.EmailSubjectTemplate)
+		}
+
+		templates[emailTemplateKey(emailType, locale)] = emailTemplate{
+			html: htmlTemplate,
+			text: textTemplate,
+		}
+	}
+
+	for _, emailType := range []string{
+		appconstant.EmailTypeVerification,
+		appconstant.EmailTypeResetPassword,
+		appconstant.EmailTypeCredentials,
+		appconstant.EmailTypeAccountLocked,
+		appconstant.EmailTypeDoctorApproved,
+		appconstant.EmailTypeDoctorRejected,
+	} {
+		if _, ok := templates[emailTemplateKey(emailType, appconstant.EmailLocaleDefault)]; !ok {
+			return emailTemplateRendererImpl{}, fmt.Errorf("missing %s template for email type %s", appconstant.EmailLocaleDefault, emailType)
+		}
+	}
+
+	return emailTemplateRendererImpl{
+		templates: templates,
+	}, nil
+}
+
+func (r *emailTemplateRendererImpl) Render(emailType, locale string, data any) (*EmailMessage, error) {
+	tmpl, ok := r.templates[emailTemplateKey(emailType, locale)]
+	if !ok {
+		tmpl, ok = r.templates[emailTemplateKey(emailType, appconstant.EmailLocaleDefault)]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown email type %s", emailType)
+	}
+
+	subject := new(bytes.Buffer)
+	if err := tmpl.text.ExecuteTemplate(subject, appconstant.EmailSubjectTemplate, data); err != nil {
+		return nil, err
+	}
+
+	text := new(bytes.Buffer)
+	if err := tmpl.text.Execute(text, data); err != nil {
+		return nil, err
+	}
+
+	html := new(bytes.Buffer)
+	if err := tmpl.html.Execute(html, data); err != nil {
+		return nil, err
+	}
+
+	return &EmailMessage{
+		Subject:  strings.TrimSpace(subject.String()),
+		TextBody: text.String(),
+		HtmlBody: html.String(),
+	}, nil
+}
+
+func NormalizeEmailLocale(acceptLanguage string) string {
+	for _, tag := range strings.Split(acceptLanguage, ",") {
+		tag = strings.TrimSpace(strings.SplitN(tag, ";", 2)[0])
+		language := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+		if appconstant.EmailLocales[language] {
+			return language
+		}
+	}
+
+	return appconstant.EmailLocaleDefault
+}
+
+func emailTemplateKey(emailType, locale string) string {
+	return emailType + "/" + locale
+}
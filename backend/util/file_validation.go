@@ -3,7 +3,9 @@ package util
 import (
 	"errors"
 	"fmt"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"strings"
 
 	"max-health/appconstant"
@@ -43,3 +45,26 @@ func isFileValidFormat(formatOptions []string, format string) bool {
 	}
 	return false
 }
+
+func ValidateFileContentType(file multipart.File, format string, contentTypes map[string]string) (string, error) {
+	contentType, ok := contentTypes[format]
+	if !ok {
+		return "", errors.New(appconstant.MsgInvalidFileType)
+	}
+
+	buffer := make([]byte, appconstant.FileSniffLength)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	if http.DetectContentType(buffer[:n]) != contentType {
+		return "", errors.New(appconstant.MsgInvalidFileType)
+	}
+
+	return contentType, nil
+}
@@ -297,3 +297,88 @@ func ValidateGetReportQuery(query GetReportQuery) (*ValidatedGetReportQuery, err
 
 	return &validatedGetReportQuery, nil
 }
+
+type GetAuditLogQuery struct {
+	ActorAccountId *int64  `form:"actor_account_id" validate:"omitempty,min=1"`
+	ActorRole      *string `form:"actor_role" validate:"omitempty"`
+	Action         *string `form:"action" validate:"omitempty"`
+	EntityType     *string `form:"entity_type" validate:"omitempty"`
+	EntityId       *int64  `form:"entity_id" validate:"omitempty,min=1"`
+	RequestId      *string `form:"request_id" validate:"omitempty"`
+	MinDate        *string `form:"min_date" validate:"omitempty,datetime=2006-01-02"`
+	MaxDate        *string `form:"max_date" validate:"omitempty,datetime=2006-01-02"`
+	Limit          *string `form:"limit" validate:"omitempty,number"`
+	Page           *string `form:"page" validate:"omitempty,number"`
+}
+
+type ValidatedGetAuditLogQuery struct {
+	ActorAccountId *int64
+	ActorRole      *string
+	Action         *string
+	EntityType     *string
+	EntityId       *int64
+	RequestId      *string
+	MinDate        *string
+	MaxDate        *string
+	Limit          int
+	Page           int
+}
+
+func ValidateGetAuditLogQuery(query GetAuditLogQuery) (*ValidatedGetAuditLogQuery, error) {
+	validatedGetAuditLogQuery := ValidatedGetAuditLogQuery{
+		ActorAccountId: query.ActorAccountId,
+		ActorRole:      query.ActorRole,
+		Action:         query.Action,
+		EntityType:     query.EntityType,
+		EntityId:       query.EntityId,
+		RequestId:      query.RequestId,
+		Limit:          10,
+		Page:           1,
+	}
+
+	var minDate, maxDate time.Time
+	var err error
+
+	if query.MinDate != nil {
+		minDate, err = time.Parse("2006-01-02", *query.MinDate)
+		if err != nil {
+			return nil, apperror.InvalidMinDateError()
+		}
+		minDateString := minDate.Format("2006-01-02 15:04:05")
+		validatedGetAuditLogQuery.MinDate = &minDateString
+	}
+
+	if query.MaxDate != nil {
+		maxDate, err = time.Parse("2006-01-02", *query.MaxDate)
+		if err != nil {
+			return nil, apperror.InvalidMaxDateError()
+		}
+		maxDate = maxDate.Add(time.Hour*time.Duration(23) +
+			time.Minute*time.Duration(59) +
+			time.Second*time.Duration(59))
+		maxDateString := maxDate.Format("2006-01-02 15:04:05")
+		validatedGetAuditLogQuery.MaxDate = &maxDateString
+	}
+
+	if query.MinDate != nil && query.MaxDate != nil && minDate.After(maxDate) {
+		return nil, apperror.InvalidMinDateError()
+	}
+
+	if query.Limit != nil {
+		limitInt, err := strconv.Atoi(*query.Limit)
+		if err != nil || limitInt < 1 {
+			return nil, apperror.InvalidLimitError()
+		}
+		validatedGetAuditLogQuery.Limit = limitInt
+	}
+
+	if query.Page != nil {
+		pageInt, err := strconv.Atoi(*query.Page)
+		if err != nil || pageInt < 1 {
+			return nil, apperror.InvalidPageError()
+		}
+		validatedGetAuditLogQuery.Page = pageInt
+	}
+
+	return &validatedGetAuditLogQuery, nil
+}
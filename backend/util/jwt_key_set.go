@@ -0,0 +1,215 @@
+package util
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"max-health/appconstant"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type JwtKey struct {
+	Id         string
+	Method     jwt.SigningMethod
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+}
+
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+type Jwks struct {
+	Keys []Jwk `json:"keys"`
+}
+
+type JwtKeySet struct {
+	activeKeyId string
+	keyIds      []string
+	keys        map[string]JwtKey
+}
+
+func NewJwtKeySet(activeKeyId string, keys ...JwtKey) (*JwtKeySet, error) {
+	keySet := JwtKeySet{
+		activeKeyId: activeKeyId,
+		keys:        map[string]JwtKey{},
+	}
+
+	for _, key := range keys {
+		if _, exists := keySet.keys[key.Id]; exists {
+			return nil, fmt.Errorf("duplicate jwt key id %q", key.Id)
+		}
+		keySet.keyIds = append(keySet.keyIds, key.Id)
+		keySet.keys[key.Id] = key
+	}
+
+	activeKey, ok := keySet.keys[activeKeyId]
+	if !ok {
+		return nil, fmt.Errorf("active jwt key %q not found", activeKeyId)
+	}
+	if activeKey.PrivateKey == nil {
+		return nil, fmt.Errorf("active jwt key %q has no private key", activeKeyId)
+	}
+
+	return &keySet, nil
+}
+
+func LoadJwtKeySet(keysDir string, activeKeyId string) (*JwtKeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(keysDir, "*"+appconstant.JwtKeyFileExtension))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	keys := []JwtKey{}
+	for _, path := range paths {
+		keyId := strings.TrimSuffix(filepath.Base(path), appconstant.JwtKeyFileExtension)
+
+		key, err := loadJwtKey(path, keyId)
+		if err != nil {
+			return nil, fmt.Errorf("loading jwt key %q: %w", keyId, err)
+		}
+		keys = append(keys, *key)
+	}
+
+	return NewJwtKeySet(activeKeyId, keys...)
+}
+
+func NewJwtKey(keyId string, privateKey crypto.Signer, publicKey crypto.PublicKey) (*JwtKey, error) {
+	if privateKey != nil {
+		publicKey = privateKey.Public()
+	}
+
+	key := JwtKey{
+		Id:         keyId,
+		PrivateKey: privateKey,
+		PublicKey:  publicKey,
+	}
+
+	switch publicKey := publicKey.(type) {
+	case *rsa.PublicKey:
+		if publicKey.N.BitLen() < appconstant.JwtMinRsaKeyBits {
+			return nil, fmt.Errorf("rsa key must be at least %d bits", appconstant.JwtMinRsaKeyBits)
+		}
+		key.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		key.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("unsupported key type, use RSA or Ed25519")
+	}
+
+	return &key, nil
+}
+
+func loadJwtKey(path string, keyId string) (*JwtKey, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		privateKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := privateKey.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("private key cannot sign")
+		}
+		return NewJwtKey(keyId, signer, nil)
+	case "RSA PRIVATE KEY":
+		privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewJwtKey(keyId, privateKey, nil)
+	case "PUBLIC KEY":
+		publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return NewJwtKey(keyId, nil, publicKey)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+func (ks *JwtKeySet) SigningKey() JwtKey {
+	return ks.keys[ks.activeKeyId]
+}
+
+func (ks *JwtKeySet) VerificationKey(keyId string) (*JwtKey, bool) {
+	key, ok := ks.keys[keyId]
+	if !ok {
+		return nil, false
+	}
+
+	return &key, true
+}
+
+func (ks *JwtKeySet) Algorithms() []string {
+	algorithms := []string{}
+	seen := map[string]bool{}
+	for _, keyId := range ks.keyIds {
+		algorithm := ks.keys[keyId].Method.Alg()
+		if !seen[algorithm] {
+			seen[algorithm] = true
+			algorithms = append(algorithms, algorithm)
+		}
+	}
+
+	return algorithms
+}
+
+func (ks *JwtKeySet) Jwks() Jwks {
+	jwks := Jwks{Keys: []Jwk{}}
+	for _, keyId := range ks.keyIds {
+		key := ks.keys[keyId]
+
+		jwk := Jwk{
+			Kid: key.Id,
+			Use: appconstant.JwtKeyUse,
+			Alg: key.Method.Alg(),
+		}
+
+		switch publicKey := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
@@ -0,0 +1,22 @@
+package util
+
+import (
+	"context"
+
+	"max-health/appconstant"
+)
+
+type localeKey struct{}
+
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+func LocaleFromContext(ctx context.Context) string {
+	locale, ok := ctx.Value(localeKey{}).(string)
+	if !ok || locale == "" {
+		return appconstant.EmailLocaleDefault
+	}
+
+	return locale
+}
@@ -1,14 +1,63 @@
 package util
 
 import (
+	"context"
+	"time"
+
+	"max-health/appconstant"
+
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 )
 
+type loggerKey struct{}
+
 func NewLogger() *logrus.Logger {
-	log := logrus.New()
-	log.SetFormatter(&logrus.TextFormatter{
-		FullTimestamp:    true,
-		QuoteEmptyFields: true,
+	log := logrus.StandardLogger()
+	log.SetFormatter(&logrus.JSONFormatter{
+		TimestampFormat: time.RFC3339Nano,
 	})
+	log.ReplaceHooks(logrus.LevelHooks{})
+	log.AddHook(traceContextHook{})
 	return log
 }
+
+func ContextWithLogger(ctx context.Context, entry *logrus.Entry) context.Context {
+	return context.WithValue(ctx, loggerKey{}, entry)
+}
+
+func ContextWithLogFields(ctx context.Context, fields logrus.Fields) context.Context {
+	entry, ok := ctx.Value(loggerKey{}).(*logrus.Entry)
+	if !ok {
+		entry = logrus.NewEntry(logrus.StandardLogger())
+	}
+	return ContextWithLogger(ctx, entry.WithFields(fields))
+}
+
+func LoggerFromContext(ctx context.Context) *logrus.Entry {
+	entry, ok := ctx.Value(loggerKey{}).(*logrus.Entry)
+	if !ok {
+		entry = logrus.NewEntry(logrus.StandardLogger())
+	}
+	return entry.WithContext(ctx)
+}
+
+type traceContextHook struct{}
+
+func (traceContextHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (traceContextHook) Fire(entry *logrus.Entry) error {
+	if entry.Context == nil {
+		return nil
+	}
+
+	spanContext := trace.SpanContextFromContext(entry.Context)
+	if spanContext.IsValid() {
+		entry.Data[appconstant.LogFieldTraceId] = spanContext.TraceID().String()
+		entry.Data[appconstant.LogFieldSpanId] = spanContext.SpanID().String()
+	}
+
+	return nil
+}
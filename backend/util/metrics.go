@@ -0,0 +1,122 @@
+package util
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"max-health/appconstant"
+)
+
+type httpMetricKey struct {
+	method string
+	route  string
+	status int
+}
+
+type httpMetricValue struct {
+	count           int64
+	durationSeconds float64
+}
+
+type HttpMetrics struct {
+	mu        sync.Mutex
+	requests  map[httpMetricKey]*httpMetricValue
+	startedAt time.Time
+}
+
+func NewHttpMetrics() *HttpMetrics {
+	return &HttpMetrics{
+		requests:  map[httpMetricKey]*httpMetricValue{},
+		startedAt: time.Now(),
+	}
+}
+
+func (m *HttpMetrics) Observe(method, route string, status int, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := httpMetricKey{method: method, route: route, status: status}
+	value, ok := m.requests[key]
+	if !ok {
+		value = &httpMetricValue{}
+		m.requests[key] = value
+	}
+	value.count++
+	value.durationSeconds += duration.Seconds()
+}
+
+func (m *HttpMetrics) Uptime() time.Duration {
+	return time.Since(m.startedAt)
+}
+
+func (m *HttpMetrics) WritePrometheus(w io.Writer) {
+	m.mu.Lock()
+	keys := make([]httpMetricKey, 0, len(m.requests))
+	values := make(map[httpMetricKey]httpMetricValue, len(m.requests))
+	for key, value := range m.requests {
+		keys = append(keys, key)
+		values[key] = *value
+	}
+	m.mu.Unlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].status < keys[j].status
+	})
+
+	requestsName := appconstant.MetricsNamespace + "_http_requests_total"
+	durationName := appconstant.MetricsNamespace + "_http_request_duration_seconds_sum"
+
+	fmt.Fprintf(w, "# TYPE %s counter\n", requestsName)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s{%s} %d\n", requestsName, httpMetricLabels(key), values[key].count)
+	}
+
+	fmt.Fprintf(w, "# TYPE %s counter\n", durationName)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s{%s} %g\n", durationName, httpMetricLabels(key), values[key].durationSeconds)
+	}
+
+	writeGauge(w, appconstant.MetricsNamespace+"_uptime_seconds", m.Uptime().Seconds())
+}
+
+func WriteRuntimeMetrics(w io.Writer) {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	writeGauge(w, "go_goroutines", float64(runtime.NumGoroutine()))
+	writeGauge(w, "go_memstats_alloc_bytes", float64(memStats.Alloc))
+	writeGauge(w, "go_memstats_heap_inuse_bytes", float64(memStats.HeapInuse))
+	writeGauge(w, "go_memstats_sys_bytes", float64(memStats.Sys))
+	writeGauge(w, "go_gc_cycles_total", float64(memStats.NumGC))
+}
+
+func WriteDbMetrics(w io.Writer, stats sql.DBStats) {
+	prefix := appconstant.MetricsNamespace + "_db_"
+
+	writeGauge(w, prefix+"max_open_connections", float64(stats.MaxOpenConnections))
+	writeGauge(w, prefix+"open_connections", float64(stats.OpenConnections))
+	writeGauge(w, prefix+"in_use_connections", float64(stats.InUse))
+	writeGauge(w, prefix+"idle_connections", float64(stats.Idle))
+	writeGauge(w, prefix+"wait_count", float64(stats.WaitCount))
+	writeGauge(w, prefix+"wait_duration_seconds", stats.WaitDuration.Seconds())
+}
+
+func writeGauge(w io.Writer, name string, value float64) {
+	fmt.Fprintf(w, "# TYPE %s gauge\n%s %g\n", name, name, value)
+}
+
+func httpMetricLabels(key httpMetricKey) string {
+	return fmt.Sprintf("method=%s,route=%s,status=\"%d\"", strconv.Quote(key.method), strconv.Quote(key.route), key.status)
+}
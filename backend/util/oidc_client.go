@@ -0,0 +1,438 @@
+package util
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/config"
+	"max-health/entity"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrOidcProviderNotFound = errors.New("oidc provider not found")
+
+type OidcClient interface {
+	Providers() []string
+	NewLoginState(provider string) (*entity.OidcLoginState, error)
+	AuthorizationUrl(ctx context.Context, loginState entity.OidcLoginState) (string, error)
+	Authenticate(ctx context.Context, loginState entity.OidcLoginState, code string) (*entity.OidcIdentity, error)
+}
+
+type oidcDiscovery struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	JwksUri                           string   `json:"jwks_uri"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+}
+
+type oidcTokenResponse struct {
+	IdToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type oidcJwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type oidcIdTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Azp           string `json:"azp"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+}
+
+type oidcProvider struct {
+	config        config.OidcProviderConfig
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	discoveredAt  time.Time
+	keys          map[string]crypto.PublicKey
+	keysFetchedAt time.Time
+}
+
+type oidcClientImpl struct {
+	httpClient    *http.Client
+	providerNames []string
+	providers     map[string]*oidcProvider
+}
+
+func NewOidcClientImpl(config *config.Config) oidcClientImpl {
+	client := oidcClientImpl{
+		httpClient:    &http.Client{Timeout: appconstant.OidcHttpTimeout},
+		providerNames: []string{},
+		providers:     map[string]*oidcProvider{},
+	}
+
+	for _, providerConfig := range config.OidcProviders {
+		client.providerNames = append(client.providerNames, providerConfig.Name)
+		client.providers[providerConfig.Name] = &oidcProvider{config: providerConfig}
+	}
+
+	return client
+}
+
+func (c *oidcClientImpl) Providers() []string {
+	return c.providerNames
+}
+
+func (c *oidcClientImpl) NewLoginState(provider string) (*entity.OidcLoginState, error) {
+	if _, ok := c.providers[provider]; !ok {
+		return nil, ErrOidcProviderNotFound
+	}
+
+	values := make([]string, 3)
+	for i := range values {
+		value, err := GenerateSecureCode(appconstant.OidcSecureCodeLength)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+
+	return &entity.OidcLoginState{
+		Provider:     provider,
+		State:        values[0],
+		Nonce:        values[1],
+		CodeVerifier: values[2],
+	}, nil
+}
+
+func (c *oidcClientImpl) AuthorizationUrl(ctx context.Context, loginState entity.OidcLoginState) (string, error) {
+	provider, discovery, err := c.discover(ctx, loginState.Provider)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationUrl, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	codeChallenge := sha256.Sum256([]byte(loginState.CodeVerifier))
+
+	query := authorizationUrl.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", provider.config.ClientId)
+	query.Set("redirect_uri", provider.config.RedirectUrl)
+	query.Set("scope", strings.Join(provider.config.Scopes, " "))
+	query.Set("state", loginState.State)
+	query.Set("nonce", loginState.Nonce)
+	query.Set("code_challenge", base64.RawURLEncoding.EncodeToString(codeChallenge[:]))
+	query.Set("code_challenge_method", appconstant.OidcCodeChallengeMethod)
+	authorizationUrl.RawQuery = query.Encode()
+
+	return authorizationUrl.String(), nil
+}
+
+func (c *oidcClientImpl) Authenticate(ctx context.Context, loginState entity.OidcLoginState, code string) (*entity.OidcIdentity, error) {
+	provider, discovery, err := c.discover(ctx, loginState.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	rawIdToken, err := c.exchangeCode(ctx, provider, discovery, code, loginState.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := oidcIdTokenClaims{}
+	_, err = jwt.ParseWithClaims(rawIdToken, &claims, func(token *jwt.Token) (interface{}, error) {
+		keyId, _ := token.Header["kid"].(string)
+		return c.publicKey(ctx, provider, discovery, keyId)
+	}, jwt.WithValidMethods(appconstant.OidcIdTokenAlgorithms),
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(provider.config.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(appconstant.OidcClockSkew),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("id token has no subject")
+	}
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(loginState.Nonce)) != 1 {
+		return nil, errors.New("id token nonce mismatch")
+	}
+	if (len(claims.Audience) > 1 || claims.Azp != "") && claims.Azp != provider.config.ClientId {
+		return nil, errors.New("id token authorized party mismatch")
+	}
+
+	return &entity.OidcIdentity{
+		Provider:        provider.config.Name,
+		Subject:         claims.Subject,
+		Email:           strings.ToLower(strings.TrimSpace(claims.Email)),
+		IsEmailVerified: isOidcClaimTrue(claims.EmailVerified),
+		Name:            strings.TrimSpace(claims.Name),
+	}, nil
+}
+
+func (c *oidcClientImpl) discover(ctx context.Context, providerName string) (*oidcProvider, *oidcDiscovery, error) {
+	provider, ok := c.providers[providerName]
+	if !ok {
+		return nil, nil, ErrOidcProviderNotFound
+	}
+
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	if provider.discovery != nil && time.Since(provider.discoveredAt) < appconstant.OidcDiscoveryCacheDuration {
+		return provider, provider.discovery, nil
+	}
+
+	discovery := oidcDiscovery{}
+	err := c.getJson(ctx, provider.config.Issuer+appconstant.OidcDiscoveryPath, &discovery)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != provider.config.Issuer {
+		return nil, nil, fmt.Errorf("discovery issuer %q does not match %q", discovery.Issuer, provider.config.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksUri == "" {
+		return nil, nil, errors.New("incomplete discovery document")
+	}
+
+	provider.discovery = &discovery
+	provider.discoveredAt = time.Now()
+	provider.keys = nil
+
+	return provider, provider.discovery, nil
+}
+
+func (c *oidcClientImpl) exchangeCode(ctx context.Context, provider *oidcProvider, discovery *oidcDiscovery, code string, codeVerifier string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", provider.config.RedirectUrl)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", provider.config.ClientId)
+
+	useBasicAuth := provider.config.ClientSecret != "" && supportsOidcBasicAuth(discovery.TokenEndpointAuthMethodsSupported)
+	if provider.config.ClientSecret != "" && !useBasicAuth {
+		form.Set("client_secret", provider.config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if useBasicAuth {
+		req.SetBasicAuth(url.QueryEscape(provider.config.ClientId), url.QueryEscape(provider.config.ClientSecret))
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	tokenResponse := oidcTokenResponse{}
+	err = json.NewDecoder(io.LimitReader(res.Body, appconstant.OidcMaxResponseBytes)).Decode(&tokenResponse)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned %d: %s %s", res.StatusCode, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+	if tokenResponse.IdToken == "" {
+		return "", errors.New("token response has no id token")
+	}
+
+	return tokenResponse.IdToken, nil
+}
+
+func (c *oidcClientImpl) publicKey(ctx context.Context, provider *oidcProvider, discovery *oidcDiscovery, keyId string) (crypto.PublicKey, error) {
+	provider.mu.Lock()
+	defer provider.mu.Unlock()
+
+	key, ok := findOidcKey(provider.keys, keyId)
+	if ok {
+		return key, nil
+	}
+	if !provider.keysFetchedAt.IsZero() && time.Since(provider.keysFetchedAt) < appconstant.OidcKeysRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %q", keyId)
+	}
+
+	jwks := struct {
+		Keys []oidcJwk `json:"keys"`
+	}{}
+	err := c.getJson(ctx, discovery.JwksUri, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != appconstant.JwtKeyUse {
+			continue
+		}
+
+		publicKey, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = publicKey
+	}
+
+	provider.keys = keys
+	provider.keysFetchedAt = time.Now()
+
+	key, ok = findOidcKey(provider.keys, keyId)
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", keyId)
+	}
+
+	return key, nil
+}
+
+func (c *oidcClientImpl) getJson(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %d", url, res.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(res.Body, appconstant.OidcMaxResponseBytes)).Decode(out)
+}
+
+func (k oidcJwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeOidcBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeOidcBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeOidcBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeOidcBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func findOidcKey(keys map[string]crypto.PublicKey, keyId string) (crypto.PublicKey, bool) {
+	if keyId == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+
+	key, ok := keys[keyId]
+	return key, ok
+}
+
+func decodeOidcBigInt(value string) (*big.Int, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(decoded), nil
+}
+
+func supportsOidcBasicAuth(methods []string) bool {
+	if len(methods) == 0 {
+		return true
+	}
+
+	for _, method := range methods {
+		if method == appconstant.OidcClientSecretBasic {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isOidcClaimTrue(value any) bool {
+	switch value := value.(type) {
+	case bool:
+		return value
+	case string:
+		return strings.EqualFold(value, "true")
+	default:
+		return false
+	}
+}
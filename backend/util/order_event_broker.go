@@ -0,0 +1,155 @@
+package util
+
+import (
+	"sync"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/entity"
+)
+
+type OrderEventPublisher interface {
+	Publish(events ...entity.OrderEvent)
+}
+
+type OrderEventSubscriber interface {
+	Subscribe(lastEventId int64, filter func(entity.OrderEvent) bool) *OrderEventSubscription
+}
+
+type OrderEventSubscription struct {
+	Backlog []entity.OrderEvent
+	Resync  bool
+	Events  <-chan entity.OrderEvent
+
+	broker     *InMemoryOrderEventBroker
+	subscriber *orderEventSubscriber
+}
+
+func (s *OrderEventSubscription) Close() {
+	if s.subscriber != nil {
+		s.broker.unsubscribe(s.subscriber)
+	}
+}
+
+type orderEventSubscriber struct {
+	filter func(entity.OrderEvent) bool
+	events chan entity.OrderEvent
+}
+
+type InMemoryOrderEventBroker struct {
+	mu          sync.Mutex
+	lastId      int64
+	history     []entity.OrderEvent
+	subscribers map[*orderEventSubscriber]struct{}
+	closed      bool
+}
+
+func NewInMemoryOrderEventBroker() *InMemoryOrderEventBroker {
+	return &InMemoryOrderEventBroker{
+		lastId:      time.Now().UnixMicro(),
+		history:     []entity.OrderEvent{},
+		subscribers: map[*orderEventSubscriber]struct{}{},
+	}
+}
+
+func (b *InMemoryOrderEventBroker) Publish(events ...entity.OrderEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, event := range events {
+		b.lastId++
+		event.Id = b.lastId
+		event.CreatedAt = time.Now()
+
+		b.history = append(b.history, event)
+		if len(b.history) > appconstant.OrderEventHistorySize {
+			b.history = b.history[len(b.history)-appconstant.OrderEventHistorySize:]
+		}
+
+		for subscriber := range b.subscribers {
+			if !subscriber.filter(event) {
+				continue
+			}
+
+			select {
+			case subscriber.events <- event:
+			default:
+				delete(b.subscribers, subscriber)
+				close(subscriber.events)
+			}
+		}
+	}
+}
+
+func (b *InMemoryOrderEventBroker) Subscribe(lastEventId int64, filter func(entity.OrderEvent) bool) *OrderEventSubscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscription := &OrderEventSubscription{
+		Backlog: []entity.OrderEvent{},
+		broker:  b,
+	}
+
+	if lastEventId > 0 {
+		oldestId := b.lastId + 1
+		if len(b.history) > 0 {
+			oldestId = b.history[0].Id
+		}
+
+		if lastEventId > b.lastId || lastEventId < oldestId-1 {
+			subscription.Resync = true
+		} else {
+			for _, event := range b.history {
+				if event.Id > lastEventId && filter(event) {
+					subscription.Backlog = append(subscription.Backlog, event)
+				}
+			}
+		}
+	}
+
+	events := make(chan entity.OrderEvent, appconstant.OrderEventSubscriberBufferSize)
+	subscription.Events = events
+
+	if b.closed {
+		close(events)
+		return subscription
+	}
+
+	subscriber := &orderEventSubscriber{
+		filter: filter,
+		events: events,
+	}
+	b.subscribers[subscriber] = struct{}{}
+	subscription.subscriber = subscriber
+
+	return subscription
+}
+
+func (b *InMemoryOrderEventBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+	for subscriber := range b.subscribers {
+		delete(b.subscribers, subscriber)
+		close(subscriber.events)
+	}
+}
+
+func (b *InMemoryOrderEventBroker) unsubscribe(subscriber *orderEventSubscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[subscriber]; ok {
+		delete(b.subscribers, subscriber)
+		close(subscriber.events)
+	}
+}
@@ -0,0 +1,160 @@
+package util
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"max-health/appconstant"
+	"max-health/config"
+	"max-health/entity"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+type PrescriptionDocumentHelper interface {
+	Sign(document entity.PrescriptionDocument) string
+	Verify(document entity.PrescriptionDocument) bool
+	Render(document entity.PrescriptionDocument) ([]byte, error)
+}
+
+type prescriptionDocumentHelperImpl struct {
+	config config.Config
+}
+
+func NewPrescriptionDocumentHelperImpl(config *config.Config) prescriptionDocumentHelperImpl {
+	return prescriptionDocumentHelperImpl{
+		config: *config,
+	}
+}
+
+func (h *prescriptionDocumentHelperImpl) Sign(document entity.PrescriptionDocument) string {
+	mac := hmac.New(sha256.New, []byte(h.config.PrescriptionSecret))
+	mac.Write([]byte(canonicalPrescriptionDocument(document)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *prescriptionDocumentHelperImpl) Verify(document entity.PrescriptionDocument) bool {
+	if document.Signature == nil {
+		return false
+	}
+
+	return hmac.Equal([]byte(h.Sign(document)), []byte(*document.Signature))
+}
+
+func (h *prescriptionDocumentHelperImpl) Render(document entity.PrescriptionDocument) ([]byte, error) {
+	if document.VerificationCode == nil || document.Signature == nil {
+		return nil, fmt.Errorf("prescription %d has not been signed", document.PrescriptionId)
+	}
+
+	verificationUrl := h.verificationUrl(*document.VerificationCode)
+
+	qr, err := qrcode.Encode(verificationUrl, qrcode.Medium, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(appconstant.PrescriptionDocumentTitle, true)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, appconstant.PrescriptionDocumentTitle, "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("No. %d  -  Issued %s", document.PrescriptionId, document.IssuedAt.Format(appconstant.DocumentDateFormat)), "", 1, "C", false, 0, "")
+	pdf.CellFormat(0, 6, fmt.Sprintf("Valid until %s  -  Refills allowed: %d", document.ExpiredAt.Format(appconstant.DocumentDateFormat), document.RefillCount), "", 1, "C", false, 0, "")
+	pdf.Ln(6)
+
+	writeDocumentSection(pdf, "Patient")
+	writeDocumentRow(pdf, "Name", document.PatientName)
+	if document.PatientGender != nil {
+		writeDocumentRow(pdf, "Gender", *document.PatientGender)
+	}
+	if document.PatientDateOfBirth != nil {
+		writeDocumentRow(pdf, "Date of birth", document.PatientDateOfBirth.Format(appconstant.DocumentDateFormat))
+	}
+	pdf.Ln(4)
+
+	writeDocumentSection(pdf, "Doctor")
+	writeDocumentRow(pdf, "Name", document.DoctorName)
+	writeDocumentRow(pdf, "Specialization", document.DoctorSpecialization)
+	writeDocumentRow(pdf, "Certificate", document.DoctorCertificate)
+	pdf.Ln(4)
+
+	writeDocumentSection(pdf, "Prescribed drugs")
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(10, 7, "#", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(70, 7, "Drug", "1", 0, "L", false, 0, "")
+	pdf.CellFormat(20, 7, "Qty", "1", 0, "C", false, 0, "")
+	pdf.CellFormat(0, 7, "Note", "1", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	for i, prescriptionDrug := range document.PrescriptionDrugs {
+		pdf.CellFormat(10, 7, fmt.Sprintf("%d", i+1), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(70, 7, prescriptionDrug.Drug.Name, "1", 0, "L", false, 0, "")
+		pdf.CellFormat(20, 7, fmt.Sprintf("%d", prescriptionDrug.Quantity), "1", 0, "C", false, 0, "")
+		pdf.CellFormat(0, 7, prescriptionDrug.Note, "1", 1, "L", false, 0, "")
+	}
+	pdf.Ln(8)
+
+	qrY := pdf.GetY()
+	pdf.RegisterImageOptionsReader("qr", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+	pdf.ImageOptions("qr", 10, qrY, 40, 40, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	pdf.SetXY(55, qrY)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 6, "Verification code: "+*document.VerificationCode, "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.MultiCell(0, 5, "Scan the QR code or open "+verificationUrl+" to verify this prescription.", "", "L", false)
+	pdf.SetX(55)
+	pdf.MultiCell(0, 5, "Digital signature: "+*document.Signature, "", "L", false)
+
+	if pdf.Err() {
+		return nil, pdf.Error()
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = pdf.Output(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (h *prescriptionDocumentHelperImpl) verificationUrl(verificationCode string) string {
+	return fmt.Sprintf("%s/prescriptions/verify/%s", h.config.PublicBaseUrl, verificationCode)
+}
+
+func canonicalPrescriptionDocument(document entity.PrescriptionDocument) string {
+	var sb strings.Builder
+
+	verificationCode := ""
+	if document.VerificationCode != nil {
+		verificationCode = *document.VerificationCode
+	}
+
+	fmt.Fprintf(&sb, "%d|%s|%d|%d|%d", document.PrescriptionId, verificationCode, document.PatientAccountId, document.DoctorAccountId, document.IssuedAt.Unix())
+
+	for _, prescriptionDrug := range document.PrescriptionDrugs {
+		fmt.Fprintf(&sb, "|%d:%d:%s", prescriptionDrug.Drug.Id, prescriptionDrug.Quantity, prescriptionDrug.Note)
+	}
+
+	return sb.String()
+}
+
+func writeDocumentSection(pdf *fpdf.Fpdf, title string) {
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.CellFormat(0, 8, title, "B", 1, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+}
+
+func writeDocumentRow(pdf *fpdf.Fpdf, label, value string) {
+	pdf.CellFormat(40, 6, label, "", 0, "L", false, 0, "")
+	pdf.CellFormat(0, 6, value, "", 1, "L", false, 0, "")
+}
@@ -5,6 +5,8 @@ import (
 	"math/big"
 	"strconv"
 	"time"
+
+	"max-health/appconstant"
 )
 
 func GenerateRandomString() (string, error) {
@@ -29,3 +31,17 @@ func GenerateRandomString() (string, error) {
 
 	return randomString, nil
 }
+
+func GenerateSecureCode(length int) (string, error) {
+	b := make([]byte, length)
+	for i := range b {
+		index, err := rand.Int(rand.Reader, big.NewInt(int64(len(appconstant.CharSet))))
+		if err != nil {
+			return "", err
+		}
+
+		b[i] = appconstant.CharSet[index.Int64()]
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,61 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type RateLimitRule struct {
+	Name          string
+	IpLimit       int
+	IdentityLimit int
+	Window        time.Duration
+	Identity      func(body []byte) string
+}
+
+type RateLimitStore interface {
+	Increment(ctx context.Context, key string, window time.Duration) (int, time.Time, error)
+}
+
+type rateLimitEntry struct {
+	count   int
+	resetAt time.Time
+}
+
+type InMemoryRateLimitStore struct {
+	mu        sync.Mutex
+	entries   map[string]*rateLimitEntry
+	lastSweep time.Time
+}
+
+func NewInMemoryRateLimitStore() *InMemoryRateLimitStore {
+	return &InMemoryRateLimitStore{
+		entries:   map[string]*rateLimitEntry{},
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *InMemoryRateLimitStore) Increment(ctx context.Context, key string, window time.Duration) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > time.Minute {
+		for k, entry := range s.entries {
+			if now.After(entry.resetAt) {
+				delete(s.entries, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	entry, ok := s.entries[key]
+	if !ok || now.After(entry.resetAt) {
+		entry = &rateLimitEntry{resetAt: now.Add(window)}
+		s.entries[key] = entry
+	}
+	entry.count++
+
+	return entry.count, entry.resetAt, nil
+}
@@ -0,0 +1,127 @@
+package util
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"max-health/appconstant"
+	"max-health/config"
+	"max-health/entity"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+type SickLeaveDocumentHelper interface {
+	Sign(certificate entity.SickLeaveCertificate) string
+	Verify(certificate entity.SickLeaveCertificate) bool
+	Render(certificate entity.SickLeaveCertificate) ([]byte, error)
+}
+
+type sickLeaveDocumentHelperImpl struct {
+	config config.Config
+}
+
+func NewSickLeaveDocumentHelperImpl(config *config.Config) sickLeaveDocumentHelperImpl {
+	return sickLeaveDocumentHelperImpl{
+		config: *config,
+	}
+}
+
+func (h *sickLeaveDocumentHelperImpl) Sign(certificate entity.SickLeaveCertificate) string {
+	mac := hmac.New(sha256.New, []byte(h.config.PrescriptionSecret))
+	mac.Write([]byte(canonicalSickLeaveCertificate(certificate)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *sickLeaveDocumentHelperImpl) Verify(certificate entity.SickLeaveCertificate) bool {
+	return hmac.Equal([]byte(h.Sign(certificate)), []byte(certificate.Signature))
+}
+
+func (h *sickLeaveDocumentHelperImpl) Render(certificate entity.SickLeaveCertificate) ([]byte, error) {
+	verificationUrl := SickLeaveVerificationUrl(certificate.VerificationCode)
+
+	qr, err := qrcode.Encode(verificationUrl, qrcode.Medium, 256)
+	if err != nil {
+		return nil, err
+	}
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle(appconstant.SickLeaveDocumentTitle, true)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.CellFormat(0, 10, appconstant.SickLeaveDocumentTitle, "", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.CellFormat(0, 6, fmt.Sprintf("No. %d  -  Issued %s", certificate.Id, certificate.IssuedAt.Format(appconstant.DocumentDateFormat)), "", 1, "C", false, 0, "")
+	pdf.Ln(6)
+
+	writeDocumentSection(pdf, "Patient")
+	writeDocumentRow(pdf, "Name", certificate.PatientName)
+	if certificate.PatientGender != nil {
+		writeDocumentRow(pdf, "Gender", *certificate.PatientGender)
+	}
+	if certificate.PatientDateOfBirth != nil {
+		writeDocumentRow(pdf, "Date of birth", certificate.PatientDateOfBirth.Format(appconstant.DocumentDateFormat))
+	}
+	pdf.Ln(4)
+
+	writeDocumentSection(pdf, "Doctor")
+	writeDocumentRow(pdf, "Name", certificate.DoctorName)
+	writeDocumentRow(pdf, "Specialization", certificate.DoctorSpecialization)
+	writeDocumentRow(pdf, "Certificate", certificate.DoctorCertificate)
+	pdf.Ln(4)
+
+	writeDocumentSection(pdf, "Sick leave")
+	writeDocumentRow(pdf, "From", certificate.StartDate.Format(appconstant.DocumentDateFormat))
+	writeDocumentRow(pdf, "Until", certificate.EndDate().Format(appconstant.DocumentDateFormat))
+	writeDocumentRow(pdf, "Duration", fmt.Sprintf("%d day(s)", certificate.Days))
+	pdf.CellFormat(40, 6, "Diagnosis", "", 0, "L", false, 0, "")
+	pdf.MultiCell(0, 6, certificate.DiagnosisSummary, "", "L", false)
+	pdf.Ln(8)
+
+	qrY := pdf.GetY()
+	pdf.RegisterImageOptionsReader("qr", fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qr))
+	pdf.ImageOptions("qr", 10, qrY, 40, 40, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	pdf.SetXY(55, qrY)
+	pdf.SetFont("Helvetica", "B", 10)
+	pdf.CellFormat(0, 6, "Verification code: "+certificate.VerificationCode, "", 2, "L", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.MultiCell(0, 5, "Scan the QR code or open "+verificationUrl+" to verify this certificate.", "", "L", false)
+	pdf.SetX(55)
+	pdf.MultiCell(0, 5, "Digital signature: "+certificate.Signature, "", "L", false)
+
+	if pdf.Err() {
+		return nil, pdf.Error()
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = pdf.Output(buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func SickLeaveVerificationUrl(verificationCode string) string {
+	return fmt.Sprintf("http://%s/sick-leave-certificates/verify/%s", config.EmailHost, verificationCode)
+}
+
+func canonicalSickLeaveCertificate(certificate entity.SickLeaveCertificate) string {
+	return fmt.Sprintf("%d|%s|%d|%d|%s|%d|%s",
+		certificate.WsChatRoomId,
+		certificate.VerificationCode,
+		certificate.UserAccountId,
+		certificate.DoctorAccountId,
+		certificate.StartDate.Format(appconstant.SickLeaveDateFormat),
+		certificate.Days,
+		certificate.DiagnosisSummary,
+	)
+}
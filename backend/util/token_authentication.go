@@ -2,9 +2,13 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"max-health/appconstant"
+
 	"max-health/config"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -15,6 +19,9 @@ type JwtCustomClaims struct {
 	Email         string `json:"email"`
 	Role          string `json:"role"`
 	TokenDuration int    `json:"token_duration"`
+	SessionId     string `json:"session_id,omitempty"`
+	TokenId       string `json:"token_id,omitempty"`
+	TwoFactor     bool   `json:"two_factor,omitempty"`
 }
 
 type CentrifugoClientClaims struct {
@@ -29,41 +36,39 @@ type CentrifugoChannelClaims struct {
 }
 
 type TokenAuthentication interface {
-	CreateAndSign(customClaims JwtCustomClaims, secretKey string) (*string, error)
-	ParseAndVerify(signed string, secretKey string) (*JwtCustomClaims, error)
+	CreateAndSign(customClaims JwtCustomClaims, audience string) (*string, error)
+	ParseAndVerify(signed string, audience string) (*JwtCustomClaims, error)
+}
+
+type JwksProvider interface {
+	Jwks() Jwks
 }
 
 type JwtAuthentication struct {
 	Config config.Config
-	Method *jwt.SigningMethodHMAC
+	KeySet *JwtKeySet
 }
 
-func (ja JwtAuthentication) CreateAndSign(customClaims JwtCustomClaims, secretKey string) (*string, error) {
+func (ja JwtAuthentication) CreateAndSign(customClaims JwtCustomClaims, audience string) (*string, error) {
 	customClaimsJsonBytes, err := json.Marshal(customClaims)
 	if err != nil {
 		return nil, err
 	}
 
-	token := jwt.NewWithClaims(ja.Method, jwt.MapClaims{
+	return ja.sign(jwt.MapClaims{
 		"iss":  ja.Config.Issuer,
-		"iat":  time.Now(),
+		"aud":  audience,
+		"iat":  time.Now().Unix(),
 		"exp":  time.Now().Add(time.Duration(customClaims.TokenDuration) * time.Minute).Unix(),
 		"data": string(customClaimsJsonBytes),
 	})
-
-	signed, err := token.SignedString([]byte(secretKey))
-	if err != nil {
-		return nil, err
-	}
-
-	return &signed, nil
 }
 
-func (ja JwtAuthentication) ParseAndVerify(signed string, secretKey string) (*JwtCustomClaims, error) {
-	token, err := jwt.Parse(signed, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secretKey), nil
-	}, jwt.WithValidMethods([]string{ja.Method.Name}),
+func (ja JwtAuthentication) ParseAndVerify(signed string, audience string) (*JwtCustomClaims, error) {
+	token, err := jwt.Parse(signed, ja.verificationKey,
+		jwt.WithValidMethods(ja.KeySet.Algorithms()),
 		jwt.WithIssuer(ja.Config.Issuer),
+		jwt.WithAudience(audience),
 		jwt.WithExpirationRequired(),
 	)
 	if err != nil {
@@ -72,11 +77,14 @@ func (ja JwtAuthentication) ParseAndVerify(signed string, secretKey string) (*Jw
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return nil, err
+		return nil, errors.New("invalid token claims")
 	}
 
 	customClaims := JwtCustomClaims{}
-	data := claims["data"].(string)
+	data, ok := claims["data"].(string)
+	if !ok {
+		return nil, errors.New("invalid token data")
+	}
 	if err := json.Unmarshal([]byte(data), &customClaims); err != nil {
 		return nil, err
 	}
@@ -85,12 +93,71 @@ func (ja JwtAuthentication) ParseAndVerify(signed string, secretKey string) (*Jw
 }
 
 func (ja JwtAuthentication) CentrifugoClientCreateAndSign(customClaims CentrifugoClientClaims) (*string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	return ja.signCentrifugo(jwt.MapClaims{
 		"sub": fmt.Sprintf("%v", customClaims.AccountId),
 		"exp": customClaims.ExpiredAt,
 	})
+}
 
-	signed, err := token.SignedString([]byte(ja.Config.CentrifugoSecret))
+func (ja JwtAuthentication) CentrifugoChannelCreateAndSign(customClaims CentrifugoChannelClaims) (*string, error) {
+	return ja.signCentrifugo(jwt.MapClaims{
+		"sub":     fmt.Sprintf("%v", customClaims.AccountId),
+		"channel": customClaims.Channel,
+		"exp":     customClaims.ExpiredAt,
+	})
+}
+
+func (ja JwtAuthentication) CentrifugoClientParseAndVerify(signed string) (*CentrifugoClientClaims, error) {
+	token, err := jwt.Parse(signed, func(token *jwt.Token) (interface{}, error) {
+		if ja.Config.CentrifugoSecret == "" {
+			return nil, errors.New("centrifugo secret is not configured")
+		}
+		return []byte(ja.Config.CentrifugoSecret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil {
+		return nil, err
+	}
+
+	accountId, err := strconv.ParseInt(subject, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid token subject")
+	}
+
+	expiredAt, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CentrifugoClientClaims{
+		AccountId: accountId,
+		ExpiredAt: expiredAt.Unix(),
+	}, nil
+}
+
+func (ja JwtAuthentication) Jwks() Jwks {
+	return ja.KeySet.Jwks()
+}
+
+func (ja JwtAuthentication) sign(claims jwt.MapClaims) (*string, error) {
+	key := ja.KeySet.SigningKey()
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header[appconstant.JwtKeyIdHeader] = key.Id
+
+	signed, err := token.SignedString(key.PrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -98,12 +165,12 @@ func (ja JwtAuthentication) CentrifugoClientCreateAndSign(customClaims Centrifug
 	return &signed, nil
 }
 
-func (ja JwtAuthentication) CentrifugoChannelCreateAndSign(customClaims CentrifugoChannelClaims) (*string, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":     fmt.Sprintf("%v", customClaims.AccountId),
-		"channel": customClaims.Channel,
-		"exp":     customClaims.ExpiredAt,
-	})
+func (ja JwtAuthentication) signCentrifugo(claims jwt.MapClaims) (*string, error) {
+	if ja.Config.CentrifugoSecret == "" {
+		return nil, errors.New("centrifugo secret is not configured")
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	signed, err := token.SignedString([]byte(ja.Config.CentrifugoSecret))
 	if err != nil {
@@ -112,3 +179,20 @@ func (ja JwtAuthentication) CentrifugoChannelCreateAndSign(customClaims Centrifu
 
 	return &signed, nil
 }
+
+func (ja JwtAuthentication) verificationKey(token *jwt.Token) (interface{}, error) {
+	keyId, ok := token.Header[appconstant.JwtKeyIdHeader].(string)
+	if !ok {
+		return nil, errors.New("missing key id")
+	}
+
+	key, ok := ja.KeySet.VerificationKey(keyId)
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", keyId)
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method %q for key %q", token.Method.Alg(), keyId)
+	}
+
+	return key.PublicKey, nil
+}
@@ -0,0 +1,202 @@
+package util
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"max-health/appconstant"
+	"max-health/config"
+
+	"github.com/skip2/go-qrcode"
+)
+
+type TwoFactorHelper interface {
+	GenerateSecret() (string, error)
+	EncryptSecret(secret string) (string, error)
+	DecryptSecret(encrypted string) (string, error)
+	ValidateCode(secret string, code string, lastUsedStep int64) (int64, bool)
+	ProvisioningUri(accountName string, secret string) string
+	RenderQrCode(provisioningUri string) (string, error)
+	GenerateRecoveryCodes() ([]string, error)
+	HashRecoveryCode(code string) string
+}
+
+type twoFactorHelperImpl struct {
+	config config.Config
+}
+
+func NewTwoFactorHelperImpl(config *config.Config) twoFactorHelperImpl {
+	return twoFactorHelperImpl{
+		config: *config,
+	}
+}
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (h *twoFactorHelperImpl) GenerateSecret() (string, error) {
+	secret := make([]byte, appconstant.TwoFactorSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func (h *twoFactorHelperImpl) EncryptSecret(secret string) (string, error) {
+	gcm, err := h.newCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (h *twoFactorHelperImpl) DecryptSecret(encrypted string) (string, error) {
+	gcm, err := h.newCipher()
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted two factor secret")
+	}
+
+	nonce, cipherText := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	secret, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
+
+func (h *twoFactorHelperImpl) ValidateCode(secret string, code string, lastUsedStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != appconstant.TwoFactorCodeDigits {
+		return 0, false
+	}
+
+	currentStep := time.Now().Unix() / int64(appconstant.TwoFactorPeriod.Seconds())
+	for step := currentStep - appconstant.TwoFactorSkew; step <= currentStep+appconstant.TwoFactorSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+
+		expected, err := generateTotpCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func (h *twoFactorHelperImpl) ProvisioningUri(accountName string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", appconstant.TwoFactorIssuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", appconstant.TwoFactorCodeDigits))
+	query.Set("period", fmt.Sprintf("%d", int(appconstant.TwoFactorPeriod.Seconds())))
+
+	label := url.PathEscape(fmt.Sprintf("%s:%s", appconstant.TwoFactorIssuer, accountName))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, query.Encode())
+}
+
+func (h *twoFactorHelperImpl) RenderQrCode(provisioningUri string) (string, error) {
+	qr, err := qrcode.Encode(provisioningUri, qrcode.Medium, appconstant.TwoFactorQrCodeSize)
+	if err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(qr), nil
+}
+
+func (h *twoFactorHelperImpl) GenerateRecoveryCodes() ([]string, error) {
+	codes := []string{}
+	for i := 0; i < appconstant.TwoFactorRecoveryCodeCount; i++ {
+		raw := make([]byte, appconstant.TwoFactorRecoveryCodeLength)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+
+		code := strings.ToLower(totpEncoding.EncodeToString(raw))[:appconstant.TwoFactorRecoveryCodeLength]
+		codes = append(codes, fmt.Sprintf("%s-%s", code[:appconstant.TwoFactorRecoveryCodeLength/2], code[appconstant.TwoFactorRecoveryCodeLength/2:]))
+	}
+
+	return codes, nil
+}
+
+func (h *twoFactorHelperImpl) HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+
+	mac := hmac.New(sha256.New, []byte(h.config.TwoFactorKey))
+	mac.Write([]byte(normalized))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (h *twoFactorHelperImpl) newCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(h.config.TwoFactorKey))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func generateTotpCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < appconstant.TwoFactorCodeDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", appconstant.TwoFactorCodeDigits, value%modulo), nil
+}
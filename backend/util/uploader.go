@@ -5,13 +5,17 @@ import (
 	"mime/multipart"
 	"strings"
 
+	"max-health/appconstant"
 	"max-health/config"
 
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func UploadToCloudinary(file multipart.File, filePath string) (string, error) {
-	ctx := context.Background()
+func UploadToCloudinary(ctx context.Context, file multipart.File, filePath string) (imageUrl string, err error) {
+	ctx, span := StartSpan(ctx, appconstant.SpanCloudinaryUpload, attribute.String("cloudinary.public_id", filePath))
+	defer func() { EndSpan(span, err) }()
+
 	cld, err := config.SetupCloudinary()
 	if err != nil {
 		return "", err
@@ -24,17 +28,20 @@ func UploadToCloudinary(file multipart.File, filePath string) (string, error) {
 		return "", err
 	}
 
-	imageUrl := result.SecureURL
+	imageUrl = result.SecureURL
 	return imageUrl, nil
 }
 
-func DeleteInCloudinary(filePath string) error {
-	ctx := context.Background()
+func DeleteInCloudinary(ctx context.Context, filePath string) (err error) {
+	publicId := getPublicIdFromUrl(filePath)
+
+	ctx, span := StartSpan(ctx, appconstant.SpanCloudinaryDestroy, attribute.String("cloudinary.public_id", publicId))
+	defer func() { EndSpan(span, err) }()
+
 	cld, err := config.SetupCloudinary()
 	if err != nil {
 		return err
 	}
-	publicId := getPublicIdFromUrl(filePath)
 	deleteParams := uploader.DestroyParams{
 		PublicID:     publicId,
 		ResourceType: "image",
@@ -227,7 +227,6 @@ const ChatRoomV2 = ({
 
     const data: IChatWsData = {
       channel: room.hash,
-      side: role == "user" ? 1 : 2,
       message: message,
       prescription_drugs: prescriptionDrugs,
     };
@@ -314,8 +313,17 @@ const ChatRoomV2 = ({
     socket.onmessage = (ev) => {
       const msg = ev.data;
 
+      const parsed = JSON.parse(msg);
+
+      if (typeof parsed.type === "string") {
+        if (parsed.type === "error") {
+          HandleShowToast(setToast, false, parsed.data.message, 5);
+        }
+        return;
+      }
+
       if (roomDetail) {
-        const msgObj: IChat = JSON.parse(msg);
+        const msgObj: IChat = parsed;
 
         setRoomDetail((prev) => {
           if (!prev) {
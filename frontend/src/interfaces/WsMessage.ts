@@ -13,7 +13,6 @@ export interface IAuthWsData {
 
 export interface IChatWsData {
   channel: string;
-  side: number;
   message: string;
   attachment?: IAttachment;
   prescription_drugs: IPrescriptionDrug[];
@@ -222,6 +222,12 @@ export async function HandleRefreshToken() {
   Cookies.set("accessToken", responseData.data.access_token, {
     expires: new Date(accessTokenClaims.exp * 1000),
   });
+
+  const refreshTokenClaims = jwtDecode<IToken>(responseData.data.refresh_token);
+
+  Cookies.set("refreshToken", responseData.data.refresh_token, {
+    expires: new Date(refreshTokenClaims.exp * 1000),
+  });
 }
 
 export async function VerifyToken(): Promise<ITokenDataV2> {